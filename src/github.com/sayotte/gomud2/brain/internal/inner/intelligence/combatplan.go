@@ -19,7 +19,8 @@ const hokeyStaticMaxHandItems = 2
 var staticMeleeDelay = float64(core.ActorMeleeDelay) / float64(time.Second)
 
 type combatPlan struct {
-	actorID uuid.UUID
+	actorID  uuid.UUID
+	targetID uuid.UUID
 
 	availableActions []CombatAction
 
@@ -33,7 +34,7 @@ type combatPlan struct {
 
 func (cp *combatPlan) plan(memory *Memory) {
 	var startingState combatState
-	(&startingState).initFromMemory(cp.actorID, uuid.UUID(memory.GetLastAttacker()), memory)
+	(&startingState).initFromMemory(cp.actorID, cp.targetID, memory)
 	startingAction := &startingAction{
 		finalState: startingState,
 	}
@@ -9,6 +9,8 @@ import (
 	"github.com/satori/go.uuid"
 
 	"github.com/sayotte/gomud2/commands"
+	"github.com/sayotte/gomud2/core"
+	uuid2 "github.com/sayotte/gomud2/uuid"
 	"github.com/sayotte/gomud2/wsapi"
 )
 
@@ -112,6 +114,10 @@ func (i *Intellect) HandleMessage(msg wsapi.Message) {
 		i.handleLookAtOtherActorMessage(msg)
 	case wsapi.MessageTypeLookAtObjectComplete:
 		i.handleLookAtObjectMessage(msg)
+	case wsapi.MessageTypeDescribeContractComplete:
+		i.handleDescribeContractMessage(msg)
+	case wsapi.MessageTypeDescribeCrimesComplete:
+		i.handleDescribeCrimesMessage(msg)
 	case wsapi.MessageTypeEvent:
 		i.handleEventMessage(msg)
 	default:
@@ -164,6 +170,52 @@ func (i *Intellect) handleLookAtObjectMessage(msg wsapi.Message) {
 	i.memory.SetObjectInfo(objectInfo)
 }
 
+func (i *Intellect) handleDescribeContractMessage(msg wsapi.Message) {
+	fmt.Println("BRAIN DEBUG: handleDescribeContractMessage(): ...")
+	var info commands.ContractInfo
+	err := json.Unmarshal(msg.Payload, &info)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: json.Unmarshal(contractInfo): %s\n", err)
+		return
+	}
+	if !uuid.Equal(info.ActorID, i.actorID) {
+		return
+	}
+	if !info.Hired {
+		i.memory.SetHirelingContract(nil)
+		return
+	}
+
+	contract := &hirelingContract{
+		EmployerID:      info.EmployerID,
+		EmployerName:    info.EmployerName,
+		HiredUntil:      info.HiredUntil,
+		Order:           info.Order,
+		OrderTargetID:   info.OrderTargetID,
+		OrderTargetName: info.OrderTargetName,
+	}
+	for _, friend := range info.Friends {
+		contract.Friends = append(contract.Friends, friend.ID)
+	}
+	for _, enemy := range info.Enemies {
+		contract.Enemies = append(contract.Enemies, enemy.ID)
+	}
+	i.memory.SetHirelingContract(contract)
+}
+
+func (i *Intellect) handleDescribeCrimesMessage(msg wsapi.Message) {
+	var info commands.CrimesInfo
+	err := json.Unmarshal(msg.Payload, &info)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: json.Unmarshal(crimesInfo): %s\n", err)
+		return
+	}
+	if !uuid.Equal(info.ActorID, i.actorID) {
+		return
+	}
+	i.memory.SetCrimesInfo(info)
+}
+
 func (i *Intellect) handleEventMessage(msg wsapi.Message) {
 	var eventEnvelope wsapi.Event
 	err := json.Unmarshal(msg.Payload, &eventEnvelope)
@@ -207,6 +259,14 @@ func (i *Intellect) handleEventMessage(msg wsapi.Message) {
 			return
 		}
 		i.handleActorDeathEvent(e, eventEnvelope.ZoneID)
+	case wsapi.EventTypeActorRespawn:
+		var e wsapi.ActorRespawnEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorRespawnEventBody): %s\n", err)
+			return
+		}
+		i.handleActorRespawnEvent(e, eventEnvelope.ZoneID)
 	case wsapi.EventTypeActorMigrateIn:
 		var e wsapi.ActorMigrateInEventBody
 		err = json.Unmarshal(eventEnvelope.Body, &e)
@@ -247,6 +307,126 @@ func (i *Intellect) handleEventMessage(msg wsapi.Message) {
 			return
 		}
 		i.handleCombatMeleeDamageEvent(e)
+	case wsapi.EventTypeCombatEngage:
+		var e wsapi.CombatEngageEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(CombatEngageEventBody): %s\n", err)
+			return
+		}
+		i.handleCombatEngageEvent(e)
+	case wsapi.EventTypeCombatDisengage:
+		var e wsapi.CombatDisengageEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(CombatDisengageEventBody): %s\n", err)
+			return
+		}
+		i.handleCombatDisengageEvent(e)
+	case wsapi.EventTypeSorceryInvoke:
+		var e wsapi.SorceryInvokeEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(SorceryInvokeEventBody): %s\n", err)
+			return
+		}
+		i.handleSorceryInvokeEvent(e)
+	case wsapi.EventTypeActorPray:
+		var e wsapi.ActorPrayEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorPrayEventBody): %s\n", err)
+			return
+		}
+		i.handleActorPrayEvent(e)
+	case wsapi.EventTypeActorHire:
+		var e wsapi.ActorHireEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorHireEventBody): %s\n", err)
+			return
+		}
+		i.handleActorHireEvent(e)
+	case wsapi.EventTypeActorRelease:
+		var e wsapi.ActorReleaseEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorReleaseEventBody): %s\n", err)
+			return
+		}
+		i.handleActorReleaseEvent(e)
+	case wsapi.EventTypeActorRelationship:
+		var e wsapi.ActorRelationshipEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorRelationshipEventBody): %s\n", err)
+			return
+		}
+		i.handleActorRelationshipEvent(e)
+	case wsapi.EventTypeActorOrder:
+		var e wsapi.ActorOrderEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorOrderEventBody): %s\n", err)
+			return
+		}
+		i.handleActorOrderEvent(e)
+	case wsapi.EventTypeActorAdminRelocate:
+		var e wsapi.ActorAdminRelocateEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorAdminRelocateEventBody): %s\n", err)
+			return
+		}
+		i.handleActorAdminRelocateEvent(e, eventEnvelope.ZoneID)
+	case wsapi.EventTypeCrimeCommit:
+		var e wsapi.CrimeCommitEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(CrimeCommitEventBody): %s\n", err)
+			return
+		}
+		i.handleCrimeCommitEvent(e)
+	case wsapi.EventTypeCrimeReport:
+		var e wsapi.CrimeReportEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(CrimeReportEventBody): %s\n", err)
+			return
+		}
+		i.handleCrimeReportEvent(e)
+	case wsapi.EventTypeCrimeResolve:
+		var e wsapi.CrimeResolveEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(CrimeResolveEventBody): %s\n", err)
+			return
+		}
+		i.memory.ForgetCrime(e.CrimeID)
+	case wsapi.EventTypeActorJoinFaction:
+		var e wsapi.ActorFactionMembershipEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorFactionMembershipEventBody): %s\n", err)
+			return
+		}
+		i.handleActorFactionMembershipEvent(e, true)
+	case wsapi.EventTypeActorLeaveFaction:
+		var e wsapi.ActorFactionMembershipEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorFactionMembershipEventBody): %s\n", err)
+			return
+		}
+		i.handleActorFactionMembershipEvent(e, false)
+	case wsapi.EventTypeActorReputation:
+		var e wsapi.ActorReputationEventBody
+		err = json.Unmarshal(eventEnvelope.Body, &e)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: json.Unmarshal(ActorReputationEventBody): %s\n", err)
+			return
+		}
+		i.handleActorReputationEvent(e)
 	default:
 		fmt.Printf("BRAIN DEBUG: Brain received event of type %q, no idea what to do with it\n", eventEnvelope.EventType)
 	}
@@ -273,7 +453,8 @@ func (i *Intellect) handleActorMoveEvent(e wsapi.ActorMoveEventBody, zoneID uuid
 		switch {
 		case uuid.Equal(currentLocID, e.FromLocationID):
 			// someone left our location
-			i.memory.RemoveActorFromLocation(zoneID, e.ToLocationID, e.ActorID)
+			i.memory.RemoveActorFromLocation(zoneID, e.FromLocationID, e.ActorID)
+			i.memory.SetActorDeparture(e.ActorID, zoneID, e.ToLocationID)
 		case uuid.Equal(currentLocID, e.ToLocationID):
 			// someone arrived at our location
 			i.memory.AddActorToLocation(zoneID, e.ToLocationID, e.ActorID)
@@ -293,6 +474,15 @@ func (i *Intellect) handleActorDeathEvent(e wsapi.ActorDeathEventBody, zoneID uu
 	i.memory.RemoveActorFromLocation(zoneID, currentLocID, e.ActorID)
 }
 
+func (i *Intellect) handleActorRespawnEvent(e wsapi.ActorRespawnEventBody, zoneID uuid.UUID) {
+	// a ghost came back to life; we forgot it when it died, so remember it
+	// again if it's in our location
+	_, currentLocID := i.memory.GetCurrentZoneAndLocationID()
+	if uuid.Equal(currentLocID, e.ToLocationID) {
+		i.memory.AddActorToLocation(zoneID, currentLocID, e.ActorID)
+	}
+}
+
 func (i *Intellect) handleActorMigrateInEvent(e wsapi.ActorMigrateInEventBody, zoneID uuid.UUID) {
 	if uuid.Equal(e.ActorID, i.actorID) {
 		// we migrated to a new zone/location
@@ -300,6 +490,8 @@ func (i *Intellect) handleActorMigrateInEvent(e wsapi.ActorMigrateInEventBody, z
 		i.memory.SetLastMovementTime(time.Now())
 		i.memory.SetCurrentZoneAndLocationID(zoneID, e.ToLocID)
 		i.memory.ClearLocationInfo()
+		// the law, and what we know of its crimes, differ from Zone to Zone
+		i.memory.SetCrimesStale()
 	} else {
 		// someone migrated in to our location
 		i.memory.AddActorToLocation(zoneID, e.ToLocID, e.ActorID)
@@ -314,6 +506,7 @@ func (i *Intellect) handleActorMigrateOutEvent(e wsapi.ActorMigrateOutEventBody,
 	}
 	// someone migrated out of our location
 	i.memory.RemoveActorFromLocation(zoneID, e.FromLocID, e.ActorID)
+	i.memory.SetActorDeparture(e.ActorID, e.ToZoneID, e.ToLocID)
 }
 
 func (i *Intellect) handleObjectAddToZoneEvent(e wsapi.ObjectAddToZoneEventBody, zoneID uuid.UUID) {
@@ -356,6 +549,191 @@ func (i *Intellect) handleCombatMeleeDamageEvent(e wsapi.CombatMeleeDamageEventB
 		i.memory.SetLastAttackedTime(time.Now())
 		i.memory.SetLastAttacker(ActorIDTyp(e.AttackerID))
 	}
+	i.noteWardAttacked(e.TargetID, e.AttackerID)
+}
+
+func (i *Intellect) handleSorceryInvokeEvent(e wsapi.SorceryInvokeEventBody) {
+	if e.Effect != core.ReactionEffectDamage || uuid.Equal(e.ActorID, i.actorID) {
+		return
+	}
+	if uuid.Equal(e.TargetID, i.actorID) {
+		i.memory.SetLastAttackedTime(time.Now())
+		i.memory.SetLastAttacker(ActorIDTyp(e.ActorID))
+	}
+	i.noteWardAttacked(e.TargetID, e.ActorID)
+}
+
+func (i *Intellect) handleActorPrayEvent(e wsapi.ActorPrayEventBody) {
+	if e.Effect != core.PrayerEffectDamage || uuid.Equal(e.ActorID, i.actorID) {
+		return
+	}
+	if uuid.Equal(e.TargetID, i.actorID) {
+		i.memory.SetLastAttackedTime(time.Now())
+		i.memory.SetLastAttacker(ActorIDTyp(e.ActorID))
+	}
+	i.noteWardAttacked(e.TargetID, e.ActorID)
+}
+
+func (i *Intellect) handleCombatEngageEvent(e wsapi.CombatEngageEventBody) {
+	if uuid.Equal(e.AttackerID, i.actorID) {
+		i.memory.SetEngagedWith(ActorIDTyp(e.TargetID))
+	}
+	if uuid.Equal(e.TargetID, i.actorID) {
+		i.memory.SetLastAttackedTime(time.Now())
+		i.memory.SetLastAttacker(ActorIDTyp(e.AttackerID))
+	}
+	i.noteWardAttacked(e.TargetID, e.AttackerID)
+}
+
+func (i *Intellect) handleCombatDisengageEvent(e wsapi.CombatDisengageEventBody) {
+	if uuid.Equal(e.ActorID, i.actorID) {
+		i.memory.SetEngagedWith(ActorIDTyp(uuid.Nil))
+	}
+}
+
+// noteWardAttacked remembers who attacked one of the Actors we've been hired
+// to protect, so we can go after them.
+func (i *Intellect) noteWardAttacked(targetID, attackerID uuid.UUID) {
+	if uuid.Equal(attackerID, i.actorID) || !i.memory.IsWard(targetID) {
+		return
+	}
+	i.memory.SetLastWardAttacker(ActorIDTyp(attackerID))
+}
+
+func (i *Intellect) handleActorHireEvent(e wsapi.ActorHireEventBody) {
+	if !uuid.Equal(e.ActorID, i.actorID) {
+		return
+	}
+	contract := &hirelingContract{
+		EmployerID:   e.EmployerID,
+		EmployerName: e.EmployerName,
+		HiredUntil:   e.HiredUntil,
+	}
+	// a renewal keeps our standing orders
+	previous, hired := i.memory.GetHirelingContract()
+	if hired && uuid.Equal(previous.EmployerID, e.EmployerID) {
+		contract.Friends = previous.Friends
+		contract.Enemies = previous.Enemies
+		contract.Order = previous.Order
+		contract.OrderTargetID = previous.OrderTargetID
+		contract.OrderTargetName = previous.OrderTargetName
+	}
+	i.memory.SetHirelingContract(contract)
+}
+
+func (i *Intellect) handleActorReleaseEvent(e wsapi.ActorReleaseEventBody) {
+	if !uuid.Equal(e.ActorID, i.actorID) {
+		return
+	}
+	i.memory.SetHirelingContract(nil)
+	i.memory.SetPendingReport(false)
+}
+
+func (i *Intellect) handleActorRelationshipEvent(e wsapi.ActorRelationshipEventBody) {
+	if !uuid.Equal(e.ActorID, i.actorID) {
+		return
+	}
+	i.memory.SetRelationship(e.OtherID, e.Relationship)
+}
+
+func (i *Intellect) handleActorOrderEvent(e wsapi.ActorOrderEventBody) {
+	if !uuid.Equal(e.ActorID, i.actorID) {
+		return
+	}
+	switch e.Order {
+	case core.HirelingOrderReport:
+		i.memory.SetPendingReport(true)
+	case core.HirelingOrderStandDown:
+		i.memory.SetPendingDisengage(true)
+		i.memory.ForgetLastWardAttacker()
+		i.memory.SetHirelingOrder(e.Order, e.TargetID, e.TargetName)
+	default:
+		i.memory.SetHirelingOrder(e.Order, e.TargetID, e.TargetName)
+	}
+}
+
+func (i *Intellect) handleActorAdminRelocateEvent(e wsapi.ActorAdminRelocateEventBody, zoneID uuid.UUID) {
+	if uuid.Equal(e.ActorID, i.actorID) {
+		// we were moved, e.g. to jail
+		i.memory.SetCurrentZoneAndLocationID(zoneID, e.ToLocationID)
+		i.memory.ClearLocationInfo()
+		return
+	}
+	_, currentLocID := i.memory.GetCurrentZoneAndLocationID()
+	if uuid.Equal(currentLocID, e.ToLocationID) {
+		i.memory.AddActorToLocation(zoneID, currentLocID, e.ActorID)
+	} else {
+		i.memory.RemoveActorFromLocation(zoneID, currentLocID, e.ActorID)
+	}
+}
+
+func (i *Intellect) handleCrimeCommitEvent(e wsapi.CrimeCommitEventBody) {
+	if uuid2.UUIDList(e.Witnesses).IndexOf(i.actorID) == -1 {
+		return
+	}
+	i.memory.AddKnownCrime(commands.CrimeInfo{
+		ID:           e.CrimeID,
+		Type:         e.CrimeType,
+		Severity:     e.Severity,
+		OffenderID:   e.OffenderID,
+		OffenderName: e.OffenderName,
+		VictimID:     e.VictimID,
+		VictimName:   e.VictimName,
+		LocationID:   e.LocationID,
+		CommittedAt:  e.CommittedAt,
+	})
+}
+
+func (i *Intellect) handleCrimeReportEvent(e wsapi.CrimeReportEventBody) {
+	switch {
+	case uuid.Equal(e.ReporterID, i.actorID):
+		i.memory.SetCrimeReported(e.CrimeID, e.ListenerID)
+	case uuid.Equal(e.ListenerID, i.actorID):
+		// the report doesn't say who the offender is, only their name, so
+		// ask for the details
+		i.memory.SetCrimesStale()
+	}
+}
+
+func (i *Intellect) handleActorFactionMembershipEvent(e wsapi.ActorFactionMembershipEventBody, joined bool) {
+	if uuid.Equal(e.ActorID, i.actorID) {
+		// our membership may change whether we enforce the law here
+		i.memory.SetCrimesStale()
+	}
+	i.memory.UpdateActorInfo(e.ActorID, func(info *commands.ActorVisibleInfo) {
+		var factions []string
+		for _, name := range info.Factions {
+			if name != e.Faction {
+				factions = append(factions, name)
+			}
+		}
+		if joined {
+			factions = append(factions, e.Faction)
+		}
+		info.Factions = factions
+	})
+}
+
+func (i *Intellect) handleActorReputationEvent(e wsapi.ActorReputationEventBody) {
+	i.memory.UpdateActorInfo(e.ActorID, func(info *commands.ActorVisibleInfo) {
+		reputation := make(map[string]int, len(info.Reputation)+1)
+		for name, value := range info.Reputation {
+			reputation[name] = value
+		}
+		reputation[e.Faction] = e.Reputation
+		info.Reputation = reputation
+
+		var hostileFactions []string
+		for _, name := range info.HostileFactions {
+			if name != e.Faction {
+				hostileFactions = append(hostileFactions, name)
+			}
+		}
+		if e.Standing == core.ReputationStandingHostile {
+			hostileFactions = append(hostileFactions, e.Faction)
+		}
+		info.HostileFactions = hostileFactions
+	})
 }
 
 func (i *Intellect) aiLoop() {
@@ -364,6 +742,18 @@ func (i *Intellect) aiLoop() {
 	ticker := time.NewTicker(minDurationBetweenRuns)
 
 	var plan executionPlan
+	// we may have been hired before this brain was attached, e.g. across a
+	// restart of the daemon
+	err := sendSyncMessage(
+		wsapi.MessageTypeDescribeContractCommand,
+		wsapi.CommandDescribeContract{ActorID: i.actorID},
+		i.msgSender,
+		i,
+	)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+	}
+
 	// set initial non-nil value for executionPlan to avoid a panic
 	plan = &trivialPlan{
 		goalName: "initial-plan",
@@ -378,6 +768,13 @@ func (i *Intellect) aiLoop() {
 		default:
 		}
 
+		if i.memory.GetCrimesStale() {
+			err := sendSyncMessage(wsapi.MessageTypeDescribeCrimesCommand, nil, i.msgSender, i)
+			if err != nil {
+				fmt.Printf("BRAIN ERROR: %s\n", err)
+			}
+		}
+
 		plan := i.planner.generatePlan(plan)
 		plan.executeStep(i.msgSender, i)
 
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"github.com/sayotte/gomud2/commands"
+	"github.com/sayotte/gomud2/core"
 	uuid2 "github.com/sayotte/gomud2/uuid"
 	"github.com/sayotte/gomud2/wsapi"
 	"math"
+	"sort"
 	"sync"
 	"time"
 
@@ -82,6 +84,22 @@ func (m *Memory) GetLastAttacker() ActorIDTyp {
 	return val.(ActorIDTyp)
 }
 
+func (m *Memory) SetEngagedWith(targetID ActorIDTyp) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.localStore[memoryEngagedWithID] = targetID
+}
+
+func (m *Memory) GetEngagedWith() ActorIDTyp {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryEngagedWithID]
+	if !found {
+		return ActorIDTyp(uuid.Nil)
+	}
+	return val.(ActorIDTyp)
+}
+
 // Location data
 
 func (m *Memory) GetCurrentZoneAndLocationID() (uuid.UUID, uuid.UUID) {
@@ -394,6 +412,27 @@ func (m *Memory) SetActorInfo(info commands.ActorVisibleInfo) {
 	m.localStore[memoryActorInfoMap] = infoMap
 }
 
+// UpdateActorInfo applies the given change to what we know about an Actor,
+// if we know anything about it at all; otherwise we'll pick up the change the
+// next time we look at it.
+func (m *Memory) UpdateActorInfo(actorID uuid.UUID, update func(info *commands.ActorVisibleInfo)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	val, found := m.localStore[memoryActorInfoMap]
+	if !found {
+		return
+	}
+	infoMap := val.(actorInfoMap)
+	entry, found := infoMap[ActorIDTyp(actorID)]
+	if !found {
+		return
+	}
+	update(&entry.Info)
+	entry.Timestamp = time.Now()
+	infoMap[ActorIDTyp(actorID)] = entry
+}
+
 // Object data
 
 func (m *Memory) SetObjectInfo(info commands.ObjectVisibleInfo) {
@@ -521,8 +560,508 @@ func (m *Memory) AddObjectToObject(objectID, toObjID uuid.UUID) {
 	infoMap[objectIDTyp(toObjID)] = objectInfoEnt
 }
 
+// Hireling contract data
+
+// SetHirelingContract records our contract with our employer, or forgets it
+// if contract is nil.
+func (m *Memory) SetHirelingContract(contract *hirelingContract) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if contract == nil {
+		delete(m.localStore, memoryHirelingContract)
+		return
+	}
+	m.localStore[memoryHirelingContract] = *contract
+}
+
+// GetHirelingContract returns our contract with our employer, and whether
+// we have one.
+func (m *Memory) GetHirelingContract() (hirelingContract, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryHirelingContract]
+	if !found {
+		return hirelingContract{}, false
+	}
+	return val.(hirelingContract), true
+}
+
+func (m *Memory) SetRelationship(actorID uuid.UUID, relationship string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	val, found := m.localStore[memoryHirelingContract]
+	if !found {
+		return
+	}
+	contract := val.(hirelingContract)
+	// copy before changing, the lists may be shared with a caller of
+	// GetHirelingContract()
+	friends := uuid2.UUIDList(append([]uuid.UUID(nil), contract.Friends...)).Remove(actorID)
+	enemies := uuid2.UUIDList(append([]uuid.UUID(nil), contract.Enemies...)).Remove(actorID)
+	switch relationship {
+	case core.HirelingRelationshipFriend:
+		friends = append(friends, actorID)
+	case core.HirelingRelationshipEnemy:
+		enemies = append(enemies, actorID)
+	}
+	contract.Friends, contract.Enemies = friends, enemies
+	m.localStore[memoryHirelingContract] = contract
+}
+
+func (m *Memory) SetHirelingOrder(order string, targetID uuid.UUID, targetName string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	val, found := m.localStore[memoryHirelingContract]
+	if !found {
+		return
+	}
+	contract := val.(hirelingContract)
+	contract.Order = order
+	contract.OrderTargetID = targetID
+	contract.OrderTargetName = targetName
+	m.localStore[memoryHirelingContract] = contract
+}
+
+func (m *Memory) SetPendingReport(pending bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.localStore[memoryPendingReport] = jsonBool(pending)
+}
+
+func (m *Memory) GetPendingReport() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryPendingReport]
+	return found && bool(val.(jsonBool))
+}
+
+func (m *Memory) SetPendingDisengage(pending bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.localStore[memoryPendingDisengage] = jsonBool(pending)
+}
+
+func (m *Memory) GetPendingDisengage() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryPendingDisengage]
+	return found && bool(val.(jsonBool))
+}
+
+// SetLastWardAttacker records someone attacking one of the Actors we're
+// protecting.
+func (m *Memory) SetLastWardAttacker(attackerID ActorIDTyp) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.localStore[memoryLastWardAttackerID] = attackerID
+	m.localStore[memoryLastWardAttackedTS] = time.Now()
+}
+
+// GetLastWardAttacker returns the last Actor to attack one of the Actors
+// we're protecting, and how many seconds ago it did so.
+func (m *Memory) GetLastWardAttacker() (ActorIDTyp, float64) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryLastWardAttackerID]
+	if !found {
+		return ActorIDTyp(uuid.Nil), math.MaxFloat64
+	}
+	attackedTS := m.localStore[memoryLastWardAttackedTS].(time.Time)
+	return val.(ActorIDTyp), time.Since(attackedTS).Seconds()
+}
+
+// ForgetLastWardAttacker forgets who last attacked one of our wards, e.g.
+// because we've been told to stand down.
+func (m *Memory) ForgetLastWardAttacker() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.localStore, memoryLastWardAttackerID)
+	delete(m.localStore, memoryLastWardAttackedTS)
+}
+
+// SetActorDeparture records where an Actor went when it left our location.
+func (m *Memory) SetActorDeparture(actorID, toZoneID, toLocID uuid.UUID) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var departures actorDepartureMap
+	val, found := m.localStore[memoryActorDepartureMap]
+	if found {
+		departures = val.(actorDepartureMap)
+	} else {
+		departures = make(actorDepartureMap)
+	}
+	departures[actorID] = [2]uuid.UUID{toZoneID, toLocID}
+	m.localStore[memoryActorDepartureMap] = departures
+}
+
+// GetActorDeparture returns the ZoneID/LocationID an Actor went to when it
+// last left our location, if we saw it go.
+func (m *Memory) GetActorDeparture(actorID uuid.UUID) ([2]uuid.UUID, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryActorDepartureMap]
+	if !found {
+		return [2]uuid.UUID{}, false
+	}
+	dest, found := val.(actorDepartureMap)[actorID]
+	return dest, found
+}
+
+// Crime data
+
+// crimeRecord returns our crime record, creating it if need be; the caller
+// must hold m.lock for writing.
+func (m *Memory) crimeRecord() crimeRecord {
+	val, found := m.localStore[memoryCrimeRecord]
+	if found {
+		return val.(crimeRecord)
+	}
+	record := crimeRecord{
+		Crimes:     make(map[uuid.UUID]commands.CrimeInfo),
+		ReportedTo: make(map[uuid.UUID][]uuid.UUID),
+	}
+	m.localStore[memoryCrimeRecord] = record
+	return record
+}
+
+// SetCrimesInfo replaces what we know of the law and its unresolved crimes
+// with a fresh description.
+func (m *Memory) SetCrimesInfo(info commands.CrimesInfo) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	record := m.crimeRecord()
+	record.Lawful = info.Lawful
+	record.Enforcer = info.Enforcer
+	record.HasJail = info.HasJail
+	record.Crimes = make(map[uuid.UUID]commands.CrimeInfo, len(info.Crimes))
+	for _, crime := range info.Crimes {
+		if crime.Resolution == core.CrimeResolutionNone {
+			record.Crimes[crime.ID] = crime
+		}
+	}
+	record.Stale = false
+	m.localStore[memoryCrimeRecord] = record
+}
+
+// AddKnownCrime records a crime we've witnessed.
+func (m *Memory) AddKnownCrime(crime commands.CrimeInfo) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.crimeRecord().Crimes[crime.ID] = crime
+}
+
+// ForgetCrime forgets a crime, e.g. because it's been dealt with.
+func (m *Memory) ForgetCrime(crimeID uuid.UUID) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	record := m.crimeRecord()
+	delete(record.Crimes, crimeID)
+	delete(record.ReportedTo, crimeID)
+}
+
+// SetCrimeReported records that we've told the listener about a crime.
+func (m *Memory) SetCrimeReported(crimeID, listenerID uuid.UUID) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	record := m.crimeRecord()
+	record.ReportedTo[crimeID] = append(record.ReportedTo[crimeID], listenerID)
+}
+
+// SetCrimesStale notes that what we know of the law, or of its crimes, is out
+// of date.
+func (m *Memory) SetCrimesStale() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	record := m.crimeRecord()
+	record.Stale = true
+	m.localStore[memoryCrimeRecord] = record
+}
+
+func (m *Memory) GetCrimesStale() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryCrimeRecord]
+	return !found || val.(crimeRecord).Stale
+}
+
+// IsLawEnforcer reports whether the law where we are lets us punish
+// offenders, and whether there's a jail to send them to.
+func (m *Memory) IsLawEnforcer() (enforcer bool, hasJail bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryCrimeRecord]
+	if !found {
+		return false, false
+	}
+	record := val.(crimeRecord)
+	return record.Lawful && record.Enforcer, record.HasJail
+}
+
+func (m *Memory) getKnownCrimes() []commands.CrimeInfo {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryCrimeRecord]
+	if !found {
+		return nil
+	}
+	record := val.(crimeRecord)
+	crimes := make([]commands.CrimeInfo, 0, len(record.Crimes))
+	for _, crime := range record.Crimes {
+		crimes = append(crimes, crime)
+	}
+	// worst first, then oldest first, so we deal with crimes in a stable order
+	sort.Slice(crimes, func(i, j int) bool {
+		if crimes[i].Severity != crimes[j].Severity {
+			return crimes[i].Severity > crimes[j].Severity
+		}
+		return crimes[i].CommittedAt.Before(crimes[j].CommittedAt)
+	})
+	return crimes
+}
+
+func (m *Memory) wasCrimeReportedTo(crimeID, listenerID uuid.UUID) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	val, found := m.localStore[memoryCrimeRecord]
+	if !found {
+		return false
+	}
+	return uuid2.UUIDList(val.(crimeRecord).ReportedTo[crimeID]).IndexOf(listenerID) != -1
+}
+
 // Derived queries
 
+// IsActorInLocation reports whether the given Actor is where we are.
+func (m *Memory) IsActorInLocation(actorID uuid.UUID) bool {
+	currentZoneID, currentLocID := m.GetCurrentZoneAndLocationID()
+	locInfo, err := m.GetLocationInfo(currentZoneID, currentLocID)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		return false
+	}
+	return uuid2.UUIDList(locInfo.Actors).IndexOf(actorID) != -1
+}
+
+// IsWard reports whether the given Actor is one we've been hired to
+// protect: our employer, and our friends.
+func (m *Memory) IsWard(actorID uuid.UUID) bool {
+	contract, hired := m.GetHirelingContract()
+	if !hired {
+		return false
+	}
+	return uuid.Equal(actorID, contract.EmployerID) || uuid2.UUIDList(contract.Friends).IndexOf(actorID) != -1
+}
+
+// GetFollowTargetID returns the Actor we should stay close to: whoever we've
+// been told to guard, or otherwise our employer. It's uuid.Nil if we're not
+// a hireling, or have been told to stay put.
+func (m *Memory) GetFollowTargetID() uuid.UUID {
+	contract, hired := m.GetHirelingContract()
+	if !hired {
+		return uuid.Nil
+	}
+	switch contract.Order {
+	case core.HirelingOrderStay:
+		return uuid.Nil
+	case core.HirelingOrderGuard:
+		return contract.OrderTargetID
+	default:
+		return contract.EmployerID
+	}
+}
+
+// GetDirectionToFollowTarget returns the direction to move in to catch up
+// with the Actor we're following, if we saw which way it went; otherwise
+// it returns "".
+func (m *Memory) GetDirectionToFollowTarget() string {
+	targetID := m.GetFollowTargetID()
+	if uuid.Equal(targetID, uuid.Nil) || m.IsActorInLocation(targetID) {
+		return ""
+	}
+	dest, found := m.GetActorDeparture(targetID)
+	if !found {
+		return ""
+	}
+	currentZoneID, currentLocID := m.GetCurrentZoneAndLocationID()
+	locInfo, err := m.GetLocationInfo(currentZoneID, currentLocID)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		return ""
+	}
+	for direction, exitDest := range locInfo.Exits {
+		if exitDest == dest {
+			return direction
+		}
+	}
+	return ""
+}
+
+// FindEnemyInLocation returns an enemy where we are, if there is one. Enemies
+// are those our employer told us to attack, and anyone who has recently
+// attacked one of our wards.
+func (m *Memory) FindEnemyInLocation() uuid.UUID {
+	contract, hired := m.GetHirelingContract()
+	if !hired {
+		return uuid.Nil
+	}
+	candidates := append([]uuid.UUID(nil), contract.Enemies...)
+	wardAttackerID, secondsAgo := m.GetLastWardAttacker()
+	if secondsAgo < 15.0 && !m.IsWard(uuid.UUID(wardAttackerID)) {
+		candidates = append(candidates, uuid.UUID(wardAttackerID))
+	}
+	for _, actorID := range candidates {
+		if m.IsActorInLocation(actorID) {
+			return actorID
+		}
+	}
+	return uuid.Nil
+}
+
+// FindHostileInLocation returns an Actor where we are who is hostile to one
+// of our factions, if there is one.
+func (m *Memory) FindHostileInLocation() uuid.UUID {
+	selfInfo, err := m.GetActorInfo(m.intellect.actorID)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		return uuid.Nil
+	}
+	if len(selfInfo.Factions) == 0 {
+		return uuid.Nil
+	}
+	currentZoneID, currentLocID := m.GetCurrentZoneAndLocationID()
+	locInfo, err := m.GetLocationInfo(currentZoneID, currentLocID)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		return uuid.Nil
+	}
+	for _, actorID := range locInfo.Actors {
+		if uuid.Equal(actorID, m.intellect.actorID) || m.IsWard(actorID) {
+			continue
+		}
+		actorInfo, err := m.GetActorInfo(actorID)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: %s\n", err)
+			continue
+		}
+		for _, hostileTo := range actorInfo.HostileFactions {
+			for _, ourFaction := range selfInfo.Factions {
+				if hostileTo == ourFaction {
+					return actorID
+				}
+			}
+		}
+	}
+	return uuid.Nil
+}
+
+// GetLowestReputationInLocation returns the worst reputation any Actor where
+// we are has with one of our factions, or 0 if we belong to none.
+func (m *Memory) GetLowestReputationInLocation() int {
+	selfInfo, err := m.GetActorInfo(m.intellect.actorID)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		return 0
+	}
+	currentZoneID, currentLocID := m.GetCurrentZoneAndLocationID()
+	locInfo, err := m.GetLocationInfo(currentZoneID, currentLocID)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		return 0
+	}
+	var lowest int
+	for _, actorID := range locInfo.Actors {
+		if uuid.Equal(actorID, m.intellect.actorID) {
+			continue
+		}
+		actorInfo, err := m.GetActorInfo(actorID)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: %s\n", err)
+			continue
+		}
+		for _, ourFaction := range selfInfo.Factions {
+			if actorInfo.Reputation[ourFaction] < lowest {
+				lowest = actorInfo.Reputation[ourFaction]
+			}
+		}
+	}
+	return lowest
+}
+
+// FindCriminalInLocation returns the worst unresolved crime we know of whose
+// offender is where we are, if we're empowered to punish it.
+func (m *Memory) FindCriminalInLocation() (commands.CrimeInfo, bool) {
+	if enforcer, _ := m.IsLawEnforcer(); !enforcer {
+		return commands.CrimeInfo{}, false
+	}
+	for _, crime := range m.getKnownCrimes() {
+		if !m.IsActorInLocation(crime.OffenderID) {
+			continue
+		}
+		// ghosts can't be punished; they'll keep until they respawn
+		offenderInfo, err := m.GetActorInfo(crime.OffenderID)
+		if err != nil || offenderInfo.IsGhost {
+			continue
+		}
+		return crime, true
+	}
+	return commands.CrimeInfo{}, false
+}
+
+// FindCrimeToReport returns an unresolved crime we know of and a fellow
+// member of one of our factions, where we are, whom we haven't yet told about
+// it.
+func (m *Memory) FindCrimeToReport() (uuid.UUID, uuid.UUID, bool) {
+	crimes := m.getKnownCrimes()
+	if len(crimes) == 0 {
+		return uuid.Nil, uuid.Nil, false
+	}
+	selfInfo, err := m.GetActorInfo(m.intellect.actorID)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		return uuid.Nil, uuid.Nil, false
+	}
+	if len(selfInfo.Factions) == 0 {
+		return uuid.Nil, uuid.Nil, false
+	}
+	currentZoneID, currentLocID := m.GetCurrentZoneAndLocationID()
+	locInfo, err := m.GetLocationInfo(currentZoneID, currentLocID)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		return uuid.Nil, uuid.Nil, false
+	}
+	for _, actorID := range locInfo.Actors {
+		if uuid.Equal(actorID, m.intellect.actorID) {
+			continue
+		}
+		actorInfo, err := m.GetActorInfo(actorID)
+		if err != nil {
+			fmt.Printf("BRAIN ERROR: %s\n", err)
+			continue
+		}
+		if actorInfo.IsGhost || !sharesFaction(selfInfo.Factions, actorInfo.Factions) {
+			continue
+		}
+		for _, crime := range crimes {
+			if uuid.Equal(crime.OffenderID, actorID) || m.wasCrimeReportedTo(crime.ID, actorID) {
+				continue
+			}
+			return crime.ID, actorID, true
+		}
+	}
+	return uuid.Nil, uuid.Nil, false
+}
+
+func sharesFaction(ours, theirs []string) bool {
+	for _, a := range ours {
+		for _, b := range theirs {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (m *Memory) IsWeaponOnGround() bool {
 	currentZoneID, currentLocID := m.GetCurrentZoneAndLocationID()
 	locInfo, err := m.GetLocationInfo(currentZoneID, currentLocID)
@@ -6,7 +6,15 @@ const (
 	memoryLastMovementTimestamp = "last-movement-timestamp"
 	memoryLastAttackedTimestamp = "last-attacked-timestamp"
 	memoryLastAttackerID        = "last-attacker-ID"
+	memoryEngagedWithID         = "engaged-with-ID"
 	memoryZoneLocInfoMap        = "zone-location-info-map"
 	memoryActorInfoMap          = "actor-info-map"
 	memoryObjectInfoMap         = "object-info-map"
+	memoryHirelingContract      = "hireling-contract"
+	memoryLastWardAttackerID    = "last-ward-attacker-ID"
+	memoryLastWardAttackedTS    = "last-ward-attacked-timestamp"
+	memoryActorDepartureMap     = "actor-departure-map"
+	memoryPendingReport         = "pending-report"
+	memoryPendingDisengage      = "pending-disengage"
+	memoryCrimeRecord           = "crime-record"
 )
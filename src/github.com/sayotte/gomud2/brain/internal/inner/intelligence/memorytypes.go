@@ -61,6 +61,12 @@ func (js jsonString) MarshalJSON() ([]byte, error) {
 //	return json.Marshal(map[uuid.UUID]map[string][2]uuid.UUID(ltem))
 //}
 
+type jsonBool bool
+
+func (jb jsonBool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bool(jb))
+}
+
 type jsonZoneInfoMap map[uuid.UUID]map[uuid.UUID]locInfoEntry
 
 func (jzim jsonZoneInfoMap) MarshalJSON() ([]byte, error) {
@@ -98,3 +104,47 @@ type objectInfoMap map[objectIDTyp]objectInfoEntry
 func (oim objectInfoMap) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[objectIDTyp]objectInfoEntry(oim))
 }
+
+// hirelingContract is what we know of our contract with our employer, if
+// we're a hireling.
+type hirelingContract struct {
+	EmployerID      uuid.UUID
+	EmployerName    string
+	HiredUntil      time.Time
+	Friends         []uuid.UUID
+	Enemies         []uuid.UUID
+	Order           string
+	OrderTargetID   uuid.UUID
+	OrderTargetName string
+}
+
+func (hc hirelingContract) MarshalJSON() ([]byte, error) {
+	type plain hirelingContract
+	return json.Marshal(plain(hc))
+}
+
+// crimeRecord is what we know of the law where we are, and of the unresolved
+// crimes committed there; ReportedTo tracks whom we've told about each crime,
+// by crime ID. Stale means we've learned of a change we don't have the details
+// of, and should ask again.
+type crimeRecord struct {
+	Lawful     bool
+	Enforcer   bool
+	HasJail    bool
+	Crimes     map[uuid.UUID]commands.CrimeInfo
+	ReportedTo map[uuid.UUID][]uuid.UUID
+	Stale      bool
+}
+
+func (cr crimeRecord) MarshalJSON() ([]byte, error) {
+	type plain crimeRecord
+	return json.Marshal(plain(cr))
+}
+
+// actorDepartureMap records where Actors went when they left our location,
+// as ZoneID/LocationID tuples.
+type actorDepartureMap map[uuid.UUID][2]uuid.UUID
+
+func (adm actorDepartureMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[uuid.UUID][2]uuid.UUID(adm))
+}
@@ -11,6 +11,14 @@ const (
 	planGoalDoNothing           = "do-nothing"
 	planGoalMoveToEmptyLocation = "move-to-emptier-location"
 	planGoalDefendSelf          = "defend-self"
+	planGoalAttackEnemy         = "attack-enemy"
+	planGoalFollow              = "follow"
+	planGoalAwaitOrders         = "await-orders"
+	planGoalReport              = "report-status"
+	planGoalStandDown           = "stand-down"
+	planGoalRepelHostile        = "repel-hostile"
+	planGoalApprehendCriminal   = "apprehend-criminal"
+	planGoalReportCrime         = "report-crime"
 )
 
 type planner struct {
@@ -71,6 +79,23 @@ func (p *planner) generatePlan(oldPlan executionPlan) executionPlan {
 	case planGoalDefendSelf:
 		plan := &combatPlan{
 			actorID:          p.actorID,
+			targetID:         uuid.UUID(p.memory.GetLastAttacker()),
+			availableActions: allActionsBase,
+		}
+		plan.plan(p.memory)
+		return plan
+	case planGoalAttackEnemy:
+		plan := &combatPlan{
+			actorID:          p.actorID,
+			targetID:         p.memory.FindEnemyInLocation(),
+			availableActions: allActionsBase,
+		}
+		plan.plan(p.memory)
+		return plan
+	case planGoalRepelHostile:
+		plan := &combatPlan{
+			actorID:          p.actorID,
+			targetID:         p.memory.FindHostileInLocation(),
 			availableActions: allActionsBase,
 		}
 		plan.plan(p.memory)
@@ -48,6 +48,32 @@ func melee(meleeType string, targetID uuid.UUID, msgSender MessageSender, intell
 	return sendSyncMessage(wsapi.MessageTypeMeleeCombatCommand, cmd, msgSender, intellect)
 }
 
+func disengage(msgSender MessageSender, intellect *Intellect) error {
+	return sendSyncMessage(wsapi.MessageTypeDisengageCombatCommand, nil, msgSender, intellect)
+}
+
+func punishCrime(crimeID uuid.UUID, resolution string, fine int, msgSender MessageSender, intellect *Intellect) error {
+	cmd := wsapi.CommandPunishCrime{
+		CrimeID:    crimeID,
+		Resolution: resolution,
+		Fine:       fine,
+	}
+	return sendSyncMessage(wsapi.MessageTypePunishCrimeCommand, cmd, msgSender, intellect)
+}
+
+func reportCrime(crimeID, listenerID uuid.UUID, msgSender MessageSender, intellect *Intellect) error {
+	cmd := wsapi.CommandReportCrime{
+		CrimeID:    crimeID,
+		ListenerID: listenerID,
+	}
+	return sendSyncMessage(wsapi.MessageTypeReportCrimeCommand, cmd, msgSender, intellect)
+}
+
+func speak(speech string, msgSender MessageSender, intellect *Intellect) error {
+	cmd := wsapi.CommandSpeak{Speech: speech}
+	return sendSyncMessage(wsapi.MessageTypeSpeakCommand, cmd, msgSender, intellect)
+}
+
 func sendSyncMessage(msgType string, payload interface{}, msgSender MessageSender, intellect *Intellect) error {
 	msgID := uuid2.NewId()
 	waiter := &sync.WaitGroup{}
@@ -77,5 +103,5 @@ func sendSyncMessage(msgType string, payload interface{}, msgSender MessageSende
 	}
 
 	waiter.Wait()
-	return nil
+	return err
 }
@@ -74,11 +74,153 @@ func getStockUtilitySelector() UtilitySelector {
 		Considerations: []UtilityConsideration{recentlyAttacked, attackerPresent},
 	}
 
+	// Hirelings answer to their employer before anything else, then look
+	// after their wards, and otherwise stick close and wait to be told what
+	// to do rather than wandering off.
+	pendingReport := UtilityConsideration{
+		Name:        "employer-asked-for-report",
+		CurveXParam: "hasPendingReport",
+		XParamRange: [2]float64{0.0, 1.0},
+		M:           1.0,
+		K:           1.0,
+		B:           0.0,
+		C:           0.0,
+	}
+	reportSelection := UtilitySelection{
+		Name:           planGoalReport,
+		Weight:         2.0,
+		Considerations: []UtilityConsideration{pendingReport},
+	}
+
+	pendingDisengage := UtilityConsideration{
+		Name:        "employer-ordered-stand-down",
+		CurveXParam: "hasPendingDisengage",
+		XParamRange: [2]float64{0.0, 1.0},
+		M:           1.0,
+		K:           1.0,
+		B:           0.0,
+		C:           0.0,
+	}
+	standDownSelection := UtilitySelection{
+		Name:           planGoalStandDown,
+		Weight:         1.5,
+		Considerations: []UtilityConsideration{pendingDisengage},
+	}
+
+	enemyPresent := UtilityConsideration{
+		Name:        "enemy-present",
+		CurveXParam: "enemyInLocation",
+		XParamRange: [2]float64{0.0, 1.0},
+		M:           1.0,
+		K:           1.0,
+		B:           0.0,
+		C:           0.0,
+	}
+	attackEnemySelection := UtilitySelection{
+		Name:           planGoalAttackEnemy,
+		Weight:         1.0,
+		Considerations: []UtilityConsideration{enemyPresent},
+	}
+
+	hostilePresent := UtilityConsideration{
+		Name:        "hostile-present",
+		CurveXParam: "hostileInLocation",
+		XParamRange: [2]float64{0.0, 1.0},
+		M:           1.0,
+		K:           1.0,
+		B:           0.0,
+		C:           0.0,
+	}
+	// the worse the reputation of whoever is here, the more urgent it is
+	// to see them off
+	badReputationPresent := UtilityConsideration{
+		Name:        "bad-reputation-present",
+		CurveXParam: "lowestReputationInLocation",
+		XParamRange: [2]float64{-1000.0, -100.0},
+		M:           -0.5,
+		K:           1.0,
+		B:           1.0,
+		C:           0.0,
+	}
+	repelHostileSelection := UtilitySelection{
+		Name:           planGoalRepelHostile,
+		Weight:         0.97,
+		Considerations: []UtilityConsideration{hostilePresent, badReputationPresent},
+	}
+
+	criminalPresent := UtilityConsideration{
+		Name:        "criminal-present",
+		CurveXParam: "criminalInLocation",
+		XParamRange: [2]float64{0.0, 1.0},
+		M:           1.0,
+		K:           1.0,
+		B:           0.0,
+		C:           0.0,
+	}
+	apprehendCriminalSelection := UtilitySelection{
+		Name:           planGoalApprehendCriminal,
+		Weight:         1.05,
+		Considerations: []UtilityConsideration{criminalPresent},
+	}
+
+	crimeToReport := UtilityConsideration{
+		Name:        "crime-to-report",
+		CurveXParam: "crimeToReport",
+		XParamRange: [2]float64{0.0, 1.0},
+		M:           1.0,
+		K:           1.0,
+		B:           0.0,
+		C:           0.0,
+	}
+	reportCrimeSelection := UtilitySelection{
+		Name:           planGoalReportCrime,
+		Weight:         0.6,
+		Considerations: []UtilityConsideration{crimeToReport},
+	}
+
+	followTargetGone := UtilityConsideration{
+		Name:        "follow-target-left",
+		CurveXParam: "followTargetElsewhere",
+		XParamRange: [2]float64{0.0, 1.0},
+		M:           1.0,
+		K:           1.0,
+		B:           0.0,
+		C:           0.0,
+	}
+	followSelection := UtilitySelection{
+		Name:           planGoalFollow,
+		Weight:         0.95,
+		Considerations: []UtilityConsideration{followTargetGone},
+	}
+
+	hired := UtilityConsideration{
+		Name:        "under-contract",
+		CurveXParam: "isHired",
+		XParamRange: [2]float64{0.0, 1.0},
+		M:           1.0,
+		K:           1.0,
+		B:           0.0,
+		C:           0.0,
+	}
+	awaitOrdersSelection := UtilitySelection{
+		Name:           planGoalAwaitOrders,
+		Weight:         0.9,
+		Considerations: []UtilityConsideration{hired},
+	}
+
 	return UtilitySelector{
 		Selections: []UtilitySelection{
+			reportSelection,
+			standDownSelection,
 			moveSelection,
 			doNothingSelection,
 			defendSelfSelection,
+			attackEnemySelection,
+			repelHostileSelection,
+			apprehendCriminalSelection,
+			reportCrimeSelection,
+			followSelection,
+			awaitOrdersSelection,
 		},
 	}
 }
@@ -2,6 +2,9 @@ package intelligence
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/sayotte/gomud2/core"
 )
 
 type trivialPlan struct {
@@ -16,6 +19,18 @@ func (te *trivialPlan) executeStep(msgSender MessageSender, intellect *Intellect
 		return
 	case "move-to-emptier-location":
 		te.moveToAnyLocation(msgSender, intellect)
+	case "await-orders":
+		return
+	case "follow":
+		te.follow(msgSender, intellect)
+	case "report-status":
+		te.reportStatus(msgSender, intellect)
+	case "stand-down":
+		te.standDown(msgSender, intellect)
+	case "apprehend-criminal":
+		te.apprehendCriminal(msgSender, intellect)
+	case "report-crime":
+		te.reportCrime(msgSender, intellect)
 	default:
 		fmt.Printf("BRAIN WARNING: don't know how to execute goal %q\n", te.goalName)
 		te.executionStatus = executionPlanStatusFailed
@@ -49,6 +64,132 @@ func (te *trivialPlan) moveToAnyLocation(msgSender MessageSender, intellect *Int
 	}
 }
 
+func (te *trivialPlan) follow(msgSender MessageSender, intellect *Intellect) {
+	direction := te.memory.GetDirectionToFollowTarget()
+	if direction == "" {
+		te.executionStatus = executionPlanStatusComplete
+		return
+	}
+	_, err := moveSelf(direction, msgSender, intellect)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		te.executionStatus = executionPlanStatusFailed
+		return
+	}
+	te.executionStatus = executionPlanStatusComplete
+}
+
+func (te *trivialPlan) reportStatus(msgSender MessageSender, intellect *Intellect) {
+	te.memory.SetPendingReport(false)
+	contract, hired := te.memory.GetHirelingContract()
+	if !hired {
+		te.executionStatus = executionPlanStatusComplete
+		return
+	}
+
+	var speech string
+	switch contract.Order {
+	case core.HirelingOrderAttack:
+		if te.memory.IsActorInLocation(contract.OrderTargetID) {
+			speech = fmt.Sprintf("I'm going after %s, as you ordered.", contract.OrderTargetName)
+		} else {
+			speech = fmt.Sprintf("I've orders to attack %s, but I don't see them here, so I'm sticking with you.", contract.OrderTargetName)
+		}
+	case core.HirelingOrderGuard:
+		speech = fmt.Sprintf("I'm guarding %s, as you ordered.", contract.OrderTargetName)
+	case core.HirelingOrderStay:
+		speech = "I'm holding this spot until you tell me otherwise."
+	case core.HirelingOrderStandDown:
+		speech = fmt.Sprintf("I've stood down, and I'm following you, %s.", contract.EmployerName)
+	default:
+		speech = fmt.Sprintf("I'm following you, %s.", contract.EmployerName)
+	}
+	minutesLeft := int(time.Until(contract.HiredUntil) / time.Minute)
+	switch {
+	case minutesLeft < 1:
+		speech += " My contract is nearly up."
+	case minutesLeft == 1:
+		speech += " My contract has about a minute left to run."
+	default:
+		speech += fmt.Sprintf(" My contract has about %d minutes left to run.", minutesLeft)
+	}
+
+	err := speak(speech, msgSender, intellect)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		te.executionStatus = executionPlanStatusFailed
+		return
+	}
+	te.executionStatus = executionPlanStatusComplete
+}
+
+func (te *trivialPlan) standDown(msgSender MessageSender, intellect *Intellect) {
+	te.memory.SetPendingDisengage(false)
+	// we may not have been engaged with anyone, in which case the server
+	// tells us so; that's fine, we've stood down either way
+	_ = disengage(msgSender, intellect)
+	te.executionStatus = executionPlanStatusComplete
+}
+
+// crimeFinePerSeverity is how many coins we fine an offender for each point
+// of a crime's severity.
+const crimeFinePerSeverity = 10
+
+func (te *trivialPlan) apprehendCriminal(msgSender MessageSender, intellect *Intellect) {
+	crime, found := te.memory.FindCriminalInLocation()
+	if !found {
+		te.executionStatus = executionPlanStatusComplete
+		return
+	}
+
+	// the punishment fits the crime, with a fallback if the first choice
+	// isn't possible, e.g. because the offender can't pay a fine
+	_, hasJail := te.memory.IsLawEnforcer()
+	var punishments []string
+	switch crime.Type {
+	case core.CrimeTypeMurder:
+		punishments = []string{core.CrimeResolutionExecuted}
+	case core.CrimeTypeAssault:
+		punishments = []string{core.CrimeResolutionJailed, core.CrimeResolutionFined}
+	default:
+		punishments = []string{core.CrimeResolutionFined, core.CrimeResolutionJailed}
+	}
+	for _, punishment := range punishments {
+		if punishment == core.CrimeResolutionJailed && !hasJail {
+			continue
+		}
+		err := punishCrime(crime.ID, punishment, crime.Severity*crimeFinePerSeverity, msgSender, intellect)
+		if err == nil {
+			te.memory.ForgetCrime(crime.ID)
+			te.executionStatus = executionPlanStatusComplete
+			return
+		}
+		fmt.Printf("BRAIN DEBUG: couldn't punish %s with %q: %s\n", crime.OffenderName, punishment, err)
+	}
+
+	// perhaps someone else has dealt with it already; find out
+	te.memory.SetCrimesStale()
+	te.executionStatus = executionPlanStatusFailed
+}
+
+func (te *trivialPlan) reportCrime(msgSender MessageSender, intellect *Intellect) {
+	crimeID, listenerID, found := te.memory.FindCrimeToReport()
+	if !found {
+		te.executionStatus = executionPlanStatusComplete
+		return
+	}
+	// only try once per listener, so a failure doesn't have us repeating
+	// ourselves forever
+	te.memory.SetCrimeReported(crimeID, listenerID)
+	err := reportCrime(crimeID, listenerID, msgSender, intellect)
+	if err != nil {
+		fmt.Printf("BRAIN ERROR: %s\n", err)
+		te.executionStatus = executionPlanStatusFailed
+		return
+	}
+	te.executionStatus = executionPlanStatusComplete
+}
+
 func (te trivialPlan) status() int {
 	return te.executionStatus
 }
@@ -111,6 +111,48 @@ func (uc UtilityConsideration) getParam(paramName string, memory *Memory) float6
 			}
 		}
 		return 0
+	case "hasPendingReport":
+		if memory.GetPendingReport() {
+			return 1.0
+		}
+		return 0
+	case "hasPendingDisengage":
+		if memory.GetPendingDisengage() {
+			return 1.0
+		}
+		return 0
+	case "enemyInLocation":
+		if uuid.Equal(memory.FindEnemyInLocation(), uuid.Nil) {
+			return 0
+		}
+		return 1.0
+	case "hostileInLocation":
+		if uuid.Equal(memory.FindHostileInLocation(), uuid.Nil) {
+			return 0
+		}
+		return 1.0
+	case "lowestReputationInLocation":
+		return float64(memory.GetLowestReputationInLocation())
+	case "criminalInLocation":
+		if _, found := memory.FindCriminalInLocation(); found {
+			return 1.0
+		}
+		return 0
+	case "crimeToReport":
+		if _, _, found := memory.FindCrimeToReport(); found {
+			return 1.0
+		}
+		return 0
+	case "followTargetElsewhere":
+		if memory.GetDirectionToFollowTarget() == "" {
+			return 0
+		}
+		return 1.0
+	case "isHired":
+		if _, hired := memory.GetHirelingContract(); hired {
+			return 1.0
+		}
+		return 0
 	case "always-1.0":
 		return 1.0
 	default:
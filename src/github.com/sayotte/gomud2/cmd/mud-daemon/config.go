@@ -13,11 +13,20 @@ type mudConfig struct {
 	Telnet    telnetConfig    `yaml:"telnet"`
 	WSAPI     wsAPIConfig     `yaml:"wsAPI"`
 	SpawnReap spawnReapConfig `yaml:"spawnReap"`
+	// optional, the core's defaults are used if this is absent
+	PlayerDeath *playerDeathConfig `yaml:"playerDeath,omitempty"`
+	Sorcery     sorceryConfig      `yaml:"sorcery"`
+	Mysticism   mysticismConfig    `yaml:"mysticism"`
+	Factions    factionsConfig     `yaml:"factions"`
+	Quests      questsConfig       `yaml:"quests"`
+	Crafting    craftingConfig     `yaml:"crafting"`
+	Objects     objectsConfig      `yaml:"objects"`
 }
 
 type worldConfig struct {
 	DefaultZoneID     uuid.UUID `yaml:"defaultZoneID"`
 	DefaultLocationID uuid.UUID `yaml:"defaultLocationID"`
+	PantheonID        uuid.UUID `yaml:"pantheonID"`
 	ZonesToLoad       []string  `yaml:"zonesToLoad"`
 }
 
@@ -37,9 +46,40 @@ type wsAPIConfig struct {
 }
 
 type spawnReapConfig struct {
-	SpawnsConfigFile    string `yaml:"spawnsConfigFile"`
-	TicksUntilReap      int    `yaml:"ticksUntilReap"`
-	TickLengthInSeconds int    `yaml:"tickLengthInSeconds"`
+	SpawnsConfigFile       string `yaml:"spawnsConfigFile"`
+	ObjectSpawnsConfigFile string `yaml:"objectSpawnsConfigFile"`
+	TicksUntilReap         int    `yaml:"ticksUntilReap"`
+	TickLengthInSeconds    int    `yaml:"tickLengthInSeconds"`
+}
+
+type playerDeathConfig struct {
+	GhostDurationInSeconds int     `yaml:"ghostDurationInSeconds"`
+	SkillLossFraction      float64 `yaml:"skillLossFraction"`
+	LeaveGearOnCorpse      bool    `yaml:"leaveGearOnCorpse"`
+}
+
+type sorceryConfig struct {
+	ReactionsFile string `yaml:"reactionsFile"`
+}
+
+type mysticismConfig struct {
+	PrayersFile string `yaml:"prayersFile"`
+}
+
+type factionsConfig struct {
+	FactionsFile string `yaml:"factionsFile"`
+}
+
+type questsConfig struct {
+	QuestsFile string `yaml:"questsFile"`
+}
+
+type craftingConfig struct {
+	RecipesFile string `yaml:"recipesFile"`
+}
+
+type objectsConfig struct {
+	PrototypesFile string `yaml:"prototypesFile"`
 }
 
 func (mc mudConfig) SerializeToFile(filename string) error {
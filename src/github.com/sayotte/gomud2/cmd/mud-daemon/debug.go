@@ -44,6 +44,50 @@ var eventTypeToStringName = map[int]string{
 	core.EventTypeObjectMigrateOut:       "ObjectMigrateOutEvent",
 	core.EventTypeZoneSetDefaultLocation: "ZoneSetDefaultLocationEvent",
 	core.EventTypeCombatMeleeDamage:      "CombatMeleeDamageEvent",
+	core.EventTypeActorBecomeGhost:       "ActorBecomeGhostEvent",
+	core.EventTypeActorRespawn:           "ActorRespawnEvent",
+	core.EventTypeActorSetBindLocation:   "ActorSetBindLocationEvent",
+	core.EventTypeSorceryInvoke:          "SorceryInvokeEvent",
+	core.EventTypeActorLearnReaction:     "ActorLearnReactionEvent",
+	core.EventTypeDeityAdd:               "DeityAddEvent",
+	core.EventTypeDeitySacrifice:         "DeitySacrificeEvent",
+	core.EventTypeDeityPrayerAnswered:    "DeityPrayerAnsweredEvent",
+	core.EventTypeActorDedicate:          "ActorDedicateEvent",
+	core.EventTypeActorSacrifice:         "ActorSacrificeEvent",
+	core.EventTypeActorPray:              "ActorPrayEvent",
+	core.EventTypeActorLearnTechnique:    "ActorLearnTechniqueEvent",
+	core.EventTypeActorScribe:            "ActorScribeEvent",
+	core.EventTypeActorExert:             "ActorExertEvent",
+	core.EventTypeExitSetDoor:            "ExitSetDoorEvent",
+	core.EventTypeExitDoor:               "ExitDoorEvent",
+	core.EventTypeObjectContainerAction:  "ObjectContainerActionEvent",
+	core.EventTypeObjectCoins:            "ObjectCoinsEvent",
+	core.EventTypeShopTrade:              "ShopTradeEvent",
+	core.EventTypeActorSetShop:           "ActorSetShopEvent",
+	core.EventTypeActorSetHireTerms:      "ActorSetHireTermsEvent",
+	core.EventTypeActorHire:              "ActorHireEvent",
+	core.EventTypeActorRelease:           "ActorReleaseEvent",
+	core.EventTypeActorRelationship:      "ActorRelationshipEvent",
+	core.EventTypeActorOrder:             "ActorOrderEvent",
+	core.EventTypeActorJoinFaction:       "ActorJoinFactionEvent",
+	core.EventTypeActorLeaveFaction:      "ActorLeaveFactionEvent",
+	core.EventTypeActorReputation:        "ActorReputationEvent",
+	core.EventTypeZoneSetLaw:             "ZoneSetLawEvent",
+	core.EventTypeCrimeCommit:            "CrimeCommitEvent",
+	core.EventTypeCrimeReport:            "CrimeReportEvent",
+	core.EventTypeCrimeResolve:           "CrimeResolveEvent",
+	core.EventTypeObjectOwnership:        "ObjectOwnershipEvent",
+	core.EventTypeActorQuestAccept:       "ActorQuestAcceptEvent",
+	core.EventTypeActorQuestProgress:     "ActorQuestProgressEvent",
+	core.EventTypeActorQuestComplete:     "ActorQuestCompleteEvent",
+	core.EventTypeActorQuestAbandon:      "ActorQuestAbandonEvent",
+	core.EventTypeActorConsume:           "ActorConsumeEvent",
+	core.EventTypeActorEffect:            "ActorEffectEvent",
+	core.EventTypeActorEffectStart:       "ActorEffectStartEvent",
+	core.EventTypeActorEffectEnd:         "ActorEffectEndEvent",
+	core.EventTypeActorCraft:             "ActorCraftEvent",
+	core.EventTypeObjectPrototypeUpdate:  "ObjectPrototypeUpdateEvent",
+	core.EventTypeDeityReversal:          "DeityReversalEvent",
 }
 
 type debugger struct {
@@ -577,6 +621,114 @@ func (ab actorBreakpoint) shouldBreak(e core.Event) bool {
 	case core.EventTypeActorMigrateOut:
 		typed := e.(*core.ActorMigrateOutEvent)
 		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorBecomeGhost:
+		typed := e.(*core.ActorBecomeGhostEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorRespawn:
+		typed := e.(*core.ActorRespawnEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorSetBindLocation:
+		typed := e.(*core.ActorSetBindLocationEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeSorceryInvoke:
+		typed := e.(*core.SorceryInvokeEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID) || uuid.Equal(typed.TargetID, ab.actorID)
+	case core.EventTypeActorLearnReaction:
+		typed := e.(*core.ActorLearnReactionEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorLearnTechnique:
+		typed := e.(*core.ActorLearnTechniqueEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorScribe:
+		typed := e.(*core.ActorScribeEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorExert:
+		typed := e.(*core.ActorExertEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeExitDoor:
+		typed := e.(*core.ExitDoorEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeObjectContainerAction:
+		typed := e.(*core.ObjectContainerActionEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeShopTrade:
+		typed := e.(*core.ShopTradeEvent)
+		return uuid.Equal(typed.ShopkeeperID, ab.actorID) || uuid.Equal(typed.CustomerID, ab.actorID)
+	case core.EventTypeActorSetShop:
+		typed := e.(*core.ActorSetShopEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorSetHireTerms:
+		typed := e.(*core.ActorSetHireTermsEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorHire:
+		typed := e.(*core.ActorHireEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID) || uuid.Equal(typed.EmployerID, ab.actorID)
+	case core.EventTypeActorRelease:
+		typed := e.(*core.ActorReleaseEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID) || uuid.Equal(typed.EmployerID, ab.actorID)
+	case core.EventTypeActorRelationship:
+		typed := e.(*core.ActorRelationshipEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID) || uuid.Equal(typed.OtherID, ab.actorID)
+	case core.EventTypeActorOrder:
+		typed := e.(*core.ActorOrderEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID) || uuid.Equal(typed.EmployerID, ab.actorID) || uuid.Equal(typed.Order.TargetID, ab.actorID)
+	case core.EventTypeActorJoinFaction:
+		typed := e.(*core.ActorJoinFactionEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorLeaveFaction:
+		typed := e.(*core.ActorLeaveFactionEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorReputation:
+		typed := e.(*core.ActorReputationEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeCrimeCommit:
+		typed := e.(*core.CrimeCommitEvent)
+		return uuid.Equal(typed.Crime.OffenderID, ab.actorID) || uuid.Equal(typed.Crime.VictimID, ab.actorID)
+	case core.EventTypeCrimeReport:
+		typed := e.(*core.CrimeReportEvent)
+		return uuid.Equal(typed.ReporterID, ab.actorID) || uuid.Equal(typed.ListenerID, ab.actorID)
+	case core.EventTypeCrimeResolve:
+		typed := e.(*core.CrimeResolveEvent)
+		return uuid.Equal(typed.OffenderID, ab.actorID) || uuid.Equal(typed.EnforcerID, ab.actorID)
+	case core.EventTypeObjectOwnership:
+		typed := e.(*core.ObjectOwnershipEvent)
+		return uuid.Equal(typed.Record.ActorID, ab.actorID) || uuid.Equal(typed.Record.OwnerID, ab.actorID)
+	case core.EventTypeActorQuestAccept:
+		typed := e.(*core.ActorQuestAcceptEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorQuestProgress:
+		typed := e.(*core.ActorQuestProgressEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorQuestComplete:
+		typed := e.(*core.ActorQuestCompleteEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorQuestAbandon:
+		typed := e.(*core.ActorQuestAbandonEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorConsume:
+		typed := e.(*core.ActorConsumeEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorEffect:
+		typed := e.(*core.ActorEffectEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorEffectStart:
+		typed := e.(*core.ActorEffectStartEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorEffectEnd:
+		typed := e.(*core.ActorEffectEndEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorCraft:
+		typed := e.(*core.ActorCraftEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorDedicate:
+		typed := e.(*core.ActorDedicateEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorSacrifice:
+		typed := e.(*core.ActorSacrificeEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID)
+	case core.EventTypeActorPray:
+		typed := e.(*core.ActorPrayEvent)
+		return uuid.Equal(typed.ActorID, ab.actorID) || uuid.Equal(typed.TargetID, ab.actorID)
 	case core.EventTypeObjectMove:
 		typed := e.(*core.ObjectMoveEvent)
 		if uuid.Equal(typed.ActorID, ab.actorID) {
@@ -613,6 +765,12 @@ func (eb exitBreakpoint) shouldBreak(e core.Event) bool {
 	case core.EventTypeExitRemoveFromZone:
 		typed := e.(*core.ExitRemoveFromZoneEvent)
 		return uuid.Equal(typed.ExitID, eb.exitID)
+	case core.EventTypeExitSetDoor:
+		typed := e.(*core.ExitSetDoorEvent)
+		return uuid.Equal(typed.ExitID, eb.exitID)
+	case core.EventTypeExitDoor:
+		typed := e.(*core.ExitDoorEvent)
+		return uuid.Equal(typed.ExitID, eb.exitID)
 	default:
 		return false
 	}
@@ -649,6 +807,12 @@ func (lb locBreakpoint) shouldBreak(e core.Event) bool {
 	case core.EventTypeActorMigrateOut:
 		typed := e.(*core.ActorMigrateOutEvent)
 		return uuid.Equal(typed.FromLocID, lb.locID)
+	case core.EventTypeActorRespawn:
+		typed := e.(*core.ActorRespawnEvent)
+		return uuid.Equal(typed.ToLocationID, lb.locID)
+	case core.EventTypeActorSetBindLocation:
+		typed := e.(*core.ActorSetBindLocationEvent)
+		return uuid.Equal(typed.LocationID, lb.locID)
 	case core.EventTypeLocationAddToZone:
 		typed := e.(*core.LocationAddToZoneEvent)
 		return uuid.Equal(typed.LocationID, lb.locID)
@@ -700,6 +864,15 @@ type objBreakpoint struct {
 
 func (ob objBreakpoint) shouldBreak(e core.Event) bool {
 	switch e.Type() {
+	case core.EventTypeObjectContainerAction:
+		typed := e.(*core.ObjectContainerActionEvent)
+		return uuid.Equal(typed.ObjectID, ob.objectID)
+	case core.EventTypeObjectCoins:
+		typed := e.(*core.ObjectCoinsEvent)
+		return uuid.Equal(typed.ObjectID, ob.objectID)
+	case core.EventTypeShopTrade:
+		typed := e.(*core.ShopTradeEvent)
+		return uuid.Equal(typed.ObjectID, ob.objectID)
 	case core.EventTypeObjectAddToZone:
 		typed := e.(*core.ObjectAddToZoneEvent)
 		return uuid.Equal(typed.ObjectID, ob.objectID)
@@ -739,6 +912,27 @@ func (ob objBreakpoint) shouldBreak(e core.Event) bool {
 	case core.EventTypeObjectMigrateOut:
 		typed := e.(*core.ObjectMigrateOutEvent)
 		return uuid.Equal(typed.ObjectID, ob.objectID)
+	case core.EventTypeObjectOwnership:
+		typed := e.(*core.ObjectOwnershipEvent)
+		return uuid.Equal(typed.ObjectID, ob.objectID)
+	case core.EventTypeObjectPrototypeUpdate:
+		typed := e.(*core.ObjectPrototypeUpdateEvent)
+		return uuid.Equal(typed.ObjectID, ob.objectID)
+	case core.EventTypeActorConsume:
+		typed := e.(*core.ActorConsumeEvent)
+		return uuid.Equal(typed.ObjectID, ob.objectID)
+	case core.EventTypeActorLearnReaction:
+		typed := e.(*core.ActorLearnReactionEvent)
+		return uuid.Equal(typed.ScrollID, ob.objectID)
+	case core.EventTypeActorLearnTechnique:
+		typed := e.(*core.ActorLearnTechniqueEvent)
+		return uuid.Equal(typed.ScrollID, ob.objectID)
+	case core.EventTypeActorScribe:
+		typed := e.(*core.ActorScribeEvent)
+		return uuid.Equal(typed.SourceID, ob.objectID) || uuid.Equal(typed.BlankID, ob.objectID)
+	case core.EventTypeActorSacrifice:
+		typed := e.(*core.ActorSacrificeEvent)
+		return uuid.Equal(typed.ObjectID, ob.objectID)
 	default:
 		return false
 	}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/sayotte/gomud2/core"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultFactionsFile = "factions.yaml"
+
+var defaultFactions = []core.Faction{
+	{
+		Name:                  "the citizens of Elm Street",
+		Description:           "Regulars at the bar and residents of the street, who look out for one another.",
+		Enemies:               []string{"the Foxhunt gang"},
+		DefaultReputation:     0,
+		MemberReputation:      200,
+		EnemyReputation:       -300,
+		HostileBelow:          -100,
+		FriendlyAtOrAbove:     100,
+		AttackedMemberPenalty: 50,
+		KilledMemberPenalty:   200,
+		KilledEnemyBonus:      25,
+	},
+	{
+		Name:                  "the Foxhunt gang",
+		Description:           "A band of toughs who've taken over the house on Elm Street.",
+		Enemies:               []string{"the citizens of Elm Street"},
+		DefaultReputation:     -50,
+		MemberReputation:      200,
+		EnemyReputation:       -300,
+		HostileBelow:          -100,
+		FriendlyAtOrAbove:     100,
+		AttackedMemberPenalty: 100,
+		KilledMemberPenalty:   300,
+		KilledEnemyBonus:      50,
+	},
+	{
+		Name:                  "the town watch",
+		Description:           "The watchmen who keep the peace on Elm Street, and the keys to its lockup.",
+		Enemies:               []string{"the Foxhunt gang"},
+		DefaultReputation:     0,
+		MemberReputation:      200,
+		EnemyReputation:       -200,
+		HostileBelow:          -100,
+		FriendlyAtOrAbove:     100,
+		AttackedMemberPenalty: 100,
+		KilledMemberPenalty:   300,
+		KilledEnemyBonus:      25,
+	},
+}
+
+func loadFactions(filename string) error {
+	fBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadFile(%q): %s", filename, err)
+	}
+	var factions []core.Faction
+	err = yaml.Unmarshal(fBytes, &factions)
+	if err != nil {
+		return fmt.Errorf("yaml.Unmarshal(): %s", err)
+	}
+	return core.SetFactions(factions)
+}
+
+func writeFactions(filename string, factions []core.Faction) error {
+	fBytes, err := yaml.Marshal(factions)
+	if err != nil {
+		return fmt.Errorf("yaml.Marshal(): %s", err)
+	}
+	err = ioutil.WriteFile(filename, fBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("ioutil.WriteFile(%q, ...): %s", filename, err)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"runtime/pprof"
 	"sync"
+	"time"
 
 	gouuid "github.com/satori/go.uuid"
 
@@ -98,6 +99,52 @@ func main() {
 		return
 	}
 
+	if cfg.PlayerDeath != nil {
+		core.PlayerGhostDuration = time.Second * time.Duration(cfg.PlayerDeath.GhostDurationInSeconds)
+		core.PlayerCharacterDeathPenalty = core.PlayerDeathPenalty{
+			SkillLossFraction: cfg.PlayerDeath.SkillLossFraction,
+			LeaveGearOnCorpse: cfg.PlayerDeath.LeaveGearOnCorpse,
+		}
+	}
+
+	if cfg.Sorcery.ReactionsFile != "" {
+		err = loadReactions(cfg.Sorcery.ReactionsFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if cfg.Mysticism.PrayersFile != "" {
+		err = loadPrayers(cfg.Mysticism.PrayersFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if cfg.Factions.FactionsFile != "" {
+		err = loadFactions(cfg.Factions.FactionsFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	// quests reward reputation with factions, so those must be loaded first
+	if cfg.Quests.QuestsFile != "" {
+		err = loadQuests(cfg.Quests.QuestsFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if cfg.Crafting.RecipesFile != "" {
+		err = loadRecipes(cfg.Crafting.RecipesFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if cfg.Objects.PrototypesFile != "" {
+		err = loadObjectPrototypes(cfg.Objects.PrototypesFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	world := core.NewWorld()
 	world.DataStore = &store.EventStore{
 		Filename:          cfg.Store.EventsFile,
@@ -107,10 +154,23 @@ func main() {
 	world.IntentLog = &store.IntentLogger{
 		Filename: cfg.Store.IntentLogfile,
 	}
+	if !gouuid.Equal(cfg.World.PantheonID, gouuid.Nil) {
+		err = world.LoadPantheon(cfg.World.PantheonID)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
 	err = world.LoadAndStart(cfg.World.ZonesToLoad, cfg.World.DefaultZoneID, cfg.World.DefaultLocationID)
 	if err != nil {
 		log.Fatal(err)
 	}
+	// roll out any changes made to the Object prototypes since we last ran
+	for _, zone := range world.Zones() {
+		err = zone.UpdateObjectsFromPrototypes()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	err = runWorld(world, cfg)
 	if err != nil {
@@ -129,6 +189,28 @@ func initStartingWorld(worldConfigFile string) error {
 		UseCompression: true,
 	}
 
+	pantheon := core.NewPantheon(gouuid.Nil, eStore)
+	pantheon.StartCommandProcessing()
+	for _, deity := range defaultDeities {
+		_, err := pantheon.AddDeity(deity.name, deity.description, deity.strength)
+		if err != nil {
+			panic(err)
+		}
+	}
+	pantheon.StopCommandProcessing()
+
+	// the starting world's Actors join factions, so those need to be known
+	// before they're written out with the rest of the configuration
+	err := core.SetFactions(defaultFactions)
+	if err != nil {
+		panic(err)
+	}
+	// likewise, much of the starting world is made from Object prototypes
+	err = core.SetObjectPrototypes(defaultObjectPrototypes)
+	if err != nil {
+		panic(err)
+	}
+
 	z := core.NewZone(gouuid.Nil, "overworld", eStore)
 	z.StartCommandProcessing()
 
@@ -159,6 +241,10 @@ func initStartingWorld(worldConfigFile string) error {
 	if err != nil {
 		panic(err)
 	}
+	err = loc2.SetTags([]string{"street"})
+	if err != nil {
+		panic(err)
+	}
 
 	exit1Prim := core.NewExit(
 		gouuid.Nil,
@@ -190,6 +276,41 @@ func initStartingWorld(worldConfigFile string) error {
 		panic(err)
 	}
 
+	shortDesc = "The town lockup"
+	longDesc = "A bare cell with a cot bolted to the wall. The barred door "
+	longDesc += "hangs open; nobody here expects those who've served their "
+	longDesc += "time to stay."
+	jailPrim := core.NewLocation(gouuid.Nil, z, shortDesc, longDesc)
+	jail, err := z.AddLocation(jailPrim)
+	if err != nil {
+		panic(err)
+	}
+
+	jailExitPrim := core.NewExit(
+		gouuid.Nil,
+		"A barred door",
+		core.ExitDirectionSouth,
+		jail,
+		loc1,
+		z,
+		gouuid.Nil,
+		gouuid.Nil,
+	)
+	_, err = z.AddExit(jailExitPrim)
+	if err != nil {
+		panic(err)
+	}
+
+	err = z.SetLaw(core.ZoneLaw{
+		Lawful:            true,
+		JailLocationID:    jail.ID(),
+		ProtectedFactions: []string{"the citizens of Elm Street", "the town watch"},
+		EnforcerFactions:  []string{"the town watch"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	//napkinPrim := core.NewObject(
 	//	gouuid.Nil,
 	//	"a crumpled up napkin",
@@ -207,19 +328,7 @@ func initStartingWorld(worldConfigFile string) error {
 	//	panic(err)
 	//}
 
-	swordPrim := core.NewObject(
-		gouuid.Nil,
-		"a sword",
-		"This was once the sort of napkin that bartenders put down so your drink doesn't leave a wet ring on the bar. Now it's crumpled into a ball.",
-		[]string{"sword"},
-		loc1,
-		0,
-		z,
-		core.ObjectAttributes{
-			SlashingDamageMin: 3.0,
-			SlashingDamageMax: 5.0,
-		},
-	)
+	swordPrim := mustObjectPrototype(swordPrototypeID).ToObject(loc1, z)
 	_, err = z.AddObject(swordPrim, loc1)
 	if err != nil {
 		panic(err)
@@ -231,10 +340,13 @@ func initStartingWorld(worldConfigFile string) error {
 		"A brown-paper bag, with the little twisted-paper handles that bougie department stores like to use so their customers can feel like they're not harming the environment when they purchase products made of processed, bleached baby animal souls.",
 		[]string{"bag"},
 		loc1,
-		20,
+		core.ObjectSizeMediumSlots+core.ObjectSizeSmallSlots,
 		z,
 		core.ObjectAttributes{
 			SlashingDamageMax: 2.0,
+			Weight:            0.5,
+			InventorySlots:    core.ObjectSizeMediumSlots,
+			Value:             2,
 		},
 	)
 	_, err = z.AddObject(bagPrim, loc1)
@@ -242,6 +354,223 @@ func initStartingWorld(worldConfigFile string) error {
 		panic(err)
 	}
 
+	chestPrim := core.NewObject(
+		gouuid.Nil,
+		"an iron-bound chest",
+		"A heavy oak chest, its corners capped with iron. A sturdy lock hangs from the hasp.",
+		[]string{"chest"},
+		loc1,
+		core.ObjectSizeLargeSlots,
+		z,
+		core.ObjectAttributes{
+			Weight:         20.0,
+			InventorySlots: core.ObjectSizeHugeSlots,
+			Closeable:      true,
+			KeyKeyword:     "ironkey",
+			Value:          40,
+		},
+	)
+	chest, err := z.AddObject(chestPrim, loc1)
+	if err != nil {
+		panic(err)
+	}
+
+	keyPrim := core.NewObject(
+		gouuid.Nil,
+		"a small iron key",
+		"A small key, blackened with age.",
+		[]string{"key", "ironkey"},
+		loc1,
+		0,
+		z,
+		core.ObjectAttributes{
+			Weight:         0.1,
+			InventorySlots: core.ObjectSizeTinySlots,
+			Value:          1,
+		},
+	)
+	_, err = z.AddObject(keyPrim, loc1)
+	if err != nil {
+		panic(err)
+	}
+
+	scrollPrim := core.NewObject(
+		gouuid.Nil,
+		"a yellowed scroll",
+		"The parchment is brittle with age, and covered in looping script which seems to crackle faintly when you look at it.",
+		[]string{"scroll"},
+		loc1,
+		0,
+		z,
+		core.ObjectAttributes{
+			ScrollReaction: defaultReactions[0].Name,
+			Weight:         0.1,
+			InventorySlots: core.ObjectSizeTinySlots,
+			Value:          25,
+		},
+	)
+	_, err = z.AddObject(scrollPrim, loc1)
+	if err != nil {
+		panic(err)
+	}
+
+	techniqueScrollPrim := core.NewObject(
+		gouuid.Nil,
+		"a dog-eared scroll",
+		"Someone has sketched a series of stick figures on this scroll, ducking and weaving away from what might be sword-strokes.",
+		[]string{"scroll", "dog-eared"},
+		loc1,
+		0,
+		z,
+		core.ObjectAttributes{
+			ScrollTechnique:      core.TechniqueDodging,
+			ScrollTechniqueLevel: 1,
+			Weight:               0.1,
+			InventorySlots:       core.ObjectSizeTinySlots,
+			Value:                20,
+		},
+	)
+	_, err = z.AddObject(techniqueScrollPrim, loc1)
+	if err != nil {
+		panic(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		blankPrim := mustObjectPrototype(blankScrollPrototypeID).ToObject(loc1, z)
+		_, err = z.AddObject(blankPrim, loc1)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	needlePrim := mustObjectPrototype(needlePrototypeID).ToObject(loc1, z)
+	_, err = z.AddObject(needlePrim, loc1)
+	if err != nil {
+		panic(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		clothPrim := mustObjectPrototype(linenPrototypeID).ToObject(loc1, z)
+		_, err = z.AddObject(clothPrim, loc1)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	bartenderPrim := core.NewActor(
+		gouuid.Nil,
+		"the bartender",
+		"",
+		loc1,
+		z,
+		core.AttributeSet{
+			Strength: 20,
+			Physical: 20,
+			Stamina:  20,
+		},
+		core.Skillset{},
+		core.DefaultHumanInventoryConstraints,
+	)
+	bartender, err := z.AddActor(bartenderPrim)
+	if err != nil {
+		panic(err)
+	}
+	err = bartender.JoinFaction("the citizens of Elm Street")
+	if err != nil {
+		panic(err)
+	}
+	err = bartender.SetShop(&core.ShopRules{
+		SellRate:     1.5,
+		BuyRate:      0.5,
+		BuysKeywords: []string{"scroll", "key"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	for i := 0; i < 2; i++ {
+		flaskPrim := mustObjectPrototype(whiskeyPrototypeID).ToObject(loc1, z)
+		flask, err := z.AddObject(flaskPrim, loc1)
+		if err != nil {
+			panic(err)
+		}
+		err = flask.Move(loc1, bartender, bartender, core.ContainerDefaultSubcontainer)
+		if err != nil {
+			panic(err)
+		}
+		err = flask.MoveToSubcontainer(core.InventoryContainerBelt, bartender)
+		if err != nil {
+			panic(err)
+		}
+	}
+	tillPrim := core.NewCoinStack(100, loc1, z)
+	till, err := z.AddObject(tillPrim, loc1)
+	if err != nil {
+		panic(err)
+	}
+	err = till.Move(loc1, bartender, bartender, core.ContainerDefaultSubcontainer)
+	if err != nil {
+		panic(err)
+	}
+	err = till.MoveToSubcontainer(core.InventoryContainerBelt, bartender)
+	if err != nil {
+		panic(err)
+	}
+	_, err = z.AddObject(core.NewCoinStack(10, loc1, z), loc1)
+	if err != nil {
+		panic(err)
+	}
+
+	mercenaryPrim := core.NewActor(
+		gouuid.Nil,
+		"a scarred mercenary",
+		"crowd-averse-wanderer",
+		loc1,
+		z,
+		core.AttributeSet{
+			Strength: 30,
+			Physical: 30,
+			Stamina:  30,
+		},
+		core.Skillset{},
+		core.DefaultHumanInventoryConstraints,
+	)
+	mercenary, err := z.AddActor(mercenaryPrim)
+	if err != nil {
+		panic(err)
+	}
+	err = mercenary.SetHireTerms(&core.HireTerms{
+		Wage: 5,
+		Term: 10 * time.Minute,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	watchmanPrim := core.NewActor(
+		gouuid.Nil,
+		"a town watchman",
+		"crowd-averse-wanderer",
+		loc1,
+		z,
+		core.AttributeSet{
+			Strength: 40,
+			Physical: 40,
+			Stamina:  40,
+		},
+		core.Skillset{},
+		core.DefaultHumanInventoryConstraints,
+	)
+	watchman, err := z.AddActor(watchmanPrim)
+	if err != nil {
+		panic(err)
+	}
+	for _, factionName := range []string{"the citizens of Elm Street", "the town watch"} {
+		err = watchman.JoinFaction(factionName)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	z2 := core.NewZone(gouuid.Nil, "123 Elm St", eStore)
 	z2.StartCommandProcessing()
 
@@ -350,10 +679,13 @@ func initStartingWorld(worldConfigFile string) error {
 				DodgingCap: 25,
 			},
 			InventoryConstraints: core.DefaultHumanInventoryConstraints,
+			Equipment:            []gouuid.UUID{whiskeyPrototypeID},
 		},
 		MaxCount:           1,
 		MaxSpawnAtOneTime:  1,
 		SpawnChancePerTick: 1.0,
+		LocationTags:       []string{"street"},
+		LeashRadius:        2,
 	}
 	chessSpawnSpec := spawnreap.SpawnSpecification{
 		ActorProto: spawnreap.ActorPrototype{
@@ -364,10 +696,29 @@ func initStartingWorld(worldConfigFile string) error {
 		MaxSpawnAtOneTime:  1,
 		SpawnChancePerTick: 1.0,
 	}
+	// the bar's stock of linen is replenished, and the chest is restocked
+	// with blank scrolls
+	objectSpawnSpecs := []spawnreap.ObjectSpawnSpecification{
+		{
+			PrototypeID:        linenPrototypeID,
+			LocationID:         loc1.ID(),
+			MaxCount:           3,
+			MaxSpawnAtOneTime:  1,
+			SpawnChancePerTick: 0.05,
+		},
+		{
+			PrototypeID:        blankScrollPrototypeID,
+			ContainerID:        chest.ID(),
+			MaxCount:           2,
+			MaxSpawnAtOneTime:  1,
+			SpawnChancePerTick: 0.02,
+		},
+	}
 	spawnReapSvc := spawnreap.Service{
-		World:       &core.World{},
-		TickLengthS: int(math.MaxInt64),
-		ConfigFile:  spawnreap.DefaultConfigFile,
+		World:            &core.World{},
+		TickLengthS:      int(math.MaxInt64),
+		ConfigFile:       spawnreap.DefaultConfigFile,
+		ObjectConfigFile: spawnreap.DefaultObjectConfigFile,
 	}
 	err = spawnReapSvc.Start()
 	if err != nil {
@@ -387,12 +738,17 @@ func initStartingWorld(worldConfigFile string) error {
 	if err != nil {
 		panic(err)
 	}
+	err = spawnReapSvc.PutObjectSpawnConfigForZone(objectSpawnSpecs, z)
+	if err != nil {
+		panic(err)
+	}
 	spawnReapSvc.Stop()
 
 	cfg := mudConfig{
 		World: worldConfig{
 			DefaultZoneID:     z.ID(),
 			DefaultLocationID: loc1.ID(),
+			PantheonID:        pantheon.ID(),
 			//DefaultZoneID:     chessboardZone.ID(),
 			//DefaultLocationID: a1Loc.ID(),
 			ZonesToLoad: []string{
@@ -414,10 +770,67 @@ func initStartingWorld(worldConfigFile string) error {
 			ListenAddr: wsapi.DefaultListenAddr,
 		},
 		SpawnReap: spawnReapConfig{
-			SpawnsConfigFile:    spawnreap.DefaultConfigFile,
-			TicksUntilReap:      spawnreap.DefaultReapTicks,
-			TickLengthInSeconds: spawnreap.DefaultTickLengthS,
+			SpawnsConfigFile:       spawnreap.DefaultConfigFile,
+			ObjectSpawnsConfigFile: spawnreap.DefaultObjectConfigFile,
+			TicksUntilReap:         spawnreap.DefaultReapTicks,
+			TickLengthInSeconds:    spawnreap.DefaultTickLengthS,
+		},
+		PlayerDeath: &playerDeathConfig{
+			GhostDurationInSeconds: int(core.PlayerGhostDuration / time.Second),
+			SkillLossFraction:      core.PlayerCharacterDeathPenalty.SkillLossFraction,
+			LeaveGearOnCorpse:      core.PlayerCharacterDeathPenalty.LeaveGearOnCorpse,
+		},
+		Sorcery: sorceryConfig{
+			ReactionsFile: defaultReactionsFile,
+		},
+		Mysticism: mysticismConfig{
+			PrayersFile: defaultPrayersFile,
+		},
+		Factions: factionsConfig{
+			FactionsFile: defaultFactionsFile,
 		},
+		Quests: questsConfig{
+			QuestsFile: defaultQuestsFile,
+		},
+		Crafting: craftingConfig{
+			RecipesFile: defaultRecipesFile,
+		},
+		Objects: objectsConfig{
+			PrototypesFile: defaultObjectPrototypesFile,
+		},
+	}
+	err = writeReactions(cfg.Sorcery.ReactionsFile, defaultReactions)
+	if err != nil {
+		return err
+	}
+	err = writePrayers(cfg.Mysticism.PrayersFile, defaultPrayers)
+	if err != nil {
+		return err
+	}
+	err = writeFactions(cfg.Factions.FactionsFile, defaultFactions)
+	if err != nil {
+		return err
+	}
+	quests := defaultQuests(loc3.ID())
+	err = core.SetQuests(quests)
+	if err != nil {
+		return err
+	}
+	err = writeQuests(cfg.Quests.QuestsFile, quests)
+	if err != nil {
+		return err
+	}
+	err = core.SetRecipes(defaultRecipes)
+	if err != nil {
+		return err
+	}
+	err = writeRecipes(cfg.Crafting.RecipesFile, defaultRecipes)
+	if err != nil {
+		return err
+	}
+	err = writeObjectPrototypes(cfg.Objects.PrototypesFile, defaultObjectPrototypes)
+	if err != nil {
+		return err
 	}
 	return cfg.SerializeToFile(worldConfigFile)
 }
@@ -504,17 +917,7 @@ func doBasicBidirectionalExits(fromLoc, toLoc *core.Location, dir string, z *cor
 		return err
 	}
 
-	var returnDir string
-	switch dir {
-	case core.ExitDirectionEast:
-		returnDir = core.ExitDirectionWest
-	case core.ExitDirectionWest:
-		returnDir = core.ExitDirectionEast
-	case core.ExitDirectionNorth:
-		returnDir = core.ExitDirectionSouth
-	case core.ExitDirectionSouth:
-		returnDir = core.ExitDirectionNorth
-	}
+	returnDir := core.OppositeDirection(dir)
 	exitPrim = core.NewExit(
 		gouuid.Nil,
 		fmt.Sprintf("To square %s", fromLoc.ShortDescription()),
@@ -579,10 +982,12 @@ func runWorld(world *core.World, cfg mudConfig) error {
 	}
 
 	spawnReapService := &spawnreap.Service{
-		World:       world,
-		BrainSvc:    brainService,
-		ReapTicks:   cfg.SpawnReap.TicksUntilReap,
-		TickLengthS: cfg.SpawnReap.TickLengthInSeconds,
+		World:            world,
+		BrainSvc:         brainService,
+		ReapTicks:        cfg.SpawnReap.TicksUntilReap,
+		TickLengthS:      cfg.SpawnReap.TickLengthInSeconds,
+		ConfigFile:       cfg.SpawnReap.SpawnsConfigFile,
+		ObjectConfigFile: cfg.SpawnReap.ObjectSpawnsConfigFile,
 	}
 	err = spawnReapService.Start()
 	if err != nil {
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultObjectPrototypesFile = "objects.yaml"
+
+// The starting world's prototypes have fixed IDs, so that the Objects made
+// from them keep referring to them whenever the world is re-initialized.
+var (
+	swordPrototypeID       = uuid.FromStringOrNil("97d08be4-3b7a-4d6e-9c86-bf51a8d866e8")
+	blankScrollPrototypeID = uuid.FromStringOrNil("651aef10-5a19-43cb-b959-d3a5c922ac8f")
+	needlePrototypeID      = uuid.FromStringOrNil("b3c0ff3e-8a3d-492d-ace7-7630bf7e2312")
+	linenPrototypeID       = uuid.FromStringOrNil("71d882a4-39f1-45e8-a5ec-b99da588bf3d")
+	whiskeyPrototypeID     = uuid.FromStringOrNil("bc0bc5e0-c772-4ab1-936d-a15d6c843467")
+)
+
+var defaultObjectPrototypes = []core.ObjectPrototype{
+	{
+		ID:          swordPrototypeID,
+		Name:        "a sword",
+		Description: "This was once the sort of napkin that bartenders put down so your drink doesn't leave a wet ring on the bar. Now it's crumpled into a ball.",
+		Keywords:    []string{"sword"},
+		Attributes: core.ObjectAttributes{
+			SlashingDamageMin: 3.0,
+			SlashingDamageMax: 5.0,
+			Weight:            1.5,
+			InventorySlots:    core.ObjectSizeMediumSlots,
+			Value:             30,
+		},
+	},
+	{
+		ID:          blankScrollPrototypeID,
+		Name:        "a blank scroll",
+		Description: "A fresh sheet of parchment, ready to be written upon.",
+		Keywords:    []string{"scroll", "blank"},
+		Attributes: core.ObjectAttributes{
+			BlankScroll:    true,
+			Weight:         0.1,
+			InventorySlots: core.ObjectSizeTinySlots,
+			Value:          5,
+		},
+	},
+	{
+		ID:          needlePrototypeID,
+		Name:        "a sewing needle",
+		Description: "A long steel needle, threaded with a length of stout twine.",
+		Keywords:    []string{"needle"},
+		Attributes: core.ObjectAttributes{
+			Weight:         0.1,
+			InventorySlots: core.ObjectSizeTinySlots,
+			Value:          4,
+		},
+	},
+	{
+		ID:          linenPrototypeID,
+		Name:        "a length of linen",
+		Description: "A folded length of plain, undyed linen.",
+		Keywords:    []string{"cloth", "linen"},
+		Attributes: core.ObjectAttributes{
+			Weight:         0.2,
+			InventorySlots: core.ObjectSizeSmallSlots,
+			Value:          2,
+		},
+	},
+	{
+		ID:          whiskeyPrototypeID,
+		Name:        "a flask of whiskey",
+		Description: "A battered tin flask, sloshing with something that smells strong enough to strip paint.",
+		Keywords:    []string{"flask", "whiskey"},
+		Attributes: core.ObjectAttributes{
+			Weight:         0.5,
+			InventorySlots: core.ObjectSizeSmallSlots,
+			Value:          3,
+			ConsumeMethod:  core.ConsumeMethodDrink,
+			Uses:           3,
+			Effects: []core.ConsumableEffect{
+				{Type: core.ConsumableEffectRestore, Attribute: core.EffectAttributeStamina, Magnitude: 10},
+				{Type: core.ConsumableEffectBuff, Attribute: core.EffectAttributePhysical, Magnitude: 5, Duration: time.Minute},
+			},
+		},
+	},
+}
+
+func loadObjectPrototypes(filename string) error {
+	fBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadFile(%q): %s", filename, err)
+	}
+	var prototypes []core.ObjectPrototype
+	err = yaml.Unmarshal(fBytes, &prototypes)
+	if err != nil {
+		return fmt.Errorf("yaml.Unmarshal(): %s", err)
+	}
+	return core.SetObjectPrototypes(prototypes)
+}
+
+func writeObjectPrototypes(filename string, prototypes []core.ObjectPrototype) error {
+	fBytes, err := yaml.Marshal(prototypes)
+	if err != nil {
+		return fmt.Errorf("yaml.Marshal(): %s", err)
+	}
+	err = ioutil.WriteFile(filename, fBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("ioutil.WriteFile(%q, ...): %s", filename, err)
+	}
+	return nil
+}
+
+// mustObjectPrototype returns the prototype with the given ID, for use while
+// building the starting world from the default prototypes.
+func mustObjectPrototype(id uuid.UUID) core.ObjectPrototype {
+	proto, found := core.ObjectPrototypeByID(id)
+	if !found {
+		panic(fmt.Sprintf("no such Object prototype %q", id))
+	}
+	return proto
+}
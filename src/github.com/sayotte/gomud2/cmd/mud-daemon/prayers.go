@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/sayotte/gomud2/core"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultPrayersFile = "prayers.yaml"
+
+var defaultPrayers = []core.Prayer{
+	{
+		Name:           "heal",
+		Description:    "A warm light suffuses the supplicant's body, closing wounds.",
+		SkillThreshold: 0,
+		ZealCost:       5,
+		StrengthCost:   0.5,
+		Effect:         core.PrayerEffectHeal,
+		MagnitudeMax:   20,
+	},
+	{
+		Name:           "smite",
+		Description:    "The deity lashes out at an enemy of the faithful.",
+		SkillThreshold: 25,
+		ZealCost:       10,
+		StrengthCost:   2,
+		Effect:         core.PrayerEffectDamage,
+		MagnitudeMax:   30,
+	},
+	{
+		Name:           "slay",
+		Description:    "The deity strikes down an enemy of the faithful outright.",
+		SkillThreshold: 99,
+		ZealCost:       50,
+		StrengthCost:   25,
+		Effect:         core.PrayerEffectDamage,
+		MagnitudeMax:   1000,
+	},
+}
+
+var defaultDeities = []struct {
+	name, description string
+	strength          float64
+}{
+	{"Aurel", "Lord of the morning, patron of farmers and early risers.", 50},
+	{"Morrow", "The quiet keeper of the graves, who takes what is owed.", 50},
+}
+
+func loadPrayers(filename string) error {
+	fBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadFile(%q): %s", filename, err)
+	}
+	var prayers []core.Prayer
+	err = yaml.Unmarshal(fBytes, &prayers)
+	if err != nil {
+		return fmt.Errorf("yaml.Unmarshal(): %s", err)
+	}
+	return core.SetPrayers(prayers)
+}
+
+func writePrayers(filename string, prayers []core.Prayer) error {
+	fBytes, err := yaml.Marshal(prayers)
+	if err != nil {
+		return fmt.Errorf("yaml.Marshal(): %s", err)
+	}
+	err = ioutil.WriteFile(filename, fBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("ioutil.WriteFile(%q, ...): %s", filename, err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultQuestsFile = "quests.yaml"
+
+// defaultQuests returns the starting world's quests; they refer to its
+// Locations, whose IDs aren't known until the world is built.
+func defaultQuests(foxhuntRoomID uuid.UUID) []core.Quest {
+	return []core.Quest{
+		{
+			Name:        "Strangers on Elm Street",
+			Description: "The bartender is tired of strange men wandering in and scaring off the regulars, and would like one of them seen off for good.",
+			Giver:       "the bartender",
+			Repeatable:  true,
+			Objectives: []core.QuestObjective{
+				{
+					Type:        core.QuestObjectiveKill,
+					Description: "Kill a man loitering about the bar",
+					Actor:       "a man",
+				},
+			},
+			Rewards: core.QuestRewards{
+				Coins: 10,
+				Reputation: map[string]int{
+					"the citizens of Elm Street": 25,
+				},
+			},
+		},
+		{
+			Name:        "The lost key",
+			Description: "The town watch has mislaid the key to its lockup, and would be grateful to whoever brings it back.",
+			Objectives: []core.QuestObjective{
+				{
+					Type:        core.QuestObjectiveDeliver,
+					Description: "Give a key to a town watchman",
+					Actor:       "a town watchman",
+					Object:      "key",
+				},
+			},
+			Rewards: core.QuestRewards{
+				Coins: 5,
+				Reputation: map[string]int{
+					"the town watch": 50,
+				},
+			},
+		},
+		{
+			Name:        "The house on Elm Street",
+			Description: "Nobody at the bar will say what goes on in the house down the street. Go and see for yourself.",
+			Giver:       "the bartender",
+			Objectives: []core.QuestObjective{
+				{
+					Type:        core.QuestObjectiveReach,
+					Description: "Find your way into the Foxhunt Room",
+					LocationID:  foxhuntRoomID,
+				},
+			},
+			Rewards: core.QuestRewards{
+				Coins: 5,
+			},
+		},
+	}
+}
+
+func loadQuests(filename string) error {
+	fBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadFile(%q): %s", filename, err)
+	}
+	var quests []core.Quest
+	err = yaml.Unmarshal(fBytes, &quests)
+	if err != nil {
+		return fmt.Errorf("yaml.Unmarshal(): %s", err)
+	}
+	return core.SetQuests(quests)
+}
+
+func writeQuests(filename string, quests []core.Quest) error {
+	fBytes, err := yaml.Marshal(quests)
+	if err != nil {
+		return fmt.Errorf("yaml.Marshal(): %s", err)
+	}
+	err = ioutil.WriteFile(filename, fBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("ioutil.WriteFile(%q, ...): %s", filename, err)
+	}
+	return nil
+}
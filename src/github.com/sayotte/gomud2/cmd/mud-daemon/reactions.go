@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/sayotte/gomud2/core"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultReactionsFile = "reactions.yaml"
+
+var defaultReactions = []core.Reaction{
+	{
+		Name:             "spark",
+		Description:      "A crackling bolt of lightning leaps from the invoker's fingertips.",
+		SkillRequirement: 0,
+		FocusCost:        10,
+		Effect:           core.ReactionEffectDamage,
+		MagnitudeMin:     2,
+		MagnitudeMax:     6,
+	},
+	{
+		Name:             "mend",
+		Description:      "Torn flesh knits itself back together.",
+		SkillRequirement: 10,
+		FocusCost:        15,
+		Effect:           core.ReactionEffectHeal,
+		MagnitudeMin:     3,
+		MagnitudeMax:     8,
+	},
+}
+
+func loadReactions(filename string) error {
+	fBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadFile(%q): %s", filename, err)
+	}
+	var reactions []core.Reaction
+	err = yaml.Unmarshal(fBytes, &reactions)
+	if err != nil {
+		return fmt.Errorf("yaml.Unmarshal(): %s", err)
+	}
+	return core.SetReactions(reactions)
+}
+
+func writeReactions(filename string, reactions []core.Reaction) error {
+	fBytes, err := yaml.Marshal(reactions)
+	if err != nil {
+		return fmt.Errorf("yaml.Marshal(): %s", err)
+	}
+	err = ioutil.WriteFile(filename, fBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("ioutil.WriteFile(%q, ...): %s", filename, err)
+	}
+	return nil
+}
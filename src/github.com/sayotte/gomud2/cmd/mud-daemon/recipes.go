@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/sayotte/gomud2/core"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultRecipesFile = "recipes.yaml"
+
+var defaultRecipes = []core.Recipe{
+	{
+		Name:        "bandage",
+		Description: "Tear a strip of cloth into a bandage.",
+		Inputs: []core.RecipeInput{
+			{Keyword: "cloth"},
+		},
+		Difficulty: 0,
+		Output: core.RecipeOutput{
+			Name:        "a linen bandage",
+			Description: "A neatly rolled strip of linen, clean enough to bind a wound.",
+			Keywords:    []string{"bandage"},
+			Attributes: core.ObjectAttributes{
+				Weight:         0.1,
+				InventorySlots: core.ObjectSizeTinySlots,
+				Value:          2,
+				ConsumeMethod:  core.ConsumeMethodUse,
+				Effects: []core.ConsumableEffect{
+					{Type: core.ConsumableEffectRestore, Attribute: core.EffectAttributePhysical, Magnitude: 10},
+				},
+			},
+			Count: 2,
+		},
+	},
+	{
+		Name:        "cloth sack",
+		Description: "Stitch two lengths of cloth into a sack.",
+		Inputs: []core.RecipeInput{
+			{Keyword: "cloth", Count: 2},
+		},
+		Tools:      []string{"needle"},
+		Difficulty: 15,
+		Output: core.RecipeOutput{
+			Name:        "a cloth sack",
+			Description: "A plain linen sack, its seams stitched with more enthusiasm than skill.",
+			Keywords:    []string{"sack"},
+			Capacity:    core.ObjectSizeMediumSlots,
+			Attributes: core.ObjectAttributes{
+				Weight:         0.3,
+				InventorySlots: core.ObjectSizeSmallSlots,
+				Value:          8,
+			},
+		},
+	},
+}
+
+func loadRecipes(filename string) error {
+	fBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadFile(%q): %s", filename, err)
+	}
+	var recipes []core.Recipe
+	err = yaml.Unmarshal(fBytes, &recipes)
+	if err != nil {
+		return fmt.Errorf("yaml.Unmarshal(): %s", err)
+	}
+	return core.SetRecipes(recipes)
+}
+
+func writeRecipes(filename string, recipes []core.Recipe) error {
+	fBytes, err := yaml.Marshal(recipes)
+	if err != nil {
+		return fmt.Errorf("yaml.Marshal(): %s", err)
+	}
+	err = ioutil.WriteFile(filename, fBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("ioutil.WriteFile(%q, ...): %s", filename, err)
+	}
+	return nil
+}
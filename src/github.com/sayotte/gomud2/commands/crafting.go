@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// DescribeRecipes lists every recipe, in order of name, with the Actor's
+// chance of following each successfully.
+func DescribeRecipes(actor *core.Actor) RecipesInfo {
+	info := RecipesInfo{ActorID: actor.ID()}
+	for _, recipe := range core.Recipes() {
+		info.Recipes = append(info.Recipes, recipeInfo(actor, recipe))
+	}
+	return info
+}
+
+// DescribeRecipe describes the named recipe, with the Actor's chance of
+// following it successfully.
+func DescribeRecipe(actor *core.Actor, name string) (RecipeInfo, error) {
+	recipe, found := core.RecipeByName(name)
+	if !found {
+		return RecipeInfo{}, core.ErrRecipeUnknown
+	}
+	return recipeInfo(actor, recipe), nil
+}
+
+func recipeInfo(actor *core.Actor, recipe core.Recipe) RecipeInfo {
+	info := RecipeInfo{
+		Name:        recipe.Name,
+		Description: recipe.Description,
+		Tools:       recipe.Tools,
+		Skill:       recipe.SkillName(),
+		Difficulty:  recipe.Difficulty,
+		Chance:      actor.CraftSuccessChance(recipe),
+		Output:      recipe.Output.Name,
+		OutputCount: recipe.Output.Quantity(),
+	}
+	for _, input := range recipe.Inputs {
+		info.Inputs = append(info.Inputs, RecipeInputInfo{
+			Keyword: input.Keyword,
+			Count:   input.Required(),
+		})
+	}
+	return info
+}
+
+type RecipesInfo struct {
+	ActorID uuid.UUID
+	Recipes []RecipeInfo
+}
+
+type RecipeInfo struct {
+	Name        string
+	Description string
+	Inputs      []RecipeInputInfo
+	Tools       []string
+	Skill       string
+	Difficulty  float64
+	// Chance is the Actor's chance (0.0 - 1.0) of success.
+	Chance      float64
+	Output      string
+	OutputCount int
+}
+
+type RecipeInputInfo struct {
+	Keyword string
+	Count   int
+}
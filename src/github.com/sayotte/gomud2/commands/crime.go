@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// DescribeCrimes describes the law where an Actor is, and the crimes it knows
+// about there, oldest first.
+func DescribeCrimes(actor *core.Actor) CrimesInfo {
+	law := actor.Zone().Law()
+	info := CrimesInfo{
+		ActorID:  actor.ID(),
+		Lawful:   law.Lawful,
+		Enforcer: actor.IsLawEnforcer(),
+		HasJail:  !uuid.Equal(law.JailLocationID, uuid.Nil),
+		Wanted:   actor.IsWanted(),
+	}
+	for _, crime := range actor.KnownCrimes() {
+		info.Crimes = append(info.Crimes, crimeInfoFromDomain(crime))
+	}
+	return info
+}
+
+func crimeInfoFromDomain(crime core.Crime) CrimeInfo {
+	return CrimeInfo{
+		ID:           crime.ID,
+		Type:         crime.Type,
+		Severity:     crime.Severity,
+		OffenderID:   crime.OffenderID,
+		OffenderName: crime.OffenderName,
+		VictimID:     crime.VictimID,
+		VictimName:   crime.VictimName,
+		LocationID:   crime.LocationID,
+		CommittedAt:  crime.CommittedAt,
+		Resolution:   crime.Resolution,
+		Fine:         crime.Fine,
+	}
+}
+
+type CrimesInfo struct {
+	ActorID  uuid.UUID
+	Lawful   bool
+	Enforcer bool
+	HasJail  bool
+	Wanted   bool
+	Crimes   []CrimeInfo
+}
+
+type CrimeInfo struct {
+	ID           uuid.UUID
+	Type         string
+	Severity     int
+	OffenderID   uuid.UUID
+	OffenderName string
+	VictimID     uuid.UUID
+	VictimName   string
+	LocationID   uuid.UUID
+	CommittedAt  time.Time
+	Resolution   string
+	Fine         int
+}
@@ -3,11 +3,17 @@ package commands
 const (
 	ErrorNoSuchExit      = "No exit in that direction!"
 	ErrorMigrationFailed = "Weird, that didn't seem to work..."
+	ErrorActorNotReady   = "You're not ready to act yet!"
+	ErrorActorIsGhost    = "You can't do that while you're a ghost!"
+	ErrorExitClosed      = "The way is shut."
 )
 
 var nonFatalErrors = map[string]bool{
 	ErrorNoSuchExit:      true,
 	ErrorMigrationFailed: true,
+	ErrorActorNotReady:   true,
+	ErrorActorIsGhost:    true,
+	ErrorExitClosed:      true,
 }
 
 func IsFatalError(err error) bool {
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// DescribeContract describes the terms an Actor can be hired on, and its
+// contract with its employer if it has one. Friends and enemies who can't be
+// found in the hireling's Zone are listed without a name.
+func DescribeContract(actor *core.Actor) ContractInfo {
+	info := ContractInfo{
+		ActorID: actor.ID(),
+		Name:    actor.Name(),
+	}
+	if terms := actor.HireTerms(); terms != nil {
+		info.ForHire = true
+		info.Wage = terms.Wage
+		info.Term = terms.Term
+	}
+	contract := actor.HirelingContract()
+	if contract == nil {
+		return info
+	}
+
+	nameOf := func(id uuid.UUID) ContractPartyInfo {
+		party := ContractPartyInfo{ID: id}
+		if other := actor.Zone().ActorByID(id); other != nil {
+			party.Name = other.Name()
+		}
+		return party
+	}
+	info.Hired = true
+	info.EmployerID = contract.EmployerID
+	info.EmployerName = contract.EmployerName
+	info.HiredUntil = contract.HiredUntil
+	for _, id := range contract.Friends {
+		info.Friends = append(info.Friends, nameOf(id))
+	}
+	for _, id := range contract.Enemies {
+		info.Enemies = append(info.Enemies, nameOf(id))
+	}
+	info.Order = contract.Order.Order
+	info.OrderTargetID = contract.Order.TargetID
+	info.OrderTargetName = contract.Order.TargetName
+	info.OrderLocationID = contract.Order.LocationID
+	return info
+}
+
+type ContractInfo struct {
+	ActorID uuid.UUID
+	Name    string
+	ForHire bool
+	Wage    int
+	Term    time.Duration
+
+	Hired            bool
+	EmployerID       uuid.UUID
+	EmployerName     string
+	HiredUntil       time.Time
+	Friends, Enemies []ContractPartyInfo
+	Order            string
+	OrderTargetID    uuid.UUID
+	OrderTargetName  string
+	OrderLocationID  uuid.UUID
+}
+
+type ContractPartyInfo struct {
+	ID   uuid.UUID
+	Name string
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"github.com/sayotte/gomud2/core"
+)
+
+// ListInventory describes everything an Actor is carrying, by subcontainer,
+// including the (visible) contents of any containers it carries.
+func ListInventory(actor *core.Actor) InventoryInfo {
+	inv := actor.Inventory()
+	info := InventoryInfo{
+		Subcontainers: make(map[string]SubcontainerInfo, len(core.AllActorInventorySubcontainers)),
+		Weight:        inv.Weight(),
+		CarryLimit:    inv.CarryLimit(),
+		Coins:         actor.Coins(),
+	}
+	for _, subContainerName := range core.AllActorInventorySubcontainers {
+		slots, maxItems := inv.CapacityBySubcontainer(subContainerName)
+		sInfo := SubcontainerInfo{
+			Slots:    slots,
+			MaxItems: maxItems,
+		}
+		for _, obj := range inv.ObjectsBySubcontainer(subContainerName) {
+			sInfo.SlotsUsed += obj.InventorySlots()
+			sInfo.Objects = append(sInfo.Objects, LookAtObject(obj))
+		}
+		info.Subcontainers[subContainerName] = sInfo
+	}
+	return info
+}
+
+type InventoryInfo struct {
+	Subcontainers map[string]SubcontainerInfo
+	Weight        float64
+	CarryLimit    float64
+	Coins         int
+}
+
+type SubcontainerInfo struct {
+	Slots, SlotsUsed int
+	MaxItems         int
+	Objects          []ObjectVisibleInfo
+}
@@ -2,6 +2,8 @@ package commands
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/satori/go.uuid"
 
 	"github.com/sayotte/gomud2/core"
@@ -33,7 +35,15 @@ func LookAtLocation(loc *core.Location) LocationInfo {
 		lInfo.Objects = append(lInfo.Objects, o.ID())
 	}
 	lInfo.Exits = make(map[string][2]uuid.UUID)
+	lInfo.Doors = make(map[string]DoorInfo)
 	for _, ex := range loc.OutExits() {
+		if ex.HasDoor() {
+			lInfo.Doors[ex.Direction()] = DoorInfo{
+				Closed:   ex.IsClosed(),
+				Locked:   ex.IsLocked(),
+				Lockable: ex.IsLockable(),
+			}
+		}
 		if ex.Destination() != nil {
 			lInfo.Exits[ex.Direction()] = [2]uuid.UUID{
 				loc.Zone().ID(),
@@ -57,13 +67,27 @@ type LocationInfo struct {
 	Actors           []uuid.UUID
 	Objects          []uuid.UUID
 	Exits            map[string][2]uuid.UUID // direction->ZoneID/LocationID
+	Doors            map[string]DoorInfo     // direction->door, for Exits with doors
+}
+
+type DoorInfo struct {
+	Closed, Locked, Lockable bool
 }
 
 func LookAtActor(actor *core.Actor) ActorVisibleInfo {
 	aInfo := ActorVisibleInfo{
 		ID:               actor.ID(),
 		Name:             actor.Name(),
+		IsGhost:          actor.IsGhost(),
 		VisibleInventory: make(map[string][]uuid.UUID, len(core.AllActorInventorySubcontainers)),
+		CarriedWeight:    actor.Inventory().Weight(),
+		CarryLimit:       actor.Inventory().CarryLimit(),
+		Factions:         actor.Factions(),
+		HostileFactions:  actor.HostileFactions(),
+		Reputation:       make(map[string]int),
+	}
+	for _, faction := range core.Factions() {
+		aInfo.Reputation[faction.Name] = actor.Reputation(faction.Name)
 	}
 	for _, subContainerName := range core.AllActorInventorySubcontainers {
 		var contents []uuid.UUID
@@ -72,6 +96,14 @@ func LookAtActor(actor *core.Actor) ActorVisibleInfo {
 		}
 		aInfo.VisibleInventory[subContainerName] = contents
 	}
+	for _, effect := range actor.ActiveEffects() {
+		aInfo.StatusEffects = append(aInfo.StatusEffects, StatusEffectInfo{
+			ID:     effect.ID,
+			Name:   effect.Effect.Name,
+			Source: effect.Effect.Source,
+			Until:  effect.Until(),
+		})
+	}
 	attrs := actor.Attributes()
 	aInfo.VisibleAttributes = ActorVisibleAttributes{
 		Strength:       attrs.Strength,
@@ -91,8 +123,25 @@ func LookAtActor(actor *core.Actor) ActorVisibleInfo {
 type ActorVisibleInfo struct {
 	ID                uuid.UUID
 	Name              string
+	IsGhost           bool
 	VisibleInventory  map[string][]uuid.UUID
+	CarriedWeight     float64
+	CarryLimit        float64
 	VisibleAttributes ActorVisibleAttributes
+	// Factions are those the Actor is a member of; HostileFactions are those
+	// whose members regard it as hostile. Reputation is by faction name.
+	Factions        []string
+	HostileFactions []string
+	Reputation      map[string]int
+	StatusEffects   []StatusEffectInfo
+}
+
+type StatusEffectInfo struct {
+	ID     uuid.UUID
+	Name   string
+	Source string
+	// Until is zero for an effect lasting until the Actor dies.
+	Until time.Time
 }
 
 type ActorVisibleAttributes struct {
@@ -104,25 +153,48 @@ type ActorVisibleAttributes struct {
 	NaturalSlashMin, NaturalSlashMax float64
 }
 
+// LookAtObject describes the Object, along with everything inside it that
+// can be seen; the contents of closed containers are hidden.
 func LookAtObject(obj *core.Object) ObjectVisibleInfo {
 	info := ObjectVisibleInfo{
-		ID:          obj.ID(),
-		Name:        obj.Name(),
-		Description: obj.Description(),
-		Capacity:    obj.InventorySlots(), // FIXME or should it be obj.containerCapacity?
-		Attributes:  obj.Attributes(),
+		ID:                 obj.ID(),
+		Name:               obj.Name(),
+		Description:        obj.Description(),
+		Capacity:           obj.Capacity(),
+		InventorySlots:     obj.InventorySlots(),
+		SlotsUsed:          obj.SlotsUsed(),
+		Closed:             obj.IsClosed(),
+		Locked:             obj.IsLocked(),
+		Attributes:         obj.Attributes(),
+		Weight:             obj.Weight(),
+		DecayAt:            obj.DecayAt(),
+		LootRightsActorIDs: obj.LootRightsActorIDs(),
+		LootRightsUntil:    obj.LootRightsUntil(),
+	}
+	if obj.IsClosed() {
+		return info
 	}
 	for _, subObj := range obj.Objects() {
 		info.ContainedObjects = append(info.ContainedObjects, subObj.ID())
+		info.Contents = append(info.Contents, LookAtObject(subObj))
 	}
 	return info
 }
 
 type ObjectVisibleInfo struct {
-	ID               uuid.UUID
-	Name             string
-	Description      string
-	Capacity         int
-	ContainedObjects []uuid.UUID
-	Attributes       core.ObjectAttributes
+	ID          uuid.UUID
+	Name        string
+	Description string
+	// Capacity is how many inventory slots' worth of Objects this one can
+	// contain, and InventorySlots how many it takes up itself.
+	Capacity, InventorySlots int
+	SlotsUsed                int
+	Closed, Locked           bool
+	ContainedObjects         []uuid.UUID
+	Contents                 []ObjectVisibleInfo
+	Attributes               core.ObjectAttributes
+	Weight                   float64 // including ContainedObjects
+	DecayAt                  time.Time
+	LootRightsActorIDs       []uuid.UUID
+	LootRightsUntil          time.Time
 }
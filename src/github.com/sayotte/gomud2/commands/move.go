@@ -3,9 +3,11 @@ package commands
 import (
 	"errors"
 	"github.com/sayotte/gomud2/core"
+	"strings"
 )
 
 func MoveActor(actor *core.Actor, direction string, observer core.Observer) (*core.Actor, error) {
+	direction = core.ExpandDirection(strings.ToLower(direction))
 	var outExit *core.Exit
 	for _, exit := range actor.Location().OutExits() {
 		if exit.Direction() == direction {
@@ -16,10 +18,19 @@ func MoveActor(actor *core.Actor, direction string, observer core.Observer) (*co
 	if outExit == nil {
 		return actor, errors.New(ErrorNoSuchExit)
 	}
+	if outExit.IsClosed() {
+		return actor, errors.New(ErrorExitClosed)
+	}
 
 	// Intra-zone move
 	if outExit.Destination() != nil {
 		err := actor.Move(actor.Location(), outExit.Destination())
+		if err == core.ErrActorNotReady {
+			return actor, errors.New(ErrorActorNotReady)
+		}
+		if err == core.ErrExitClosed {
+			return actor, errors.New(ErrorExitClosed)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// DescribeProvenance describes who an Object belongs to, whether it's
+// stolen, and how it changed hands along the way, oldest first.
+func DescribeProvenance(obj *core.Object) ProvenanceInfo {
+	ownership := obj.Ownership()
+	info := ProvenanceInfo{
+		ObjectID:  obj.ID(),
+		Name:      obj.Name(),
+		OwnerID:   ownership.OwnerID,
+		OwnerName: ownership.OwnerName,
+		Stolen:    ownership.Stolen,
+	}
+	for _, record := range ownership.Provenance {
+		info.History = append(info.History, ProvenanceRecordInfo{
+			Reason:    record.Reason,
+			OwnerID:   record.OwnerID,
+			OwnerName: record.OwnerName,
+			ActorID:   record.ActorID,
+			ActorName: record.ActorName,
+			At:        record.At,
+		})
+	}
+	return info
+}
+
+type ProvenanceInfo struct {
+	ObjectID  uuid.UUID
+	Name      string
+	OwnerID   uuid.UUID
+	OwnerName string
+	Stolen    bool
+	History   []ProvenanceRecordInfo
+}
+
+type ProvenanceRecordInfo struct {
+	Reason    string
+	OwnerID   uuid.UUID
+	OwnerName string
+	ActorID   uuid.UUID
+	ActorName string
+	At        time.Time
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+const (
+	QuestStatusAvailable = "available"
+	QuestStatusActive    = "active"
+	QuestStatusCompleted = "completed"
+)
+
+// DescribeQuests lists every quest, in order of name, with how far the Actor
+// has got with each.
+func DescribeQuests(actor *core.Actor) QuestsInfo {
+	info := QuestsInfo{ActorID: actor.ID()}
+	for _, quest := range core.Quests() {
+		info.Quests = append(info.Quests, questInfo(actor, quest))
+	}
+	return info
+}
+
+// DescribeQuest describes the named quest, with how far the Actor has got
+// with it.
+func DescribeQuest(actor *core.Actor, name string) (QuestInfo, error) {
+	quest, found := core.QuestByName(name)
+	if !found {
+		return QuestInfo{}, core.ErrQuestUnknown
+	}
+	return questInfo(actor, quest), nil
+}
+
+func questInfo(actor *core.Actor, quest core.Quest) QuestInfo {
+	info := QuestInfo{
+		Name:        quest.Name,
+		Description: quest.Description,
+		Giver:       quest.Giver,
+		Repeatable:  quest.Repeatable,
+		Status:      QuestStatusAvailable,
+		Coins:       quest.Rewards.Coins,
+		Reputation:  quest.Rewards.Reputation,
+	}
+	progress, found := actor.QuestProgress(quest.Name)
+	if found {
+		info.AcceptedAt = progress.AcceptedAt
+		info.CompletedAt = progress.CompletedAt
+		info.Status = QuestStatusActive
+		if progress.Completed {
+			info.Status = QuestStatusCompleted
+		}
+	}
+	for i, objective := range quest.Objectives {
+		objInfo := QuestObjectiveInfo{
+			Type:        objective.Type,
+			Description: objective.Description,
+			Required:    objective.Required(),
+		}
+		if found && i < len(progress.Progress) {
+			objInfo.Progress = progress.Progress[i]
+		}
+		info.Objectives = append(info.Objectives, objInfo)
+	}
+	return info
+}
+
+type QuestsInfo struct {
+	ActorID uuid.UUID
+	Quests  []QuestInfo
+}
+
+type QuestInfo struct {
+	Name        string
+	Description string
+	Giver       string
+	Repeatable  bool
+	// Status is one of the QuestStatus* constants.
+	Status      string
+	AcceptedAt  time.Time
+	CompletedAt time.Time
+	Objectives  []QuestObjectiveInfo
+	Coins       int
+	Reputation  map[string]int
+}
+
+type QuestObjectiveInfo struct {
+	Type        string
+	Description string
+	Progress    int
+	Required    int
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// DescribeReputation lists the factions an Actor belongs to, and its
+// reputation with every faction.
+func DescribeReputation(actor *core.Actor) ReputationInfo {
+	info := ReputationInfo{
+		ActorID:  actor.ID(),
+		Name:     actor.Name(),
+		Factions: actor.Factions(),
+	}
+	for _, faction := range core.Factions() {
+		reputation := actor.Reputation(faction.Name)
+		info.Standings = append(info.Standings, FactionStandingInfo{
+			Faction:    faction.Name,
+			Reputation: reputation,
+			Standing:   faction.Standing(reputation),
+		})
+	}
+	return info
+}
+
+type ReputationInfo struct {
+	ActorID   uuid.UUID
+	Name      string
+	Factions  []string
+	Standings []FactionStandingInfo
+}
+
+type FactionStandingInfo struct {
+	Faction    string
+	Reputation int
+	Standing   string
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// CharacterSheet describes an Actor's own numbers: its base attributes and
+// skills against their caps, its derived attributes against what respawning
+// would restore them to, what it has learned, and its recent gains and
+// losses, newest first.
+func CharacterSheet(actor *core.Actor) CharacterSheetInfo {
+	attrs := actor.Attributes()
+	skills := actor.Skills()
+	// the mysticism "skill" decays over time; show what's left of it
+	skills.Mysticism = actor.Mysticism()
+
+	info := CharacterSheetInfo{
+		ActorID:              actor.ID(),
+		Name:                 actor.Name(),
+		TotalBaseCap:         attrs.TotalBaseCap,
+		BaseAttributes:       traitInfos(core.BaseAttributeTraits(attrs)),
+		DerivedAttributes:    traitInfos(core.DerivedAttributeTraits(attrs)),
+		Skills:               traitInfos(core.SkillTraits(skills)),
+		DodgingTechniques:    skills.DodgingTechniques,
+		DodgingTechniquesCap: skills.DodgingTechniquesCap,
+		KnownReactions:       actor.KnownReactions(),
+		CarriedWeight:        actor.Inventory().Weight(),
+		CarryLimit:           actor.Inventory().CarryLimit(),
+	}
+	progression := actor.Progression()
+	for i := len(progression) - 1; i >= 0; i-- {
+		entry := progression[i]
+		info.RecentGains = append(info.RecentGains, ProgressionInfo{
+			At:     entry.At,
+			Reason: entry.Reason,
+			Trait:  entry.Trait,
+			From:   entry.From,
+			To:     entry.To,
+			Detail: entry.Detail,
+		})
+	}
+	return info
+}
+
+func traitInfos(traits []core.Trait) []TraitInfo {
+	out := make([]TraitInfo, 0, len(traits))
+	for _, trait := range traits {
+		out = append(out, TraitInfo{
+			Name:  trait.Name,
+			Value: trait.Value,
+			Cap:   trait.Cap,
+		})
+	}
+	return out
+}
+
+type CharacterSheetInfo struct {
+	ActorID              uuid.UUID
+	Name                 string
+	TotalBaseCap         int
+	BaseAttributes       []TraitInfo
+	DerivedAttributes    []TraitInfo
+	Skills               []TraitInfo
+	DodgingTechniques    int
+	DodgingTechniquesCap int
+	KnownReactions       []string
+	CarriedWeight        float64
+	CarryLimit           float64
+	// RecentGains includes losses too, e.g. skill lost on dying.
+	RecentGains []ProgressionInfo
+}
+
+type TraitInfo struct {
+	Name  string
+	Value float64
+	Cap   float64
+}
+
+type ProgressionInfo struct {
+	At     time.Time
+	Reason string
+	Trait  string
+	From   float64
+	To     float64
+	Detail string
+}
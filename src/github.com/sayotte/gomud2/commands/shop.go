@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// FindShopkeeper returns the first shopkeeper at the Location, or nil if
+// there isn't one.
+func FindShopkeeper(loc *core.Location) *core.Actor {
+	for _, a := range loc.Actors() {
+		if a.IsShopkeeper() && !a.IsGhost() {
+			return a
+		}
+	}
+	return nil
+}
+
+// ListShop describes the goods a shopkeeper has for sale, and their prices.
+func ListShop(shopkeeper *core.Actor) ShopInfo {
+	info := ShopInfo{
+		ShopkeeperID: shopkeeper.ID(),
+		Name:         shopkeeper.Name(),
+	}
+	rules := shopkeeper.Shop()
+	if rules == nil {
+		return info
+	}
+	for _, obj := range shopkeeper.Inventory().Objects() {
+		if obj.IsCurrency() {
+			continue
+		}
+		info.Items = append(info.Items, ShopItemInfo{
+			ID:    obj.ID(),
+			Name:  obj.Name(),
+			Price: rules.SellPrice(obj),
+		})
+	}
+	return info
+}
+
+// ValueObject describes what a shopkeeper would pay for an Object. Stolen
+// goods are worth nothing to a shopkeeper.
+func ValueObject(shopkeeper *core.Actor, obj *core.Object) ObjectValueInfo {
+	rules := shopkeeper.Shop()
+	if rules == nil || !rules.WillBuy(obj) || obj.IsStolen() {
+		return ObjectValueInfo{ObjectID: obj.ID(), Stolen: obj.IsStolen()}
+	}
+	return ObjectValueInfo{
+		ObjectID: obj.ID(),
+		WillBuy:  true,
+		Price:    rules.BuyPrice(obj),
+	}
+}
+
+type ShopInfo struct {
+	ShopkeeperID uuid.UUID
+	Name         string
+	Items        []ShopItemInfo
+}
+
+type ShopItemInfo struct {
+	ID    uuid.UUID
+	Name  string
+	Price int
+}
+
+type ObjectValueInfo struct {
+	ObjectID uuid.UUID
+	WillBuy  bool
+	Price    int
+	Stolen   bool
+}
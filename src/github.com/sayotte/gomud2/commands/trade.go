@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// DescribeTrade describes both sides of the trade the Actor has open, if any.
+func DescribeTrade(actor *core.Actor) TradeInfo {
+	partner := actor.TradingWith()
+	if partner == nil {
+		return TradeInfo{}
+	}
+	info := TradeInfo{
+		PartnerID:   partner.ID(),
+		PartnerName: partner.Name(),
+		Mine:        describeTradeOffer(actor),
+		Joined:      partner.TradingWith() == actor,
+	}
+	if info.Joined {
+		info.Theirs = describeTradeOffer(partner)
+	}
+	return info
+}
+
+func describeTradeOffer(actor *core.Actor) TradeOfferInfo {
+	objects, coins := actor.TradeOffer()
+	info := TradeOfferInfo{
+		Coins:    coins,
+		Accepted: actor.TradeAccepted(),
+	}
+	for _, obj := range objects {
+		info.Objects = append(info.Objects, LookAtObject(obj))
+	}
+	return info
+}
+
+type TradeInfo struct {
+	PartnerID   uuid.UUID
+	PartnerName string
+	// Joined is false until the partner has put up an offer of its own
+	Joined       bool
+	Mine, Theirs TradeOfferInfo
+}
+
+type TradeOfferInfo struct {
+	Objects  []ObjectVisibleInfo
+	Coins    int
+	Accepted bool
+}
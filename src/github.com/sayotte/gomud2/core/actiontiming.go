@@ -0,0 +1,169 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Per combatPlan.md, Physical lowers action delays by an absolute amount, and
+// Stamina lowers them by a percentage.
+const (
+	actionDelayPhysicalReductionPerPoint = time.Millisecond * 2
+	actionDelayStaminaReductionMax       = 0.25
+	actionDelayMinimumFraction           = 0.25
+)
+
+// ActorActionQueueLen is the number of delayed actions an Actor may have
+// pending (including the one currently waiting to execute) before further
+// actions are rejected with ErrActorNotReady.
+var ActorActionQueueLen = 2
+
+var ErrActorNotReady = errors.New("Actor is not ready to act yet")
+
+// ActionDelay scales a base action delay by the given attributes. Physical
+// reduces the delay by a fixed amount per point, Stamina by a percentage, and
+// the result never drops below a fixed fraction of the base delay.
+func ActionDelay(base time.Duration, attrs AttributeSet) time.Duration {
+	phys := attrs.Physical
+	if phys < 0 {
+		phys = 0
+	}
+	stam := attrs.Stamina
+	if stam < 0 {
+		stam = 0
+	} else if stam > 100 {
+		stam = 100
+	}
+
+	delay := base - time.Duration(phys)*actionDelayPhysicalReductionPerPoint
+	delay = time.Duration(float64(delay) * (1.0 - (float64(stam)/100)*actionDelayStaminaReductionMax))
+
+	minDelay := time.Duration(float64(base) * actionDelayMinimumFraction)
+	if delay < minDelay {
+		return minDelay
+	}
+	return delay
+}
+
+func (a *Actor) MoveDelay() time.Duration {
+	return ActionDelay(actorMoveDelay, a.Attributes())
+}
+
+func (a *Actor) MeleeDelay() time.Duration {
+	return ActionDelay(ActorMeleeDelay, a.Attributes())
+}
+
+// NextActionAt returns the earliest time at which the Actor's next delayed
+// action may begin.
+func (a *Actor) NextActionAt() time.Time {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.nextDelayedActionStart
+}
+
+// doDelayedAction queues the given action behind any other pending actions for
+// this Actor, waits until the Actor is ready to act, and then executes it. If
+// the action succeeds, the Actor won't be ready to act again until delay
+// (lengthened by any encumbrance) has passed, and its observers are told as
+// much via an ActorActionDelayEvent.
+func (a *Actor) doDelayedAction(delay time.Duration, action func() error) error {
+	a.rwlock.Lock()
+	if a.pendingActions >= ActorActionQueueLen {
+		a.rwlock.Unlock()
+		return ErrActorNotReady
+	}
+	a.pendingActions++
+	a.rwlock.Unlock()
+
+	a.actionLock.Lock()
+	defer a.actionLock.Unlock()
+
+	delayTilActionStart := a.NextActionAt().Sub(time.Now())
+	if delayTilActionStart > 0 {
+		time.Sleep(delayTilActionStart)
+	}
+
+	err := action()
+	delay = a.encumberedDelay(delay)
+
+	a.rwlock.Lock()
+	a.pendingActions--
+	if err == nil {
+		a.nextDelayedActionStart = time.Now().Add(delay)
+	}
+	a.rwlock.Unlock()
+
+	if err == nil {
+		e := NewActorActionDelayEvent(a.id, a.Zone().ID(), delay)
+		_, delayErr := a.syncRequestToZone(newActorActionDelayCommand(e))
+		if delayErr != nil {
+			fmt.Printf("CORE WARNING: Actor %q: cannot announce action delay: %s\n", a.id, delayErr)
+		}
+	}
+
+	return err
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newActorActionDelayCommand(wrapped *ActorActionDelayEvent) actorActionDelayCommand {
+	return actorActionDelayCommand{
+		commandGeneric{commandType: CommandTypeActorActionDelay},
+		wrapped,
+	}
+}
+
+type actorActionDelayCommand struct {
+	commandGeneric
+	wrappedEvent *ActorActionDelayEvent
+}
+
+func NewActorActionDelayEvent(actorID, zoneID uuid.UUID, delay time.Duration) *ActorActionDelayEvent {
+	now := time.Now()
+	return &ActorActionDelayEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorActionDelay,
+			TimeStamp:         now,
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: false,
+		},
+		ActorID: actorID,
+		Delay:   delay,
+		ReadyAt: now.Add(delay),
+	}
+}
+
+type ActorActionDelayEvent struct {
+	*eventGeneric
+	ActorID uuid.UUID
+	Delay   time.Duration
+	ReadyAt time.Time
+}
+
+func (z *Zone) processActorActionDelayCommand(c Command) ([]Event, error) {
+	cmd := c.(actorActionDelayCommand)
+	e := cmd.wrappedEvent
+
+	_, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+
+	e.SetSequenceNumber(z.nextSequenceId)
+	z.nextSequenceId = e.SequenceNumber() + 1
+	_, err := z.applyEvent(e)
+	return []Event{e}, err
+}
+
+func (z *Zone) applyActorActionDelayEvent(e *ActorActionDelayEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	// only the Actor's own observers care when it can act again
+	return actor.Observers(), nil
+}
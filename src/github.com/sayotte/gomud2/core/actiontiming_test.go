@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestActionDelay(t *testing.T) {
+	testCases := map[string]struct {
+		base          time.Duration
+		attrs         AttributeSet
+		expectedDelay time.Duration
+	}{
+		"no attributes": {
+			base:          time.Second,
+			expectedDelay: time.Second,
+		},
+		"physical only": {
+			base:          time.Second,
+			attrs:         AttributeSet{Physical: 100},
+			expectedDelay: time.Millisecond * 800,
+		},
+		"stamina only": {
+			base:          time.Second,
+			attrs:         AttributeSet{Stamina: 50},
+			expectedDelay: time.Millisecond * 875,
+		},
+		"physical and stamina": {
+			base:          time.Second,
+			attrs:         AttributeSet{Physical: 100, Stamina: 100},
+			expectedDelay: time.Millisecond * 600,
+		},
+		"negative attributes count as zero": {
+			base:          time.Second,
+			attrs:         AttributeSet{Physical: -50, Stamina: -50},
+			expectedDelay: time.Second,
+		},
+		"stamina beyond 100 counts as 100": {
+			base:          time.Second,
+			attrs:         AttributeSet{Stamina: 200},
+			expectedDelay: time.Millisecond * 750,
+		},
+		"never below the minimum fraction": {
+			base:          time.Second,
+			attrs:         AttributeSet{Physical: 1000, Stamina: 100},
+			expectedDelay: time.Millisecond * 250,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			delay := ActionDelay(tc.base, tc.attrs)
+			if delay != tc.expectedDelay {
+				t.Errorf("expected %s, got %s", tc.expectedDelay, delay)
+			}
+		})
+	}
+}
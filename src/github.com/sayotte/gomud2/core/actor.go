@@ -28,8 +28,9 @@ func NewActor(id uuid.UUID, name, brainType string, location *Location, zone *Zo
 		location:   location,
 		zone:       zone,
 		brainType:  brainType,
-		inventory:  &ActorInventory{constraints: inventoryConstraints},
+		inventory:  &ActorInventory{constraints: inventoryConstraints, carryLimit: CarryLimit(attrs.Strength)},
 		rwlock:     &sync.RWMutex{},
+		actionLock: &sync.Mutex{},
 		attributes: attrs,
 		skills:     skills,
 	}
@@ -42,6 +43,23 @@ type Actor struct {
 	zone                   *Zone
 	observers              ObserverList
 	nextDelayedActionStart time.Time
+	pendingActions         int
+	actionLock             *sync.Mutex
+	engagedWith            *Actor
+	ghostUntil             time.Time
+	bindLocationID         uuid.UUID
+	knownReactions         []string
+	deityID                uuid.UUID
+	mysticismAsOf          time.Time
+	shop                   *ShopRules
+	trade                  *actorTrade
+	hireTerms              *HireTerms
+	contract               *HirelingContract
+	factions               []string
+	reputation             map[string]int
+	quests                 map[string]QuestProgress
+	progression            []ProgressionEntry
+	activeEffects          []ActiveEffect
 
 	brainType string
 
@@ -101,12 +119,17 @@ func (a *Actor) Attributes() AttributeSet {
 
 func (a *Actor) setAttributes(attrs AttributeSet) {
 	a.attributes = attrs
+	a.inventory.carryLimit = CarryLimit(attrs.Strength)
 }
 
 func (a *Actor) Skills() Skillset {
 	return a.skills
 }
 
+func (a *Actor) setSkills(skills Skillset) {
+	a.skills = skills
+}
+
 func (a *Actor) Inventory() *ActorInventory {
 	return a.inventory
 }
@@ -166,6 +189,19 @@ func (a Actor) snapshot(sequenceNum uint64) Event {
 		a.skills,
 		a.inventory.Constraints(),
 	)
+	e.GhostUntil = a.ghostUntil
+	e.BindLocationID = a.bindLocationID
+	e.KnownReactions = a.KnownReactions()
+	e.DeityID = a.deityID
+	e.MysticismAsOf = a.mysticismAsOf
+	e.Shop = a.shop
+	e.HireTerms = a.hireTerms
+	e.HirelingContract = a.contract
+	e.Factions = a.factions
+	e.Reputation = a.reputation
+	e.Quests = a.quests
+	e.Progression = a.progression
+	e.ActiveEffects = a.activeEffects
 	e.SetSequenceNumber(sequenceNum)
 	return e
 }
@@ -181,25 +217,17 @@ func (a *Actor) Move(from, to *Location) error {
 		return fmt.Errorf("cross-zone moves should use the World.MigrateZone() API call")
 	}
 
-	delayTilActionStart := a.nextDelayedActionStart.Sub(time.Now())
-	if delayTilActionStart > 0 {
-		time.Sleep(delayTilActionStart)
-	}
-
-	e := NewActorMoveEvent(
-		from.ID(),
-		to.ID(),
-		a.id,
-		a.zone.ID(),
-	)
-	cmd := newActorMoveCommand(e)
-	_, err := a.syncRequestToZone(cmd)
-
-	if err == nil {
-		a.nextDelayedActionStart = time.Now().Add(actorMoveDelay)
-	}
-
-	return err
+	return a.doDelayedAction(a.MoveDelay(), func() error {
+		e := NewActorMoveEvent(
+			from.ID(),
+			to.ID(),
+			a.id,
+			a.zone.ID(),
+		)
+		cmd := newActorMoveCommand(e)
+		_, err := a.syncRequestToZone(cmd)
+		return err
+	})
 }
 
 func (a *Actor) AdminRelocate(to *Location) error {
@@ -222,14 +250,11 @@ func (a *Actor) Bite(target *Actor) error {
 }
 
 func (a *Actor) meleeGeneric(target *Actor, dmgType string) error {
-	dmgCmd := newCombatMeleeCommand(a, target, dmgType)
-	_, err := a.syncRequestToZone(dmgCmd)
-
-	if err == nil {
-		a.nextDelayedActionStart = time.Now().Add(ActorMeleeDelay)
-	}
-
-	return err
+	return a.doDelayedAction(a.MeleeDelay(), func() error {
+		dmgCmd := newCombatMeleeCommand(a, target, dmgType)
+		_, err := a.syncRequestToZone(dmgCmd)
+		return err
+	})
 }
 
 func (a *Actor) Die() error {
@@ -378,6 +403,19 @@ func NewActorAddToZoneEvent(name, brainType string, actorId, startingLocationId,
 		attrs,
 		skills,
 		invConstraints,
+		time.Time{},
+		uuid.Nil,
+		nil,
+		uuid.Nil,
+		time.Time{},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	}
 }
 
@@ -389,6 +427,19 @@ type ActorAddToZoneEvent struct {
 	Attributes           AttributeSet
 	Skills               Skillset
 	InventoryConstraints ActorInventoryConstraints
+	GhostUntil           time.Time
+	BindLocationID       uuid.UUID
+	KnownReactions       []string
+	DeityID              uuid.UUID
+	MysticismAsOf        time.Time
+	Shop                 *ShopRules
+	HireTerms            *HireTerms
+	HirelingContract     *HirelingContract
+	Factions             []string
+	Reputation           map[string]int
+	Quests               map[string]QuestProgress
+	Progression          []ProgressionEntry
+	ActiveEffects        []ActiveEffect
 }
 
 func newActorRemoveFromZoneCommand(wrapped *ActorRemoveFromZoneEvent) *actorRemoveFromZoneCommand {
@@ -444,13 +495,19 @@ func NewActorDeathEvent(name string, actorID, zoneID uuid.UUID) *ActorDeathEvent
 		},
 		name,
 		actorID,
+		uuid.Nil,
+		"",
 	}
 }
 
+// ActorDeathEvent records an Actor dying; KillerID is uuid.Nil unless another
+// Actor killed it.
 type ActorDeathEvent struct {
 	*eventGeneric
-	ActorName string
-	ActorID   uuid.UUID
+	ActorName  string
+	ActorID    uuid.UUID
+	KillerID   uuid.UUID
+	KillerName string
 }
 
 func newActorMigrateInCommand(actor *Actor, from, to *Location, oList ObserverList) *actorMigrateInCommand {
@@ -500,6 +557,19 @@ type ActorMigrateInEvent struct {
 	Attributes            AttributeSet
 	Skills                Skillset
 	InventoryConstraints  ActorInventoryConstraints
+	GhostUntil            time.Time
+	BindLocationID        uuid.UUID
+	KnownReactions        []string
+	DeityID               uuid.UUID
+	MysticismAsOf         time.Time
+	Shop                  *ShopRules
+	HireTerms             *HireTerms
+	HirelingContract      *HirelingContract
+	Factions              []string
+	Reputation            map[string]int
+	Quests                map[string]QuestProgress
+	Progression           []ProgressionEntry
+	ActiveEffects         []ActiveEffect
 }
 
 func newActorMigrateOutCommand(actor *Actor, from, to *Location) *actorMigrateOutCommand {
@@ -578,32 +648,46 @@ type ActorSpeakEvent struct {
 
 /////////////////// Reusable code closely related to Actors ///////////////////
 
-func doActorDeath(actor *Actor, zone *Zone) []Event {
+func doActorDeath(actor, killer *Actor, zone *Zone) []Event {
 	var outEvents []Event
 
 	// Create the death event itself
 	deathEv := NewActorDeathEvent(actor.Name(), actor.ID(), zone.id)
+	if killer != nil {
+		deathEv.KillerID = killer.ID()
+		deathEv.KillerName = killer.Name()
+	}
 	outEvents = append(outEvents, deathEv)
 
+	// The victim's factions, and their enemies, take note of the killing
+	outEvents = append(outEvents, zone.reputationEventsForKill(killer, actor)...)
+	outEvents = append(outEvents, zone.crimeEventsForMurder(killer, actor)...)
+
 	// Create a corpse to hold the objects previously held by the Actor
-	corpseObjectProto := NewObject(
-		uuid.Nil,
-		actor.Name()+"'s corpse",
-		"The empty husk of what was once a living thing.",
-		[]string{"corpse"},
-		actor.Location(),
-		100,
-		zone,
-		ObjectAttributes{},
-	)
-	corpseObjEv := corpseObjectProto.snapshot(zone.nextSequenceId)
+	corpseObjEv, corpseID := newCorpseAddToZoneEvent(actor, killer, zone)
 	outEvents = append(outEvents, corpseObjEv)
 
-	// Relocate all the Actor's objects to the corpse
-	for _, objContTuple := range getObjectContainerTuplesRecursive(actor) {
-		objEv := NewObjectAdminRelocateEvent(objContTuple.obj.ID(), zone.id)
-		objEv.ToObjectContainerID = corpseObjectProto.ID()
-		outEvents = append(outEvents, objEv)
+	// Relocate all the Actor's objects to the corpse, unless it's a player
+	// character whose death penalty lets it keep them
+	if !actor.IsPlayerCharacter() || PlayerCharacterDeathPenalty.LeaveGearOnCorpse {
+		for _, objContTuple := range getObjectContainerTuplesRecursive(actor) {
+			objEv := NewObjectAdminRelocateEvent(objContTuple.obj.ID(), zone.id)
+			objEv.ToObjectContainerID = corpseID
+			outEvents = append(outEvents, objEv)
+		}
+	}
+
+	// End any fights the Actor was involved in
+	outEvents = append(outEvents, zone.disengageEventsFor(actor, CombatDisengageReasonDeath)...)
+	outEvents = append(outEvents, zone.tradeCancelEventsFor(actor, TradeCancelReasonDeath)...)
+	outEvents = append(outEvents, zone.effectEndEventsFor(actor, EffectEndReasonDeath)...)
+
+	// Player characters linger as ghosts until they respawn, so that their
+	// sessions stay attached
+	if actor.IsPlayerCharacter() {
+		ghostEv := NewActorBecomeGhostEvent(actor.Name(), actor.ID(), zone.id, time.Now().Add(PlayerGhostDuration))
+		outEvents = append(outEvents, ghostEv)
+		return outEvents
 	}
 
 	// Remove the Actor, as it's supposed to be dead and has been replaced by
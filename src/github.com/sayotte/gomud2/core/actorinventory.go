@@ -5,6 +5,8 @@ import (
 	"fmt"
 )
 
+var ErrInventoryNoRoom = errors.New("Actor has no room to hold that")
+
 const (
 	// ascending powers of 4
 	ObjectSizeTinySlots   = 1   // e.g. a marble
@@ -56,6 +58,9 @@ type ActorInventory struct {
 	belt        ObjectList
 	body        ObjectList
 	hands       ObjectList
+
+	// carryLimit is kept in step with the owning Actor's Strength
+	carryLimit float64
 }
 
 func (ai *ActorInventory) Constraints() ActorInventoryConstraints {
@@ -161,6 +166,27 @@ func (ai *ActorInventory) addObject(o *Object, subContainer string) error {
 		subContainer = InventoryContainerHands
 	}
 
+	err := ai.checkAddObject(o, subContainer)
+	if err != nil {
+		return err
+	}
+
+	switch subContainer {
+	case InventoryContainerBack:
+		ai.back = append(ai.back, o)
+	case InventoryContainerBelt:
+		ai.belt = append(ai.belt, o)
+	case InventoryContainerBody:
+		ai.body = append(ai.body, o)
+	case InventoryContainerHands:
+		ai.hands = append(ai.hands, o)
+	}
+	return nil
+}
+
+// checkAddObject returns an error if the Object can't be added to the given
+// subcontainer.
+func (ai *ActorInventory) checkAddObject(o *Object, subContainer string) error {
 	var subObjs ObjectList
 	var subMaxObjs, subSlots int
 	switch subContainer {
@@ -193,22 +219,59 @@ func (ai *ActorInventory) addObject(o *Object, subContainer string) error {
 		slotsTaken += o.InventorySlots()
 	}
 	if slotsTaken+o.InventorySlots() > subSlots {
-		return fmt.Errorf("item too large to fit in/on %s")
+		return fmt.Errorf("item too large to fit in/on %s", subContainer)
 	}
 
-	switch subContainer {
-	case InventoryContainerBack:
-		ai.back = append(ai.back, o)
-	case InventoryContainerBelt:
-		ai.belt = append(ai.belt, o)
-	case InventoryContainerBody:
-		ai.body = append(ai.body, o)
-	case InventoryContainerHands:
-		ai.hands = append(ai.hands, o)
+	if !ai.counts(o) && ai.Weight()+o.Weight() > ai.carryLimit {
+		return ErrInventoryTooHeavy
 	}
 	return nil
 }
 
+// roomFor returns the first subcontainer the Object could be added to, or the
+// empty string if there's no room for it anywhere.
+func (ai *ActorInventory) roomFor(o *Object) string {
+	for _, subContainer := range AllActorInventorySubcontainers {
+		if ai.checkAddObject(o, subContainer) == nil {
+			return subContainer
+		}
+	}
+	return ""
+}
+
+// placementsFor returns the subcontainer each of the incoming Objects would be
+// added to, in turn, once the outgoing Objects have been removed, or an error
+// if they wouldn't all fit.
+func (ai *ActorInventory) placementsFor(incoming, outgoing ObjectList) ([]string, error) {
+	sim := &ActorInventory{
+		constraints: ai.constraints,
+		back:        ai.back.Copy(),
+		belt:        ai.belt.Copy(),
+		body:        ai.body.Copy(),
+		hands:       ai.hands.Copy(),
+		carryLimit:  ai.carryLimit,
+	}
+	for _, o := range outgoing {
+		sim.removeObject(o)
+	}
+
+	places := make([]string, len(incoming))
+	for i, o := range incoming {
+		if sim.Weight()+o.Weight() > sim.carryLimit {
+			return nil, ErrInventoryTooHeavy
+		}
+		places[i] = sim.roomFor(o)
+		if places[i] == "" {
+			return nil, ErrInventoryNoRoom
+		}
+		err := sim.addObject(o, places[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return places, nil
+}
+
 func (ai *ActorInventory) removeObject(o *Object) {
 	ai.back = ai.back.Remove(o)
 	ai.belt = ai.belt.Remove(o)
@@ -261,9 +324,9 @@ func (ai *ActorInventory) checkMoveObjectToSubcontainer(o *Object, srcSub, dstSu
 		}
 		var dstSLotsTaken int
 		for _, dstO := range dstList {
-			dstSLotsTaken += dstO.inventorySlots
+			dstSLotsTaken += dstO.InventorySlots()
 		}
-		if dstSLotsTaken+o.inventorySlots > dstSlots {
+		if dstSLotsTaken+o.InventorySlots() > dstSlots {
 			return errors.New("object can't fit in that subcontainer")
 		}
 		if len(dstList)+1 > dstMaxItems {
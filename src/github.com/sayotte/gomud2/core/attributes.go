@@ -13,3 +13,34 @@ type AttributeSet struct {
 	NaturalBiteMin, NaturalBiteMax   float64
 	NaturalSlashMin, NaturalSlashMax float64
 }
+
+// modifiedBy returns a copy of the AttributeSet with each attribute's current
+// value adjusted by the corresponding value in mod, e.g. by a status effect.
+// Caps are unaffected, and no attribute is reduced below zero.
+func (as AttributeSet) modifiedBy(mod AttributeSet) AttributeSet {
+	clamp := func(v int) int {
+		if v < 0 {
+			return 0
+		}
+		return v
+	}
+	clampF := func(v float64) float64 {
+		if v < 0 {
+			return 0
+		}
+		return v
+	}
+	as.Strength = clamp(as.Strength + mod.Strength)
+	as.Fitness = clamp(as.Fitness + mod.Fitness)
+	as.Will = clamp(as.Will + mod.Will)
+	as.Faith = clamp(as.Faith + mod.Faith)
+	as.Physical = clamp(as.Physical + mod.Physical)
+	as.Stamina = clamp(as.Stamina + mod.Stamina)
+	as.Focus = clamp(as.Focus + mod.Focus)
+	as.Zeal = clamp(as.Zeal + mod.Zeal)
+	as.NaturalBiteMin = clampF(as.NaturalBiteMin + mod.NaturalBiteMin)
+	as.NaturalBiteMax = clampF(as.NaturalBiteMax + mod.NaturalBiteMax)
+	as.NaturalSlashMin = clampF(as.NaturalSlashMin + mod.NaturalSlashMin)
+	as.NaturalSlashMax = clampF(as.NaturalSlashMax + mod.NaturalSlashMax)
+	return as
+}
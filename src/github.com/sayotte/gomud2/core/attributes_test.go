@@ -0,0 +1,73 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAttributeSet_modifiedBy(t *testing.T) {
+	base := AttributeSet{
+		TotalBaseCap: 200,
+		Strength:     20, StrengthCap: 50,
+		Fitness: 20, FitnessCap: 50,
+		Will: 20, WillCap: 50,
+		Faith: 20, Faithcap: 50,
+		Physical: 100, Stamina: 100, Focus: 100, Zeal: 100,
+		NaturalBiteMin: 1, NaturalBiteMax: 2,
+		NaturalSlashMin: 1, NaturalSlashMax: 2,
+	}
+
+	testCases := map[string]struct {
+		mod           AttributeSet
+		expectedAttrs AttributeSet
+	}{
+		"no modifier": {
+			expectedAttrs: base,
+		},
+		"buff": {
+			mod: AttributeSet{Strength: 5, Stamina: 10, NaturalBiteMax: 1.5},
+			expectedAttrs: AttributeSet{
+				TotalBaseCap: 200,
+				Strength:     25, StrengthCap: 50,
+				Fitness: 20, FitnessCap: 50,
+				Will: 20, WillCap: 50,
+				Faith: 20, Faithcap: 50,
+				Physical: 100, Stamina: 110, Focus: 100, Zeal: 100,
+				NaturalBiteMin: 1, NaturalBiteMax: 3.5,
+				NaturalSlashMin: 1, NaturalSlashMax: 2,
+			},
+		},
+		"debuff stops at zero": {
+			mod: AttributeSet{Will: -30, Focus: -150, NaturalSlashMin: -5},
+			expectedAttrs: AttributeSet{
+				TotalBaseCap: 200,
+				Strength:     20, StrengthCap: 50,
+				Fitness: 20, FitnessCap: 50,
+				Will: 0, WillCap: 50,
+				Faith: 20, Faithcap: 50,
+				Physical: 100, Stamina: 100, Focus: 0, Zeal: 100,
+				NaturalBiteMin: 1, NaturalBiteMax: 2,
+				NaturalSlashMin: 0, NaturalSlashMax: 2,
+			},
+		},
+		"caps are unaffected": {
+			mod: AttributeSet{
+				TotalBaseCap: 50,
+				StrengthCap:  10,
+				FitnessCap:   10,
+				WillCap:      10,
+				Faithcap:     10,
+			},
+			expectedAttrs: base,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			attrs := base.modifiedBy(tc.mod)
+			if !reflect.DeepEqual(attrs, tc.expectedAttrs) {
+				t.Errorf("expected %+v, got %+v", tc.expectedAttrs, attrs)
+			}
+		})
+	}
+}
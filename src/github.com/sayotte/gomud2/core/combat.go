@@ -66,14 +66,17 @@ func (cmc combatMeleeCommand) Do() ([]Event, error) {
 }
 
 func (cmc combatMeleeCommand) doSlash() ([]Event, error) {
-	if cmc.checkDodge(cmc.attacker.Skills().Slashing, cmc.target) {
+	if cmc.checkDodge(cmc.attacker.EffectiveSkills().Slashing, cmc.target) {
 		dodgeEvent := NewCombatDodgeEvent(CombatMeleeDamageTypeSlash, cmc.attacker.Name(), cmc.target.Name(), cmc.attacker.ID(), cmc.target.ID(), cmc.attacker.Zone().ID())
 		return []Event{dodgeEvent}, nil
 	}
 
+	// status effects on the attacker make it hit harder or softer
+	attackerAttrs := cmc.attacker.EffectiveAttributes()
+
 	// find weapon in attacker's hands with highest slashing damage cap; use
 	// that for damage range
-	weaponMinBaseDmg, weaponMaxBaseDmg := cmc.attacker.attributes.NaturalSlashMin, cmc.attacker.attributes.NaturalSlashMax
+	weaponMinBaseDmg, weaponMaxBaseDmg := attackerAttrs.NaturalSlashMin, attackerAttrs.NaturalSlashMax
 	for _, obj := range cmc.attacker.Inventory().ObjectsBySubcontainer(InventoryContainerHands) {
 		attrs := obj.Attributes()
 		if attrs.SlashingDamageMax > weaponMaxBaseDmg {
@@ -84,8 +87,8 @@ func (cmc combatMeleeCommand) doSlash() ([]Event, error) {
 	// calculate damage after bonuses etc.
 	baseDmgRange := weaponMaxBaseDmg - weaponMinBaseDmg
 	scaledBaseDmg := (rollFloat64(cmc.attacker.Zone().Rand()) * baseDmgRange) + weaponMinBaseDmg
-	physBonus := (float64(cmc.attacker.Attributes().Physical) / 100) * scaledBaseDmg // max 0.50
-	focBonus := (float64(cmc.attacker.Attributes().Focus) / 100) * scaledBaseDmg     // max 0.15
+	physBonus := (float64(attackerAttrs.Physical) / 100) * scaledBaseDmg // max 0.50
+	focBonus := (float64(attackerAttrs.Focus) / 100) * scaledBaseDmg     // max 0.15
 	totalDmg := scaledBaseDmg + physBonus + focBonus
 
 	// distribute damage 3:1:1 over phys:stam:focus
@@ -109,7 +112,7 @@ func (cmc combatMeleeCommand) doSlash() ([]Event, error) {
 }
 
 func (cmc combatMeleeCommand) doBite() ([]Event, error) {
-	if cmc.checkDodge(cmc.attacker.Skills().Biting, cmc.target) {
+	if cmc.checkDodge(cmc.attacker.EffectiveSkills().Biting, cmc.target) {
 		dodgeEvent := NewCombatDodgeEvent(
 			CombatMeleeDamageTypeBite,
 			cmc.attacker.Name(),
@@ -122,8 +125,9 @@ func (cmc combatMeleeCommand) doBite() ([]Event, error) {
 	}
 
 	// calculate damage after bonuses etc.
-	minBaseDmg := cmc.attacker.Attributes().NaturalBiteMin
-	maxBaseDmg := cmc.attacker.Attributes().NaturalBiteMax
+	attackerAttrs := cmc.attacker.EffectiveAttributes()
+	minBaseDmg := attackerAttrs.NaturalBiteMin
+	maxBaseDmg := attackerAttrs.NaturalBiteMax
 	baseDmgRange := maxBaseDmg - minBaseDmg
 	totalDmg := (rollFloat64(cmc.attacker.Zone().Rand()) * baseDmgRange) + minBaseDmg
 
@@ -148,7 +152,8 @@ func (cmc combatMeleeCommand) doBite() ([]Event, error) {
 }
 
 func (cmc combatMeleeCommand) checkDodge(attackSkill float64, defender *Actor) bool {
-	dSkills := defender.Skills()
+	dSkills := defender.EffectiveSkills()
+	dAttrs := defender.EffectiveAttributes()
 	defendSkill := dSkills.Dodging
 
 	// scale our % chance to the difference between attacker/defender skills
@@ -162,8 +167,8 @@ func (cmc combatMeleeCommand) checkDodge(attackSkill float64, defender *Actor) b
 	skillScale += 50
 
 	scaledChance := (skillScale / 100) * combatDodgeBaseChance
-	stamBonus := float64(defender.Attributes().Stamina) / 100
-	focBonus := float64(defender.Attributes().Focus) / 100
+	stamBonus := float64(dAttrs.Stamina) / 100
+	focBonus := float64(dAttrs.Focus) / 100
 	chance := scaledChance + stamBonus + focBonus
 
 	for i := 1; i <= dSkills.DodgingTechniques; i++ {
@@ -181,6 +186,7 @@ func (cmc combatMeleeCommand) checkDodge(attackSkill float64, defender *Actor) b
 
 func (cmc combatMeleeCommand) addDeathEventIfNeeded(damageEvent *CombatMeleeDamageEvent) []Event {
 	outEvents := []Event{damageEvent}
+	outEvents = append(outEvents, cmc.target.Zone().crimeEventsForAssault(cmc.attacker, cmc.target)...)
 
 	switch {
 	case cmc.target.attributes.Physical-damageEvent.PhysicalDmg <= 0:
@@ -188,7 +194,7 @@ func (cmc combatMeleeCommand) addDeathEventIfNeeded(damageEvent *CombatMeleeDama
 	case cmc.target.attributes.Stamina-damageEvent.StaminaDmg <= 0:
 		fallthrough
 	case cmc.target.attributes.Focus-damageEvent.FocusDmg <= 0:
-		deathEvents := doActorDeath(cmc.target, cmc.target.Zone())
+		deathEvents := doActorDeath(cmc.target, cmc.attacker, cmc.target.Zone())
 		outEvents = append(outEvents, deathEvents...)
 	}
 
@@ -26,6 +26,46 @@ const (
 	CommandTypeObjectRemoveFromZone
 	CommandTypeZoneSetDefaultLocation
 	CommandTypeCombatMelee
+	CommandTypeActorActionDelay
+	CommandTypeCombatEngage
+	CommandTypeCombatDisengage
+	CommandTypeCombatFlee
+	CommandTypeCombatRound
+	CommandTypeObjectDecay
+	CommandTypeActorRespawn
+	CommandTypeActorSetBindLocation
+	CommandTypeSorceryInvoke
+	CommandTypeActorReadScroll
+	CommandTypeDeityAdd
+	CommandTypeDeitySacrifice
+	CommandTypeDeityPrayerAnswered
+	CommandTypeActorDedicate
+	CommandTypeActorSacrifice
+	CommandTypeActorPray
+	CommandTypeActorScribe
+	CommandTypeExitSetDoor
+	CommandTypeActorDoor
+	CommandTypeActorContainerAction
+	CommandTypeShopTrade
+	CommandTypeActorSetShop
+	CommandTypeTradeOffer
+	CommandTypeTradeAccept
+	CommandTypeTradeCancel
+	CommandTypeActorSetHireTerms
+	CommandTypeActorHire
+	CommandTypeActorFire
+	CommandTypeActorOrder
+	CommandTypeHirelingContractCheck
+	CommandTypeActorFactionMembership
+	CommandTypeZoneSetLaw
+	CommandTypeCrimeReport
+	CommandTypeCrimeResolve
+	CommandTypeActorQuest
+	CommandTypeActorConsume
+	CommandTypeActorEffectCheck
+	CommandTypeActorCraft
+	CommandTypeObjectPrototypeUpdate
+	CommandTypeDeityReversal
 )
 
 type commandGeneric struct {
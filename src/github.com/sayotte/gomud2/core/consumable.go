@@ -0,0 +1,273 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Consumables are Objects which are eaten, drunk or otherwise used up: food,
+// drink and potions. Each has a number of uses, and each use has the same
+// effects on whoever consumes it. Some effects are immediate, like restoring
+// an Actor's Physical, Stamina or Focus; others put a status effect on the
+// Actor, like a buff which raises one of them until it wears off, or a poison
+// which wears the Actor's Physical down every few seconds until it runs its
+// course.
+//
+// Consuming something, and every change to an Actor's attributes its effects
+// make, is recorded as an event of its own, so that the reason for any change
+// can be seen when the Zone's events are replayed or debugged.
+
+const (
+	ConsumeMethodEat   = "eat"
+	ConsumeMethodDrink = "drink"
+	ConsumeMethodUse   = "use"
+
+	ConsumableEffectRestore = "restore"
+	ConsumableEffectBuff    = "buff"
+	ConsumableEffectPoison  = "poison"
+	// ConsumableEffectStatus puts an arbitrary status effect on the Actor.
+	ConsumableEffectStatus = "status"
+
+	StatusEffectPoisoned = "poisoned"
+)
+
+// ActorConsumeDelay is the base delay following eating, drinking or using
+// something.
+var ActorConsumeDelay = time.Second
+
+// PoisonTickInterval is how often a poison wears down the Actor it's
+// affecting.
+var PoisonTickInterval = time.Second * 5
+
+// buffNames names the status effect of a buff to each attribute.
+var buffNames = map[string]string{
+	EffectAttributePhysical: "fortified",
+	EffectAttributeStamina:  "invigorated",
+	EffectAttributeFocus:    "focused",
+}
+
+var (
+	ErrObjectNotConsumable = errors.New("Object can't be consumed")
+	ErrWrongConsumeMethod  = errors.New("Object can't be consumed that way")
+	ErrObjectNotHeld       = errors.New("Actor isn't holding that Object")
+)
+
+// ConsumableEffect is one of the effects of consuming an Object.
+type ConsumableEffect struct {
+	// Type is one of the ConsumableEffect* constants.
+	Type string `yaml:"type"`
+	// Attribute is one of the EffectAttribute* constants; poisons always
+	// affect Physical.
+	Attribute string `yaml:"attribute,omitempty"`
+	// Magnitude is how much the attribute is restored, raised or, by each
+	// tick of a poison, reduced.
+	Magnitude int `yaml:"magnitude"`
+	// Duration is how long a buff or poison lasts.
+	Duration time.Duration `yaml:"duration,omitempty"`
+	// Status is the status effect of a ConsumableEffectStatus.
+	Status *StatusEffect `yaml:"status,omitempty"`
+}
+
+// statusEffect returns the status effect put on whoever consumes the named
+// source, if there is one.
+func (ce ConsumableEffect) statusEffect(source string) (StatusEffect, bool) {
+	var effect StatusEffect
+	switch ce.Type {
+	case ConsumableEffectBuff:
+		effect = StatusEffect{
+			Name:       buffNames[ce.Attribute],
+			Attributes: withAttributeDelta(AttributeSet{}, ce.Attribute, ce.Magnitude),
+			Duration:   ce.Duration,
+		}
+	case ConsumableEffectPoison:
+		// every dose of poison does its damage
+		effect = StatusEffect{
+			Name:          StatusEffectPoisoned,
+			Stacking:      StatusEffectStackingStack,
+			TickAttribute: EffectAttributePhysical,
+			TickDelta:     -ce.Magnitude,
+			TickInterval:  PoisonTickInterval,
+			Duration:      ce.Duration,
+		}
+	case ConsumableEffectStatus:
+		if ce.Status == nil {
+			return StatusEffect{}, false
+		}
+		effect = *ce.Status
+	default:
+		return StatusEffect{}, false
+	}
+	effect.Source = source
+	return effect, true
+}
+
+//////// Object methods
+
+// IsConsumable reports whether the Object can be eaten, drunk or used up.
+func (o *Object) IsConsumable() bool {
+	return o.attributes.ConsumeMethod != ""
+}
+
+// UsesLeft returns how many more times the Object can be consumed before
+// it's all gone.
+func (o *Object) UsesLeft() int {
+	if !o.IsConsumable() {
+		return 0
+	}
+	if o.attributes.Uses < 1 {
+		return 1
+	}
+	return o.attributes.Uses
+}
+
+//////// Actor methods
+
+func (a *Actor) ConsumeDelay() time.Duration {
+	return ActionDelay(ActorConsumeDelay, a.Attributes())
+}
+
+// Consume eats, drinks or uses (according to method, one of the
+// ConsumeMethod* constants) an Object the Actor is carrying. Anything
+// consumable can be used, but only food can be eaten and only drink drunk.
+func (a *Actor) Consume(obj *Object, method string) error {
+	return a.doDelayedAction(a.ConsumeDelay(), func() error {
+		_, err := a.syncRequestToZone(newActorConsumeCommand(a, obj, method))
+		return err
+	})
+}
+
+//////// Zone-side processing
+
+func (z *Zone) processActorConsumeCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorConsumeCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	_, found = z.objectsById[cmd.obj.ID()]
+	if !found || !heldBy(cmd.obj.Container(), cmd.actor) {
+		return nil, ErrObjectNotHeld
+	}
+	if !cmd.obj.WithinReachOf(cmd.actor) {
+		return nil, ErrContainerClosed
+	}
+	if cmd.obj.lockedInTrade() {
+		return nil, ErrObjectLockedInTrade
+	}
+	if cmd.actor.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if !cmd.obj.IsConsumable() {
+		return nil, ErrObjectNotConsumable
+	}
+	if cmd.method != ConsumeMethodUse && cmd.method != cmd.obj.Attributes().ConsumeMethod {
+		return nil, ErrWrongConsumeMethod
+	}
+
+	usesLeft := cmd.obj.UsesLeft() - 1
+	outEvents := []Event{
+		NewActorConsumeEvent(
+			cmd.actor.ID(),
+			cmd.obj.ID(),
+			z.id,
+			cmd.actor.Name(),
+			cmd.obj.Name(),
+			cmd.method,
+			usesLeft,
+		),
+	}
+	if usesLeft == 0 {
+		outEvents = append(outEvents, NewObjectRemoveFromZoneEvent(cmd.obj.Name(), cmd.obj.ID(), z.id))
+	}
+
+	attrs := cmd.actor.Attributes()
+	for _, effect := range cmd.obj.Attributes().Effects {
+		if effect.Type == ConsumableEffectRestore {
+			// restoring can't raise an attribute beyond what respawning would
+			delta := cappedAttributeDelta(attrs, effect.Attribute, effect.Magnitude)
+			if delta <= 0 {
+				continue
+			}
+			attrs = withAttributeDelta(attrs, effect.Attribute, delta)
+			outEvents = append(outEvents, NewActorEffectEvent(
+				cmd.actor.ID(),
+				uuid.Nil,
+				z.id,
+				cmd.actor.Name(),
+				cmd.obj.Name(),
+				effect.Type,
+				effect.Attribute,
+				delta,
+			))
+			continue
+		}
+		if status, ok := effect.statusEffect(cmd.obj.Name()); ok {
+			outEvents = append(outEvents, z.statusEffectEventsFor(cmd.actor, status)...)
+		}
+	}
+
+	return z.sequenceAndApplyEvents(outEvents)
+}
+
+func (z *Zone) applyActorConsumeEvent(e *ActorConsumeEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q consuming Object", e.ActorID)
+	}
+	obj, found := z.objectsById[e.ObjectID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Object %q to consume", e.ObjectID)
+	}
+	obj.attributes.Uses = e.UsesLeft
+	return actor.Location().Observers(), nil
+}
+
+//////// Commands and events
+
+func newActorConsumeCommand(actor *Actor, obj *Object, method string) *actorConsumeCommand {
+	return &actorConsumeCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorConsume},
+		actor:          actor,
+		obj:            obj,
+		method:         method,
+	}
+}
+
+type actorConsumeCommand struct {
+	commandGeneric
+	actor  *Actor
+	obj    *Object
+	method string
+}
+
+func NewActorConsumeEvent(actorID, objectID, zoneID uuid.UUID, actorName, objectName, method string, usesLeft int) *ActorConsumeEvent {
+	return &ActorConsumeEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorConsume,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:    actorID,
+		ObjectID:   objectID,
+		ActorName:  actorName,
+		ObjectName: objectName,
+		Method:     method,
+		UsesLeft:   usesLeft,
+	}
+}
+
+// ActorConsumeEvent records an Actor eating, drinking or using an Object,
+// leaving it with UsesLeft uses. Its effects follow as events of their own.
+type ActorConsumeEvent struct {
+	*eventGeneric
+	ActorID, ObjectID     uuid.UUID
+	ActorName, ObjectName string
+	Method                string
+	UsesLeft              int
+}
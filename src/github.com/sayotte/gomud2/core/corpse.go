@@ -0,0 +1,157 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+var (
+	// How long a corpse lies around before decaying, dropping whatever is
+	// left in it on the ground.
+	CorpseDecayDelay = time.Minute * 10
+	// How long only the killer and whoever else was fighting the deceased
+	// may loot its corpse.
+	CorpseLootRightsGracePeriod = time.Minute * 2
+	// How often a Zone checks for Objects which have decayed.
+	objectDecayCheckInterval = time.Second * 5
+)
+
+var (
+	ErrObjectLootRightsReserved = errors.New("Object is reserved for looting by others")
+	ErrObjectNotPortable        = errors.New("Object cannot be carried")
+)
+
+//////// Object getters
+
+// DecayAt returns the time at which the Object will decay and be removed from
+// the Zone, or the zero time if it never decays.
+func (o *Object) DecayAt() time.Time {
+	return o.decayAt
+}
+
+func (o *Object) LootRightsActorIDs() []uuid.UUID {
+	out := make([]uuid.UUID, len(o.lootRightsActorIDs))
+	copy(out, o.lootRightsActorIDs)
+	return out
+}
+
+func (o *Object) LootRightsUntil() time.Time {
+	return o.lootRightsUntil
+}
+
+// CorpseOf returns the ID of the Actor this Object is the corpse of, or
+// uuid.Nil if it isn't a corpse.
+func (o *Object) CorpseOf() uuid.UUID {
+	return o.corpseOfID
+}
+
+// IsPortable reports whether Actors may carry the Object. Objects which decay,
+// such as corpses, cannot be carried.
+func (o *Object) IsPortable() bool {
+	return o.decayAt.IsZero()
+}
+
+// CanBeLootedBy reports whether the given Actor may take things out of this
+// Object right now.
+func (o *Object) CanBeLootedBy(a *Actor) bool {
+	if len(o.lootRightsActorIDs) == 0 || time.Now().After(o.lootRightsUntil) {
+		return true
+	}
+	if a == nil {
+		return false
+	}
+	for _, id := range o.lootRightsActorIDs {
+		if uuid.Equal(id, a.ID()) {
+			return true
+		}
+	}
+	return false
+}
+
+// newCorpseAddToZoneEvent creates the corpse of a freshly dead Actor, with
+// looting rights for its killer and anyone else who was fighting it.
+func newCorpseAddToZoneEvent(actor, killer *Actor, zone *Zone) (*ObjectAddToZoneEvent, uuid.UUID) {
+	corpseObjectProto := NewObject(
+		uuid.Nil,
+		actor.Name()+"'s corpse",
+		"The empty husk of what was once a living thing.",
+		[]string{"corpse"},
+		actor.Location(),
+		// big enough for everything the Actor was carrying
+		actor.Inventory().Capacity(),
+		zone,
+		ObjectAttributes{},
+	)
+	corpseObjEv := corpseObjectProto.snapshot(zone.nextSequenceId).(*ObjectAddToZoneEvent)
+
+	now := time.Now()
+	corpseObjEv.DecayAt = now.Add(CorpseDecayDelay)
+	corpseObjEv.CorpseOfID = actor.ID()
+
+	rightsMap := make(map[uuid.UUID]bool)
+	if killer != nil {
+		rightsMap[killer.ID()] = true
+	}
+	for _, a := range zone.actorsEngagedWith(actor) {
+		rightsMap[a.ID()] = true
+	}
+	// player characters can always recover their own gear
+	if actor.IsPlayerCharacter() {
+		rightsMap[actor.ID()] = true
+	}
+	if len(rightsMap) > 0 {
+		for id := range rightsMap {
+			corpseObjEv.LootRightsActorIDs = append(corpseObjEv.LootRightsActorIDs, id)
+		}
+		corpseObjEv.LootRightsUntil = now.Add(CorpseLootRightsGracePeriod)
+	}
+
+	return corpseObjEv, corpseObjectProto.ID()
+}
+
+//////// Zone-side processing
+
+// processObjectDecayCommand removes every Object whose decay time has passed,
+// dropping its contents into the Location where it lay.
+func (z *Zone) processObjectDecayCommand(c Command) ([]Event, error) {
+	var outEvents []Event
+	now := time.Now()
+
+	for _, obj := range z.objectsById {
+		if obj.decayAt.IsZero() || now.Before(obj.decayAt) {
+			continue
+		}
+
+		var decayEvents []Event
+		loc := obj.Location()
+		for _, contained := range obj.Objects() {
+			relocEv := NewObjectAdminRelocateEvent(contained.ID(), z.ID())
+			relocEv.ToLocationContainerID = loc.ID()
+			decayEvents = append(decayEvents, relocEv)
+		}
+		decayEvents = append(decayEvents, NewObjectRemoveFromZoneEvent(obj.Name(), obj.ID(), z.ID()))
+
+		applied, err := z.sequenceAndApplyEvents(decayEvents)
+		outEvents = append(outEvents, applied...)
+		if err != nil {
+			fmt.Printf("CORE ERROR: zone %q decaying Object %q: %s\n", z.Tag(), obj.ID(), err)
+		}
+	}
+
+	return outEvents, nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newObjectDecayCommand() *objectDecayCommand {
+	return &objectDecayCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeObjectDecay},
+	}
+}
+
+type objectDecayCommand struct {
+	commandGeneric
+}
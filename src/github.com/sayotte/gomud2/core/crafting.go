@@ -0,0 +1,423 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+// Crafting turns Objects into other Objects by following a recipe. A recipe
+// names the Objects it uses up (by keyword), the tools which must be in the
+// crafter's hands (also by keyword, and not used up), the skill it exercises
+// and how difficult it is, and what it makes. Like scribing, an attempt uses
+// up the inputs whether or not it succeeds; only a success trains the skill.
+//
+// Everything an attempt changes, from the crafter's skill to the inputs
+// removed and the outputs added, is sequenced and applied as a single batch
+// of events, so there's no moment at which the inputs are gone but the
+// outputs haven't appeared.
+
+var (
+	// ActorCraftDelay is the base delay following an attempt to craft.
+	ActorCraftDelay = time.Second * 3
+	// How much skill is gained by a successful attempt to craft.
+	CraftingGainOnSuccess = 1.0
+)
+
+var (
+	ErrRecipeUnknown       = errors.New("no such recipe")
+	ErrRecipeMissingInputs = errors.New("Actor isn't carrying everything the recipe uses")
+	ErrRecipeMissingTools  = errors.New("Actor isn't holding the tools the recipe needs")
+	ErrCraftFailed         = errors.New("crafting failed")
+)
+
+// CraftSuccessChance returns the chance (0.0 - 1.0) that a recipe of the
+// given difficulty is followed successfully by a crafter of the given skill.
+// The chance is 50% when skill and difficulty are equal, moving linearly to
+// 0% and 100% at 25 points below and above the difficulty.
+func CraftSuccessChance(skill, difficulty float64) float64 {
+	chance := 0.5 + (skill-difficulty)/50
+	return math.Max(0.0, math.Min(1.0, chance))
+}
+
+// RecipeInput is some number of Objects, each with the given keyword, used up
+// by following a recipe.
+type RecipeInput struct {
+	Keyword string `yaml:"keyword"`
+	Count   int    `yaml:"count,omitempty"`
+}
+
+// Required returns how many of the input the recipe uses up; a zero Count
+// means just one.
+func (ri RecipeInput) Required() int {
+	if ri.Count < 1 {
+		return 1
+	}
+	return ri.Count
+}
+
+// RecipeOutput describes the Objects made by following a recipe.
+type RecipeOutput struct {
+	Name        string           `yaml:"name"`
+	Description string           `yaml:"description"`
+	Keywords    []string         `yaml:"keywords"`
+	Capacity    int              `yaml:"capacity,omitempty"`
+	Attributes  ObjectAttributes `yaml:"attributes"`
+	// Count is how many are made; a zero Count means just one.
+	Count int `yaml:"count,omitempty"`
+}
+
+// Quantity returns how many Objects the recipe makes; a zero Count means
+// just one.
+func (ro RecipeOutput) Quantity() int {
+	if ro.Count < 1 {
+		return 1
+	}
+	return ro.Count
+}
+
+type Recipe struct {
+	Name        string        `yaml:"name"`
+	Description string        `yaml:"description"`
+	Inputs      []RecipeInput `yaml:"inputs"`
+	// Tools are the keywords of Objects which must be in the crafter's
+	// hands; they aren't used up.
+	Tools []string `yaml:"tools,omitempty"`
+	// Skill names the skill exercised, SkillCrafting if it's empty, and
+	// Difficulty is the skill at which an attempt succeeds half of the time.
+	Skill      string       `yaml:"skill,omitempty"`
+	Difficulty float64      `yaml:"difficulty"`
+	Output     RecipeOutput `yaml:"output"`
+}
+
+// SkillName returns the name of the skill the recipe exercises.
+func (r Recipe) SkillName() string {
+	if r.Skill == "" {
+		return SkillCrafting
+	}
+	return r.Skill
+}
+
+func (r Recipe) validate() error {
+	if len(r.Inputs) == 0 {
+		return errors.New("no inputs")
+	}
+	for _, input := range r.Inputs {
+		if input.Keyword == "" {
+			return errors.New("input without a keyword")
+		}
+	}
+	if _, _, ok := (Skillset{}).skill(r.SkillName()); !ok {
+		return fmt.Errorf("unknown skill %q", r.SkillName())
+	}
+	if r.Output.Name == "" {
+		return errors.New("output without a name")
+	}
+	return nil
+}
+
+var (
+	recipesLock   = &sync.RWMutex{}
+	recipesByName = make(map[string]Recipe)
+)
+
+// SetRecipes replaces the set of recipes, typically with definitions loaded
+// from a data file at startup.
+func SetRecipes(recipes []Recipe) error {
+	byName := make(map[string]Recipe, len(recipes))
+	for _, r := range recipes {
+		if r.Name == "" {
+			return errors.New("recipe with empty name")
+		}
+		if _, duplicate := byName[r.Name]; duplicate {
+			return fmt.Errorf("duplicate recipe %q", r.Name)
+		}
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("recipe %q: %s", r.Name, err)
+		}
+		byName[r.Name] = r
+	}
+
+	recipesLock.Lock()
+	defer recipesLock.Unlock()
+	recipesByName = byName
+	return nil
+}
+
+func RecipeByName(name string) (Recipe, bool) {
+	recipesLock.RLock()
+	defer recipesLock.RUnlock()
+	r, found := recipesByName[name]
+	return r, found
+}
+
+func Recipes() []Recipe {
+	recipesLock.RLock()
+	defer recipesLock.RUnlock()
+	out := make([]Recipe, 0, len(recipesByName))
+	for _, r := range recipesByName {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+//////// Actor methods
+
+func (a *Actor) CraftDelay() time.Duration {
+	return ActionDelay(ActorCraftDelay, a.Attributes())
+}
+
+// CraftSuccessChance returns the chance (0.0 - 1.0) that the Actor, as it
+// is right now, follows the recipe successfully.
+func (a *Actor) CraftSuccessChance(r Recipe) float64 {
+	skill, _, _ := a.EffectiveSkills().skill(r.SkillName())
+	return CraftSuccessChance(skill, r.Difficulty)
+}
+
+// Craft follows the named recipe, using up the inputs it's carrying and
+// making the recipe's output, which goes into its inventory if there's room
+// or onto the ground if not. The inputs are used up whether or not the
+// attempt succeeds; if it fails, ErrCraftFailed is returned.
+func (a *Actor) Craft(recipe string) error {
+	var succeeded bool
+	err := a.doDelayedAction(a.CraftDelay(), func() error {
+		val, err := a.syncRequestToZone(newActorCraftCommand(a, recipe))
+		if err == nil {
+			succeeded = val.(bool)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if !succeeded {
+		return ErrCraftFailed
+	}
+	return nil
+}
+
+// craftingMaterials returns the Objects the Actor would use up by following
+// the recipe. Tools in its hands are never used up, nor are containers with
+// anything in them, nor anything the Actor can't get at right now.
+func craftingMaterials(actor *Actor, r Recipe) (ObjectList, error) {
+	hands := actor.Inventory().ObjectsBySubcontainer(InventoryContainerHands)
+	tools := make(map[*Object]bool)
+	for _, keyword := range r.Tools {
+		var tool *Object
+		for _, obj := range hands {
+			if !tools[obj] && hasKeyword(obj, keyword) {
+				tool = obj
+				break
+			}
+		}
+		if tool == nil {
+			return nil, ErrRecipeMissingTools
+		}
+		tools[tool] = true
+	}
+
+	var materials ObjectList
+	used := make(map[*Object]bool)
+	for _, input := range r.Inputs {
+		needed := input.Required()
+		for _, tuple := range getObjectContainerTuplesRecursive(actor) {
+			obj := tuple.obj
+			if needed == 0 {
+				break
+			}
+			if tools[obj] || used[obj] || len(obj.Objects()) > 0 || !hasKeyword(obj, input.Keyword) {
+				continue
+			}
+			// nothing shut away in a closed container, or on offer in a
+			// trade, can be used up
+			if sealedOff(obj.Container()) || obj.lockedInTrade() {
+				continue
+			}
+			used[obj] = true
+			materials = append(materials, obj)
+			needed--
+		}
+		if needed > 0 {
+			return nil, ErrRecipeMissingInputs
+		}
+	}
+	return materials, nil
+}
+
+//////// Zone-side processing
+
+func (z *Zone) processActorCraftCommand(c Command) (interface{}, []Event, error) {
+	cmd := c.(*actorCraftCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, nil, errors.New("Actor not in Zone")
+	}
+	if cmd.actor.IsGhost() {
+		return nil, nil, ErrActorIsGhost
+	}
+	recipe, found := RecipeByName(cmd.recipe)
+	if !found {
+		return nil, nil, ErrRecipeUnknown
+	}
+	materials, err := craftingMaterials(cmd.actor, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	success := rollFloat64(z.Rand()) < cmd.actor.CraftSuccessChance(recipe)
+
+	// only the Actor's own skill is trained, not what it's buffed to
+	skillAfter, skillCap, _ := cmd.actor.Skills().skill(recipe.SkillName())
+	if success && skillAfter < skillCap {
+		skillAfter = math.Min(skillAfter+CraftingGainOnSuccess, skillCap)
+	}
+
+	outEvents := []Event{
+		NewActorCraftEvent(
+			cmd.actor.ID(),
+			z.id,
+			cmd.actor.Name(),
+			recipe.Name,
+			success,
+			recipe.SkillName(),
+			skillAfter,
+		),
+	}
+	// the inputs are used up either way
+	for _, obj := range materials {
+		outEvents = append(outEvents, NewObjectRemoveFromZoneEvent(obj.Name(), obj.ID(), z.id))
+	}
+	if success {
+		outEvents = append(outEvents, z.craftedObjectEvents(cmd.actor, recipe.Output, materials)...)
+	}
+
+	applied, err := z.sequenceAndApplyEvents(outEvents)
+	if err != nil {
+		return nil, applied, err
+	}
+	return success, applied, nil
+}
+
+// craftedObjectEvents returns the events adding a recipe's output, belonging
+// to the Actor who crafted it, to the Actor's inventory once the materials
+// have been used up, or to the ground if it won't all fit.
+func (z *Zone) craftedObjectEvents(actor *Actor, output RecipeOutput, materials ObjectList) []Event {
+	var protos ObjectList
+	for i := 0; i < output.Quantity(); i++ {
+		protos = append(protos, NewObject(
+			uuid.Nil,
+			output.Name,
+			output.Description,
+			output.Keywords,
+			nil,
+			output.Capacity,
+			z,
+			output.Attributes,
+		))
+	}
+	places, err := actor.Inventory().placementsFor(protos, materials)
+
+	var events []Event
+	for i, proto := range protos {
+		locationID, actorID, subcontainer := uuid.Nil, actor.ID(), ContainerDefaultSubcontainer
+		if err == nil {
+			subcontainer = places[i]
+		} else {
+			locationID, actorID = actor.Location().ID(), uuid.Nil
+		}
+		addEv := NewObjectAddToZoneEvent(
+			proto.Name(),
+			proto.Description(),
+			proto.Keywords(),
+			proto.Capacity(),
+			myuuid.NewId(),
+			locationID,
+			actorID,
+			uuid.Nil,
+			z.id,
+			subcontainer,
+			proto.Attributes(),
+		)
+		addEv.Ownership = ObjectOwnership{
+			OwnerID:   actor.ID(),
+			OwnerName: actor.Name(),
+			Provenance: []ProvenanceRecord{{
+				Reason:    OwnershipReasonCrafted,
+				OwnerID:   actor.ID(),
+				OwnerName: actor.Name(),
+				ActorID:   actor.ID(),
+				ActorName: actor.Name(),
+				At:        time.Now(),
+			}},
+		}
+		events = append(events, addEv)
+	}
+	return events
+}
+
+func (z *Zone) applyActorCraftEvent(e *ActorCraftEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find crafting Actor %q", e.ActorID)
+	}
+	beforeAttrs, beforeSkills := actor.Attributes(), actor.Skills()
+	actor.setSkills(actor.Skills().withSkill(e.Skill, e.SkillValue))
+	actor.recordProgressionSince(e.Timestamp(), ProgressionReasonCrafting, e.Recipe, beforeAttrs, beforeSkills)
+	return actor.Location().Observers(), nil
+}
+
+//////// Commands and events
+
+func newActorCraftCommand(actor *Actor, recipe string) *actorCraftCommand {
+	return &actorCraftCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorCraft},
+		actor:          actor,
+		recipe:         recipe,
+	}
+}
+
+type actorCraftCommand struct {
+	commandGeneric
+	actor  *Actor
+	recipe string
+}
+
+func NewActorCraftEvent(actorID, zoneID uuid.UUID, actorName, recipe string, success bool, skill string, skillValue float64) *ActorCraftEvent {
+	return &ActorCraftEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorCraft,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:    actorID,
+		ActorName:  actorName,
+		Recipe:     recipe,
+		Success:    success,
+		Skill:      skill,
+		SkillValue: skillValue,
+	}
+}
+
+// ActorCraftEvent records an Actor attempting to follow a recipe. The inputs
+// used up, and on success the outputs made, follow as events of their own.
+type ActorCraftEvent struct {
+	*eventGeneric
+	ActorID   uuid.UUID
+	ActorName string
+	Recipe    string
+	Success   bool
+	// SkillValue is the Actor's Skill after the attempt.
+	Skill      string
+	SkillValue float64
+}
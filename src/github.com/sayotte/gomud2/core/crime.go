@@ -0,0 +1,685 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+// Zones flagged as lawful treat some harmful acts as crimes: assaulting or
+// murdering an Actor the law protects, or stealing its property. Each crime
+// is recorded by the Zone with its severity, when and where it happened, and
+// who knows about it; witnesses (everyone else present) know about it from
+// the start, and anyone who knows can report it to someone else. Actors the
+// law empowers to enforce it can then punish the offender for the crime, by
+// sending them to jail, fining them, or putting them to death, or they can
+// pardon them instead. Until every crime an Actor committed in a Zone is
+// resolved, the Actor is wanted there, and the law doesn't protect it.
+//
+// Recognising crimes, and punishing them, is up to the Zone; hunting down
+// offenders and telling each other about them is up to the enforcers' brains.
+// See the guard discussion in dialoguePlan.md.
+
+const (
+	CrimeTypeAssault = "assault"
+	CrimeTypeMurder  = "murder"
+	CrimeTypeTheft   = "theft"
+
+	CrimeResolutionNone     = ""
+	CrimeResolutionJailed   = "jailed"
+	CrimeResolutionFined    = "fined"
+	CrimeResolutionExecuted = "executed"
+	CrimeResolutionPardoned = "pardoned"
+)
+
+// CrimeSeverities ranks each type of crime; higher is worse.
+var CrimeSeverities = map[string]int{
+	CrimeTypeTheft:   1,
+	CrimeTypeAssault: 2,
+	CrimeTypeMurder:  3,
+}
+
+var AllCrimeResolutions = []string{
+	CrimeResolutionJailed,
+	CrimeResolutionFined,
+	CrimeResolutionExecuted,
+	CrimeResolutionPardoned,
+}
+
+var (
+	ErrCrimeUnknown           = errors.New("no such crime")
+	ErrCrimeNotKnown          = errors.New("Actor doesn't know about that crime")
+	ErrCrimeResolved          = errors.New("that crime has already been dealt with")
+	ErrCrimeResolutionUnknown = errors.New("unknown punishment")
+	ErrNotLawEnforcer         = errors.New("Actor is not empowered to enforce the law here")
+	ErrOffenderNotHere        = errors.New("offender is not here")
+	ErrNoJail                 = errors.New("there is no jail here")
+	ErrFineAmount             = errors.New("a fine must be a positive number of coins")
+	ErrCrimeReportSelf        = errors.New("Actor cannot report a crime to itself")
+	ErrCrimeListenerNotHere   = errors.New("whoever the crime is reported to must be here")
+)
+
+// ZoneLaw describes how a Zone's law works, if it has any.
+type ZoneLaw struct {
+	Lawful bool
+	// JailLocationID is where offenders are sent when jailed; without one,
+	// nobody can be jailed.
+	JailLocationID uuid.UUID
+	// ProtectedFactions are the factions whose members the law protects; if
+	// there are none, it protects everyone.
+	ProtectedFactions []string
+	// EnforcerFactions are the factions whose members may punish offenders;
+	// if there are none, anyone may.
+	EnforcerFactions []string
+}
+
+func (zl ZoneLaw) copy() ZoneLaw {
+	out := zl
+	out.ProtectedFactions = append([]string(nil), zl.ProtectedFactions...)
+	out.EnforcerFactions = append([]string(nil), zl.EnforcerFactions...)
+	return out
+}
+
+func (zl ZoneLaw) protects(a *Actor) bool {
+	if len(zl.ProtectedFactions) == 0 {
+		return true
+	}
+	for _, name := range zl.ProtectedFactions {
+		if a.IsFactionMember(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (zl ZoneLaw) empowers(a *Actor) bool {
+	if len(zl.EnforcerFactions) == 0 {
+		return true
+	}
+	for _, name := range zl.EnforcerFactions {
+		if a.IsFactionMember(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Crime is a Zone's record of one crime.
+type Crime struct {
+	ID           uuid.UUID
+	Type         string
+	Severity     int
+	OffenderID   uuid.UUID
+	OffenderName string
+	VictimID     uuid.UUID
+	VictimName   string
+	// ObjectID is what was stolen, for thefts.
+	ObjectID    uuid.UUID
+	LocationID  uuid.UUID
+	CommittedAt time.Time
+	// KnownBy are the Actors who know about the crime, whether they witnessed
+	// it or had it reported to them.
+	KnownBy []uuid.UUID
+	// Resolution is one of the CrimeResolution* constants; until the crime
+	// is resolved, it's CrimeResolutionNone.
+	Resolution   string
+	ResolvedAt   time.Time
+	ResolvedByID uuid.UUID
+	Fine         int
+}
+
+func (c Crime) IsResolved() bool {
+	return c.Resolution != CrimeResolutionNone
+}
+
+func (c Crime) IsKnownBy(actorID uuid.UUID) bool {
+	return myuuid.UUIDList(c.KnownBy).IndexOf(actorID) != -1
+}
+
+func sortCrimes(crimes []Crime) {
+	sort.Slice(crimes, func(i, j int) bool {
+		return crimes[i].CommittedAt.Before(crimes[j].CommittedAt)
+	})
+}
+
+//////// Zone methods
+
+// Law returns the Zone's law.
+func (z *Zone) Law() ZoneLaw {
+	z.crimeLock.RLock()
+	defer z.crimeLock.RUnlock()
+	return z.law
+}
+
+// SetLaw replaces the Zone's law. Crimes already recorded are unaffected.
+func (z *Zone) SetLaw(law ZoneLaw) error {
+	e := NewZoneSetLawEvent(law.copy(), z.id)
+	_, err := z.syncRequestToSelf(newZoneSetLawCommand(e))
+	return err
+}
+
+// Crimes returns every crime recorded in the Zone, oldest first.
+func (z *Zone) Crimes() []Crime {
+	z.crimeLock.RLock()
+	defer z.crimeLock.RUnlock()
+	out := make([]Crime, 0, len(z.crimesById))
+	for _, crime := range z.crimesById {
+		out = append(out, *crime)
+	}
+	sortCrimes(out)
+	return out
+}
+
+// CrimeByID returns the crime with the given ID, if the Zone has recorded it.
+func (z *Zone) CrimeByID(id uuid.UUID) (Crime, bool) {
+	z.crimeLock.RLock()
+	defer z.crimeLock.RUnlock()
+	crime, found := z.crimesById[id]
+	if !found {
+		return Crime{}, false
+	}
+	return *crime, true
+}
+
+// CrimesKnownBy returns the crimes the given Actor knows about, oldest first.
+func (z *Zone) CrimesKnownBy(actorID uuid.UUID) []Crime {
+	var out []Crime
+	for _, crime := range z.Crimes() {
+		if crime.IsKnownBy(actorID) {
+			out = append(out, crime)
+		}
+	}
+	return out
+}
+
+// IsWanted reports whether the given Actor has committed any crimes in the
+// Zone which haven't been resolved.
+func (z *Zone) IsWanted(actorID uuid.UUID) bool {
+	z.crimeLock.RLock()
+	defer z.crimeLock.RUnlock()
+	for _, crime := range z.crimesById {
+		if uuid.Equal(crime.OffenderID, actorID) && !crime.IsResolved() {
+			return true
+		}
+	}
+	return false
+}
+
+//////// Actor methods
+
+// IsWanted reports whether the Actor is wanted for a crime in its Zone.
+func (a *Actor) IsWanted() bool {
+	return a.Zone().IsWanted(a.ID())
+}
+
+// KnownCrimes returns the crimes in its Zone that the Actor knows about.
+func (a *Actor) KnownCrimes() []Crime {
+	return a.Zone().CrimesKnownBy(a.ID())
+}
+
+// IsLawEnforcer reports whether the law of the Actor's Zone empowers it to
+// punish offenders.
+func (a *Actor) IsLawEnforcer() bool {
+	law := a.Zone().Law()
+	return law.Lawful && law.empowers(a)
+}
+
+// ReportCrime tells the listener, who must be in the same Location, about a
+// crime the Actor knows about.
+func (a *Actor) ReportCrime(crimeID uuid.UUID, listener *Actor) error {
+	_, err := a.syncRequestToZone(newCrimeReportCommand(a, listener, crimeID))
+	return err
+}
+
+// Punish resolves a crime the Actor knows about with one of the
+// CrimeResolution* constants. Jailing, fining or executing the offender
+// requires the offender to be in the same Location; fine is the number of
+// coins to take from the offender, and is ignored otherwise.
+func (a *Actor) Punish(crimeID uuid.UUID, resolution string, fine int) error {
+	_, err := a.syncRequestToZone(newCrimeResolveCommand(a, crimeID, resolution, fine))
+	return err
+}
+
+//////// Zone-side processing
+
+// isCrime reports whether it's a crime, under the Zone's law, for the offender
+// to harm the victim.
+func (z *Zone) isCrime(offender, victim *Actor) bool {
+	if offender == nil || offender == victim {
+		return false
+	}
+	law := z.Law()
+	if !law.Lawful || !law.protects(victim) {
+		return false
+	}
+	// the law doesn't protect the wanted, which also makes it lawful to
+	// defend oneself against them
+	return !z.IsWanted(victim.ID())
+}
+
+// crimeEvent records a new crime, witnessed by everyone else present.
+func (z *Zone) crimeEvent(crimeType string, offender *Actor, victimID uuid.UUID, victimName string, objectID uuid.UUID) *CrimeCommitEvent {
+	crime := Crime{
+		ID:           myuuid.NewId(),
+		Type:         crimeType,
+		Severity:     CrimeSeverities[crimeType],
+		OffenderID:   offender.ID(),
+		OffenderName: offender.Name(),
+		VictimID:     victimID,
+		VictimName:   victimName,
+		ObjectID:     objectID,
+		LocationID:   offender.Location().ID(),
+		CommittedAt:  time.Now(),
+	}
+	for _, witness := range offender.Location().Actors() {
+		if witness != offender && !witness.IsGhost() {
+			crime.KnownBy = append(crime.KnownBy, witness.ID())
+		}
+	}
+	return NewCrimeCommitEvent(crime, z.id)
+}
+
+// crimeEventsForAssault returns the event recording an assault on the victim,
+// if harming it is a crime, unless the offender is already wanted for
+// assaulting it.
+func (z *Zone) crimeEventsForAssault(offender, victim *Actor) []Event {
+	if !z.isCrime(offender, victim) {
+		return nil
+	}
+	for _, crime := range z.Crimes() {
+		if crime.Type == CrimeTypeAssault &&
+			!crime.IsResolved() &&
+			uuid.Equal(crime.OffenderID, offender.ID()) &&
+			uuid.Equal(crime.VictimID, victim.ID()) {
+			return nil
+		}
+	}
+	return []Event{z.crimeEvent(CrimeTypeAssault, offender, victim.ID(), victim.Name(), uuid.Nil)}
+}
+
+// crimeEventsForMurder returns the event recording the victim's murder, if
+// killing it is a crime.
+func (z *Zone) crimeEventsForMurder(killer, victim *Actor) []Event {
+	if !z.isCrime(killer, victim) {
+		return nil
+	}
+	return []Event{z.crimeEvent(CrimeTypeMurder, killer, victim.ID(), victim.Name(), uuid.Nil)}
+}
+
+func (z *Zone) processZoneSetLawCommand(c Command) ([]Event, error) {
+	cmd := c.(zoneSetLawCommand)
+	e := cmd.wrappedEvent
+
+	if !uuid.Equal(e.Law.JailLocationID, uuid.Nil) {
+		if _, found := z.locationsById[e.Law.JailLocationID]; !found {
+			return nil, fmt.Errorf("no such Location with ID %q in Zone", e.Law.JailLocationID)
+		}
+	}
+	for _, name := range append(e.Law.ProtectedFactions, e.Law.EnforcerFactions...) {
+		if _, found := FactionByName(name); !found {
+			return nil, fmt.Errorf("%s: %q", ErrFactionUnknown, name)
+		}
+	}
+
+	e.SetSequenceNumber(z.nextSequenceId)
+	z.nextSequenceId = e.SequenceNumber() + 1
+	_, err := z.applyEvent(e)
+	return []Event{e}, err
+}
+
+func (z *Zone) processCrimeReportCommand(c Command) ([]Event, error) {
+	cmd := c.(*crimeReportCommand)
+
+	for _, actor := range []*Actor{cmd.reporter, cmd.listener} {
+		_, found := z.actorsById[actor.ID()]
+		if !found || actor.Zone() != z {
+			return nil, errors.New("Actor not in Zone")
+		}
+	}
+	if cmd.reporter.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if cmd.reporter == cmd.listener {
+		return nil, ErrCrimeReportSelf
+	}
+	if cmd.listener.Location() != cmd.reporter.Location() || cmd.listener.IsGhost() {
+		return nil, ErrCrimeListenerNotHere
+	}
+	crime, found := z.CrimeByID(cmd.crimeID)
+	if !found {
+		return nil, ErrCrimeUnknown
+	}
+	if !crime.IsKnownBy(cmd.reporter.ID()) {
+		return nil, ErrCrimeNotKnown
+	}
+
+	e := NewCrimeReportEvent(
+		crime.ID,
+		cmd.reporter.ID(),
+		cmd.listener.ID(),
+		z.id,
+		cmd.reporter.Name(),
+		cmd.listener.Name(),
+		crime.Type,
+		crime.OffenderName,
+	)
+	return z.sequenceAndApplyEvents([]Event{e})
+}
+
+func (z *Zone) processCrimeResolveCommand(c Command) ([]Event, error) {
+	cmd := c.(*crimeResolveCommand)
+
+	_, found := z.actorsById[cmd.enforcer.ID()]
+	if !found || cmd.enforcer.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	if cmd.enforcer.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if !cmd.enforcer.IsLawEnforcer() {
+		return nil, ErrNotLawEnforcer
+	}
+	crime, found := z.CrimeByID(cmd.crimeID)
+	if !found {
+		return nil, ErrCrimeUnknown
+	}
+	if !crime.IsKnownBy(cmd.enforcer.ID()) {
+		return nil, ErrCrimeNotKnown
+	}
+	if crime.IsResolved() {
+		return nil, ErrCrimeResolved
+	}
+
+	var fine int
+	offender := z.actorsById[crime.OffenderID]
+	switch cmd.resolution {
+	case CrimeResolutionPardoned:
+	case CrimeResolutionJailed, CrimeResolutionFined, CrimeResolutionExecuted:
+		if offender == nil || offender.Location() != cmd.enforcer.Location() || offender.IsGhost() {
+			return nil, ErrOffenderNotHere
+		}
+	default:
+		return nil, ErrCrimeResolutionUnknown
+	}
+
+	var punishmentEvents []Event
+	switch cmd.resolution {
+	case CrimeResolutionJailed:
+		jail, found := z.locationsById[z.Law().JailLocationID]
+		if !found {
+			return nil, ErrNoJail
+		}
+		punishmentEvents = append(punishmentEvents, z.disengageEventsFor(offender, CombatDisengageReasonDeparted)...)
+		punishmentEvents = append(punishmentEvents, z.tradeCancelEventsFor(offender, TradeCancelReasonDeparted)...)
+		punishmentEvents = append(punishmentEvents, NewActorAdminRelocateEvent(offender.ID(), jail.ID(), z.id))
+	case CrimeResolutionFined:
+		if cmd.fine <= 0 {
+			return nil, ErrFineAmount
+		}
+		if offender.spendableCoins() < cmd.fine {
+			return nil, ErrCannotAfford
+		}
+		fine = cmd.fine
+		punishmentEvents = z.coinDebitEvents(offender, fine)
+	case CrimeResolutionExecuted:
+		punishmentEvents = doActorDeath(offender, cmd.enforcer, z)
+	}
+
+	e := NewCrimeResolveEvent(
+		crime.ID,
+		crime.OffenderID,
+		cmd.enforcer.ID(),
+		z.id,
+		crime.OffenderName,
+		cmd.enforcer.Name(),
+		crime.Type,
+		cmd.resolution,
+		fine,
+	)
+	return z.sequenceAndApplyEvents(append([]Event{e}, punishmentEvents...))
+}
+
+func (z *Zone) applyZoneSetLawEvent(e *ZoneSetLawEvent) error {
+	z.crimeLock.Lock()
+	defer z.crimeLock.Unlock()
+	z.law = e.Law.copy()
+	return nil
+}
+
+func (z *Zone) applyCrimeCommitEvent(e *CrimeCommitEvent) (ObserverList, error) {
+	crime := e.Crime
+	crime.KnownBy = append([]uuid.UUID(nil), e.Crime.KnownBy...)
+	z.crimeLock.Lock()
+	z.crimesById[crime.ID] = &crime
+	z.crimeLock.Unlock()
+
+	loc, found := z.locationsById[crime.LocationID]
+	if !found {
+		return nil, nil
+	}
+	return loc.Observers(), nil
+}
+
+func (z *Zone) applyCrimeReportEvent(e *CrimeReportEvent) (ObserverList, error) {
+	z.crimeLock.Lock()
+	crime, found := z.crimesById[e.CrimeID]
+	if !found {
+		z.crimeLock.Unlock()
+		return nil, fmt.Errorf("cannot find crime %q", e.CrimeID)
+	}
+	if !crime.IsKnownBy(e.ListenerID) {
+		// copy-on-write, as the old slice may have been handed out already
+		updated := *crime
+		updated.KnownBy = append(append([]uuid.UUID(nil), crime.KnownBy...), e.ListenerID)
+		z.crimesById[e.CrimeID] = &updated
+	}
+	z.crimeLock.Unlock()
+
+	reporter, found := z.actorsById[e.ReporterID]
+	if !found {
+		return nil, nil
+	}
+	return reporter.Location().Observers(), nil
+}
+
+func (z *Zone) applyCrimeResolveEvent(e *CrimeResolveEvent) (ObserverList, error) {
+	z.crimeLock.Lock()
+	crime, found := z.crimesById[e.CrimeID]
+	if !found {
+		z.crimeLock.Unlock()
+		return nil, fmt.Errorf("cannot find crime %q", e.CrimeID)
+	}
+	updated := *crime
+	updated.Resolution = e.Resolution
+	updated.ResolvedAt = e.Timestamp()
+	updated.ResolvedByID = e.EnforcerID
+	updated.Fine = e.Fine
+	z.crimesById[e.CrimeID] = &updated
+	z.crimeLock.Unlock()
+
+	// the resolution is announced before any punishment is carried out, so
+	// the offender is still where it was caught
+	var oList ObserverList
+	offender, found := z.actorsById[e.OffenderID]
+	if found {
+		oList = offender.Location().Observers()
+	}
+	enforcer, found := z.actorsById[e.EnforcerID]
+	if found && (offender == nil || enforcer.Location() != offender.Location()) {
+		oList = append(oList, enforcer.Location().Observers()...)
+	}
+	return oList, nil
+}
+
+// crimeSnapshot returns the events needed to rebuild the Zone's law and its
+// record of crimes.
+func (z *Zone) crimeSnapshot(sequenceNum uint64) []Event {
+	var events []Event
+	law := z.Law()
+	if law.Lawful || !uuid.Equal(law.JailLocationID, uuid.Nil) || len(law.ProtectedFactions) > 0 || len(law.EnforcerFactions) > 0 {
+		e := NewZoneSetLawEvent(law.copy(), z.id)
+		e.SetSequenceNumber(sequenceNum)
+		events = append(events, e)
+	}
+	for _, crime := range z.Crimes() {
+		e := NewCrimeCommitEvent(crime, z.id)
+		e.SetSequenceNumber(sequenceNum)
+		events = append(events, e)
+	}
+	return events
+}
+
+//////// Commands and events
+
+func newZoneSetLawCommand(wrapped *ZoneSetLawEvent) zoneSetLawCommand {
+	return zoneSetLawCommand{
+		commandGeneric{commandType: CommandTypeZoneSetLaw},
+		wrapped,
+	}
+}
+
+type zoneSetLawCommand struct {
+	commandGeneric
+	wrappedEvent *ZoneSetLawEvent
+}
+
+func newCrimeReportCommand(reporter, listener *Actor, crimeID uuid.UUID) *crimeReportCommand {
+	return &crimeReportCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeCrimeReport},
+		reporter:       reporter,
+		listener:       listener,
+		crimeID:        crimeID,
+	}
+}
+
+type crimeReportCommand struct {
+	commandGeneric
+	reporter *Actor
+	listener *Actor
+	crimeID  uuid.UUID
+}
+
+func newCrimeResolveCommand(enforcer *Actor, crimeID uuid.UUID, resolution string, fine int) *crimeResolveCommand {
+	return &crimeResolveCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeCrimeResolve},
+		enforcer:       enforcer,
+		crimeID:        crimeID,
+		resolution:     resolution,
+		fine:           fine,
+	}
+}
+
+type crimeResolveCommand struct {
+	commandGeneric
+	enforcer   *Actor
+	crimeID    uuid.UUID
+	resolution string
+	fine       int
+}
+
+func NewZoneSetLawEvent(law ZoneLaw, zoneID uuid.UUID) *ZoneSetLawEvent {
+	return &ZoneSetLawEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeZoneSetLaw,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		Law: law,
+	}
+}
+
+type ZoneSetLawEvent struct {
+	*eventGeneric
+	Law ZoneLaw
+}
+
+func NewCrimeCommitEvent(crime Crime, zoneID uuid.UUID) *CrimeCommitEvent {
+	return &CrimeCommitEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeCrimeCommit,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		Crime: crime,
+	}
+}
+
+// CrimeCommitEvent records a crime. In snapshots, it carries the crime's
+// current state, including who knows about it and how it was resolved.
+type CrimeCommitEvent struct {
+	*eventGeneric
+	Crime Crime
+}
+
+func NewCrimeReportEvent(crimeID, reporterID, listenerID, zoneID uuid.UUID, reporterName, listenerName, crimeType, offenderName string) *CrimeReportEvent {
+	return &CrimeReportEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeCrimeReport,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		CrimeID:      crimeID,
+		ReporterID:   reporterID,
+		ListenerID:   listenerID,
+		ReporterName: reporterName,
+		ListenerName: listenerName,
+		CrimeType:    crimeType,
+		OffenderName: offenderName,
+	}
+}
+
+type CrimeReportEvent struct {
+	*eventGeneric
+	CrimeID      uuid.UUID
+	ReporterID   uuid.UUID
+	ListenerID   uuid.UUID
+	ReporterName string
+	ListenerName string
+	CrimeType    string
+	OffenderName string
+}
+
+func NewCrimeResolveEvent(crimeID, offenderID, enforcerID, zoneID uuid.UUID, offenderName, enforcerName, crimeType, resolution string, fine int) *CrimeResolveEvent {
+	return &CrimeResolveEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeCrimeResolve,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		CrimeID:      crimeID,
+		OffenderID:   offenderID,
+		EnforcerID:   enforcerID,
+		OffenderName: offenderName,
+		EnforcerName: enforcerName,
+		CrimeType:    crimeType,
+		Resolution:   resolution,
+		Fine:         fine,
+	}
+}
+
+// CrimeResolveEvent records an enforcer dealing with a crime. Any punishment
+// is carried out by the events that follow it.
+type CrimeResolveEvent struct {
+	*eventGeneric
+	CrimeID      uuid.UUID
+	OffenderID   uuid.UUID
+	EnforcerID   uuid.UUID
+	OffenderName string
+	EnforcerName string
+	CrimeType    string
+	Resolution   string
+	Fine         int
+}
@@ -0,0 +1,349 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Exits may have a door, which can be opened and closed by Actors, and may
+// also have a lock. A lock is opened by a key: any Object with the ID or
+// keyword the Exit names. Actors can't move through a closed Exit.
+//
+// Doors are configured (e.g. by the world editor) one Exit at a time, but
+// opening, closing, locking or unlocking a door also affects the Exit leading
+// back the other way, if it has a door of its own.
+
+const (
+	ExitDoorActionOpen   = "open"
+	ExitDoorActionClose  = "close"
+	ExitDoorActionLock   = "lock"
+	ExitDoorActionUnlock = "unlock"
+)
+
+var (
+	ErrExitNoDoor        = errors.New("Exit has no door")
+	ErrExitNoLock        = errors.New("Exit has no lock")
+	ErrExitClosed        = errors.New("Exit is closed")
+	ErrExitLocked        = errors.New("Exit is locked")
+	ErrExitAlreadyOpen   = errors.New("Exit is already open")
+	ErrExitAlreadyClosed = errors.New("Exit is already closed")
+	ErrExitAlreadyLocked = errors.New("Exit is already locked")
+	ErrExitNotLocked     = errors.New("Exit is not locked")
+	ErrExitNotClosed     = errors.New("Exit must be closed before it can be locked")
+	ErrActorHasNoKey     = errors.New("Actor is not carrying a key for that lock")
+)
+
+//////// Exit methods
+
+func (ex Exit) HasDoor() bool {
+	return ex.hasDoor
+}
+
+func (ex Exit) IsClosed() bool {
+	return ex.closed
+}
+
+func (ex Exit) IsLocked() bool {
+	return ex.locked
+}
+
+// IsLockable reports whether the Exit has a lock, i.e. whether it has a door
+// and names a key.
+func (ex Exit) IsLockable() bool {
+	return ex.hasDoor && (!uuid.Equal(ex.keyID, uuid.Nil) || ex.keyKeyword != "")
+}
+
+// KeyID returns the ID of the Object which opens the Exit's lock, if any.
+func (ex Exit) KeyID() uuid.UUID {
+	return ex.keyID
+}
+
+// KeyKeyword returns the keyword of Objects which open the Exit's lock, if any.
+func (ex Exit) KeyKeyword() string {
+	return ex.keyKeyword
+}
+
+// IsKey reports whether the given Object opens the Exit's lock.
+func (ex Exit) IsKey(obj *Object) bool {
+	if !uuid.Equal(ex.keyID, uuid.Nil) && uuid.Equal(obj.ID(), ex.keyID) {
+		return true
+	}
+	if ex.keyKeyword == "" {
+		return false
+	}
+	for _, kw := range obj.Keywords() {
+		if kw == ex.keyKeyword {
+			return true
+		}
+	}
+	return false
+}
+
+func (ex *Exit) setDoor(hasDoor, closed, locked bool, keyID uuid.UUID, keyKeyword string) {
+	ex.hasDoor = hasDoor
+	ex.closed = closed
+	ex.locked = locked
+	ex.keyID = keyID
+	ex.keyKeyword = keyKeyword
+}
+
+// SetDoor configures the Exit's door. The door starts out in the given
+// closed/locked state; a door without a key can't be locked.
+func (ex Exit) SetDoor(hasDoor, closed, locked bool, keyID uuid.UUID, keyKeyword string) error {
+	e := NewExitSetDoorEvent(ex.id, ex.zone.ID(), hasDoor, closed, locked, keyID, keyKeyword)
+	_, err := ex.syncRequestToZone(newExitSetDoorCommand(e))
+	return err
+}
+
+//////// Actor methods
+
+func (a *Actor) OpenExit(ex *Exit) error {
+	return a.doorAction(ex, ExitDoorActionOpen)
+}
+
+func (a *Actor) CloseExit(ex *Exit) error {
+	return a.doorAction(ex, ExitDoorActionClose)
+}
+
+// LockExit locks the Exit's door, which must be closed, using a key the Actor
+// is carrying.
+func (a *Actor) LockExit(ex *Exit) error {
+	return a.doorAction(ex, ExitDoorActionLock)
+}
+
+// UnlockExit unlocks the Exit's door using a key the Actor is carrying.
+func (a *Actor) UnlockExit(ex *Exit) error {
+	return a.doorAction(ex, ExitDoorActionUnlock)
+}
+
+func (a *Actor) doorAction(ex *Exit, action string) error {
+	_, err := a.syncRequestToZone(newActorDoorCommand(a, ex, action))
+	return err
+}
+
+//////// Zone-side processing
+
+// reverseExit returns the Exit leading back from the given Exit's destination
+// to its source, if there is one in this Zone.
+func (z *Zone) reverseExit(ex *Exit) *Exit {
+	if ex.Destination() == nil {
+		return nil
+	}
+	for _, candidate := range ex.Destination().OutExits() {
+		if candidate.Destination() == ex.Source() {
+			return candidate
+		}
+	}
+	return nil
+}
+
+func (z *Zone) processActorDoorCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorDoorCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	_, found = z.exitsById[cmd.exit.ID()]
+	if !found || cmd.exit.Source() != cmd.actor.Location() {
+		return nil, errors.New("Exit does not lead from the Actor's Location")
+	}
+	if cmd.actor.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if !cmd.exit.HasDoor() {
+		return nil, ErrExitNoDoor
+	}
+
+	switch cmd.action {
+	case ExitDoorActionOpen:
+		if cmd.exit.IsLocked() {
+			return nil, ErrExitLocked
+		}
+		// a door locked from the other side stays shut from this one too
+		if reverse := z.reverseExit(cmd.exit); reverse != nil && reverse.HasDoor() && reverse.IsLocked() {
+			return nil, ErrExitLocked
+		}
+		if !cmd.exit.IsClosed() {
+			return nil, ErrExitAlreadyOpen
+		}
+	case ExitDoorActionClose:
+		if cmd.exit.IsClosed() {
+			return nil, ErrExitAlreadyClosed
+		}
+	case ExitDoorActionLock, ExitDoorActionUnlock:
+		if !cmd.exit.IsLockable() {
+			return nil, ErrExitNoLock
+		}
+		if cmd.action == ExitDoorActionLock && !cmd.exit.IsClosed() {
+			return nil, ErrExitNotClosed
+		}
+		if cmd.action == ExitDoorActionLock && cmd.exit.IsLocked() {
+			return nil, ErrExitAlreadyLocked
+		}
+		if cmd.action == ExitDoorActionUnlock && !cmd.exit.IsLocked() {
+			return nil, ErrExitNotLocked
+		}
+		var hasKey bool
+		for _, obj := range cmd.actor.Inventory().Objects() {
+			if cmd.exit.IsKey(obj) {
+				hasKey = true
+				break
+			}
+		}
+		if !hasKey {
+			return nil, ErrActorHasNoKey
+		}
+	default:
+		return nil, fmt.Errorf("unknown door action %q", cmd.action)
+	}
+
+	events := []Event{
+		NewExitDoorEvent(cmd.action, cmd.exit.Direction(), cmd.exit.ID(), cmd.actor.ID(), z.id, cmd.actor.Name()),
+	}
+	// the other side of the door follows suit, except that a side with no
+	// lock of its own is never locked, or nobody could unlock it from there
+	reverse := z.reverseExit(cmd.exit)
+	mirror := reverse != nil && reverse.HasDoor()
+	if cmd.action == ExitDoorActionLock || cmd.action == ExitDoorActionUnlock {
+		mirror = mirror && reverse.IsLockable()
+	}
+	if mirror {
+		events = append(events, NewExitDoorEvent(cmd.action, reverse.Direction(), reverse.ID(), cmd.actor.ID(), z.id, cmd.actor.Name()))
+	}
+	return z.sequenceAndApplyEvents(events)
+}
+
+func (z *Zone) processExitSetDoorCommand(c Command) ([]Event, error) {
+	cmd := c.(exitSetDoorCommand)
+	e := cmd.wrappedEvent
+
+	if _, found := z.exitsById[e.ExitID]; !found {
+		return nil, fmt.Errorf("unknown Exit %q", e.ExitID)
+	}
+	if !e.HasDoor && (e.Closed || e.Locked) {
+		return nil, errors.New("an Exit without a door can't be closed or locked")
+	}
+	if e.Locked && !e.Closed {
+		return nil, errors.New("an open door can't be locked")
+	}
+	if e.Locked && uuid.Equal(e.KeyID, uuid.Nil) && e.KeyKeyword == "" {
+		return nil, errors.New("a door without a key can't be locked")
+	}
+
+	return z.sequenceAndApplyEvents([]Event{e})
+}
+
+func (z *Zone) applyExitDoorEvent(e *ExitDoorEvent) (ObserverList, error) {
+	exit, found := z.exitsById[e.ExitID]
+	if !found {
+		return nil, fmt.Errorf("unknown Exit %q", e.ExitID)
+	}
+	switch e.Action {
+	case ExitDoorActionOpen:
+		exit.closed = false
+	case ExitDoorActionClose:
+		exit.closed = true
+	case ExitDoorActionLock:
+		exit.locked = true
+	case ExitDoorActionUnlock:
+		exit.locked = false
+	default:
+		return nil, fmt.Errorf("unknown door action %q", e.Action)
+	}
+	return exit.Source().Observers(), nil
+}
+
+func (z *Zone) applyExitSetDoorEvent(e *ExitSetDoorEvent) error {
+	exit, found := z.exitsById[e.ExitID]
+	if !found {
+		return fmt.Errorf("unknown Exit %q", e.ExitID)
+	}
+	exit.setDoor(e.HasDoor, e.Closed, e.Locked, e.KeyID, e.KeyKeyword)
+	return nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newActorDoorCommand(actor *Actor, exit *Exit, action string) *actorDoorCommand {
+	return &actorDoorCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorDoor},
+		actor:          actor,
+		exit:           exit,
+		action:         action,
+	}
+}
+
+type actorDoorCommand struct {
+	commandGeneric
+	actor  *Actor
+	exit   *Exit
+	action string
+}
+
+func newExitSetDoorCommand(wrapped *ExitSetDoorEvent) exitSetDoorCommand {
+	return exitSetDoorCommand{
+		commandGeneric{commandType: CommandTypeExitSetDoor},
+		wrapped,
+	}
+}
+
+type exitSetDoorCommand struct {
+	commandGeneric
+	wrappedEvent *ExitSetDoorEvent
+}
+
+func NewExitDoorEvent(action, direction string, exitID, actorID, zoneID uuid.UUID, actorName string) *ExitDoorEvent {
+	return &ExitDoorEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeExitDoor,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		Action:    action,
+		Direction: direction,
+		ExitID:    exitID,
+		ActorID:   actorID,
+		ActorName: actorName,
+	}
+}
+
+// ExitDoorEvent records an Actor opening, closing, locking or unlocking the
+// door of an Exit, according to Action (one of the ExitDoorAction* constants).
+type ExitDoorEvent struct {
+	*eventGeneric
+	Action, Direction string
+	ExitID, ActorID   uuid.UUID
+	ActorName         string
+}
+
+func NewExitSetDoorEvent(exitID, zoneID uuid.UUID, hasDoor, closed, locked bool, keyID uuid.UUID, keyKeyword string) *ExitSetDoorEvent {
+	return &ExitSetDoorEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeExitSetDoor,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ExitID:     exitID,
+		HasDoor:    hasDoor,
+		Closed:     closed,
+		Locked:     locked,
+		KeyID:      keyID,
+		KeyKeyword: keyKeyword,
+	}
+}
+
+type ExitSetDoorEvent struct {
+	*eventGeneric
+	ExitID                  uuid.UUID
+	HasDoor, Closed, Locked bool
+	KeyID                   uuid.UUID
+	KeyKeyword              string
+}
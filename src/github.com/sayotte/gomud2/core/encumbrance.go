@@ -0,0 +1,167 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Encumbrance is the fraction of an Actor's carry limit it is using. Past
+// EncumbranceThreshold, an Actor's actions take progressively longer, and
+// moving from one Location to another costs it Stamina.
+
+var (
+	// CarryWeightPerStrength is how much weight an Actor can carry for each
+	// point of Strength.
+	CarryWeightPerStrength = 0.5
+	// EncumbranceThreshold is the encumbrance past which an Actor is slowed
+	// and tired by what it carries.
+	EncumbranceThreshold = 0.5
+	// EncumbranceDelayMax is the fraction by which action delays are
+	// lengthened when an Actor is fully encumbered.
+	EncumbranceDelayMax = 0.5
+	// EncumbranceMoveStaminaMax is the Stamina spent on each move when an
+	// Actor is fully encumbered.
+	EncumbranceMoveStaminaMax = 4
+)
+
+var ErrInventoryTooHeavy = errors.New("Actor cannot carry that much weight")
+
+// CarryLimit returns the total weight an Actor with the given Strength can
+// carry.
+func CarryLimit(strength int) float64 {
+	if strength < 0 {
+		return 0
+	}
+	return float64(strength) * CarryWeightPerStrength
+}
+
+// Weight returns the weight of the Object, including anything inside it.
+func (o *Object) Weight() float64 {
+	weight := o.attributes.Weight
+	for _, contained := range o.containedObjects {
+		weight += contained.Weight()
+	}
+	return weight
+}
+
+// Weight returns the total weight of everything in the inventory.
+func (ai *ActorInventory) Weight() float64 {
+	var weight float64
+	for _, o := range ai.Objects() {
+		weight += o.Weight()
+	}
+	return weight
+}
+
+// counts reports whether the Object's weight is already counted in the
+// inventory's, being somewhere inside a container the inventory holds; taking
+// it out of that container adds nothing to the load.
+func (ai *ActorInventory) counts(o *Object) bool {
+	held := ai.Objects()
+	c := o.Container()
+	for {
+		obj, ok := c.(*Object)
+		if !ok || obj == nil {
+			return false
+		}
+		if _, err := held.IndexOf(obj); err == nil {
+			return true
+		}
+		c = obj.Container()
+	}
+}
+
+// CarryLimit returns the total weight the inventory's owner can carry.
+func (ai *ActorInventory) CarryLimit() float64 {
+	return ai.carryLimit
+}
+
+// Encumbrance returns the fraction (0.0 - 1.0) of its carry limit the Actor is
+// using.
+func (a *Actor) Encumbrance() float64 {
+	weight := a.inventory.Weight()
+	limit := a.inventory.CarryLimit()
+	switch {
+	case weight <= 0:
+		return 0
+	case weight >= limit:
+		return 1
+	default:
+		return weight / limit
+	}
+}
+
+// overEncumbrance returns how far (0.0 - 1.0) the Actor's encumbrance is
+// between EncumbranceThreshold and fully encumbered.
+func (a *Actor) overEncumbrance() float64 {
+	over := (a.Encumbrance() - EncumbranceThreshold) / (1 - EncumbranceThreshold)
+	return math.Max(0, math.Min(1, over))
+}
+
+// encumberedDelay lengthens an action delay according to the Actor's
+// encumbrance.
+func (a *Actor) encumberedDelay(delay time.Duration) time.Duration {
+	return time.Duration(float64(delay) * (1 + a.overEncumbrance()*EncumbranceDelayMax))
+}
+
+// moveStaminaCost returns the Stamina the Actor spends moving to a new
+// Location while encumbered.
+func (a *Actor) moveStaminaCost() int {
+	return int(math.Ceil(a.overEncumbrance() * float64(EncumbranceMoveStaminaMax)))
+}
+
+//////// Zone-side processing
+
+// exertEventsFor returns the events needed to tire an Actor which has just
+// moved, if it's encumbered.
+func (z *Zone) exertEventsFor(a *Actor) []Event {
+	cost := a.moveStaminaCost()
+	// exertion alone never leaves an Actor with no Stamina at all
+	if available := a.Attributes().Stamina - 1; cost > available {
+		cost = available
+	}
+	if cost <= 0 {
+		return nil
+	}
+	return []Event{NewActorExertEvent(a.ID(), z.id, a.Name(), cost)}
+}
+
+func (z *Zone) applyActorExertEvent(e *ActorExertEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find exerting Actor %q", e.ActorID)
+	}
+	attrs := actor.Attributes()
+	attrs.Stamina -= e.StaminaCost
+	actor.setAttributes(attrs)
+	// only the Actor's own observers care how tired it is
+	return actor.Observers(), nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func NewActorExertEvent(actorID, zoneID uuid.UUID, actorName string, staminaCost int) *ActorExertEvent {
+	return &ActorExertEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorExert,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:     actorID,
+		ActorName:   actorName,
+		StaminaCost: staminaCost,
+	}
+}
+
+type ActorExertEvent struct {
+	*eventGeneric
+	ActorID     uuid.UUID
+	ActorName   string
+	StaminaCost int
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/satori/go.uuid"
+)
+
+func TestObjectMove_carryLimit(t *testing.T) {
+	testCases := map[string]struct {
+		fromOwnBag  bool
+		expectedErr error
+	}{
+		"from the ground, over the limit": {fromOwnBag: false, expectedErr: ErrInventoryTooHeavy},
+		"out of a bag already carried":    {fromOwnBag: true, expectedErr: nil},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			zone := NewZone(uuid.Nil, "test", nil)
+			zone.StartCommandProcessing()
+			defer zone.StopCommandProcessing()
+			loc, err := zone.AddLocation(NewLocation(uuid.Nil, zone, "a room", "an empty room"))
+			if err != nil {
+				t.Fatalf("Zone.AddLocation(): %s", err)
+			}
+			// a carry limit of 5
+			attrs := AttributeSet{Strength: 10, Physical: 50, Stamina: 50}
+			actor, err := zone.AddActor(NewActor(uuid.Nil, "bob", PlayerParkingBrainType, loc, zone, attrs, Skillset{}, DefaultHumanInventoryConstraints))
+			if err != nil {
+				t.Fatalf("Zone.AddActor(): %s", err)
+			}
+			bagProto := NewObject(uuid.Nil, "a bag", "a bag", []string{"bag"}, loc, ObjectSizeMediumSlots, zone, ObjectAttributes{Weight: 1, InventorySlots: ObjectSizeMediumSlots})
+			bag, err := zone.AddObject(bagProto, loc)
+			if err != nil {
+				t.Fatalf("Zone.AddObject(): %s", err)
+			}
+			rockProto := NewObject(uuid.Nil, "a rock", "a rock", []string{"rock"}, loc, 0, zone, ObjectAttributes{Weight: 3, InventorySlots: ObjectSizeSmallSlots})
+			rock, err := zone.AddObject(rockProto, loc)
+			if err != nil {
+				t.Fatalf("Zone.AddObject(): %s", err)
+			}
+
+			err = bag.Move(loc, actor, actor, InventoryContainerBack)
+			if err != nil {
+				t.Fatalf("Object.Move(): %s", err)
+			}
+			var from Container = loc
+			if tc.fromOwnBag {
+				err = rock.Move(loc, actor, actor, InventoryContainerHands)
+				if err != nil {
+					t.Fatalf("Object.Move(): %s", err)
+				}
+				err = rock.Move(actor, bag, actor, ContainerDefaultSubcontainer)
+				if err != nil {
+					t.Fatalf("Object.Move(): %s", err)
+				}
+				from = bag
+			}
+			if !tc.fromOwnBag {
+				// with something else already weighing it down
+				ballastProto := NewObject(uuid.Nil, "a stone", "a stone", []string{"stone"}, loc, 0, zone, ObjectAttributes{Weight: 3, InventorySlots: ObjectSizeSmallSlots})
+				ballast, err := zone.AddObject(ballastProto, loc)
+				if err != nil {
+					t.Fatalf("Zone.AddObject(): %s", err)
+				}
+				err = ballast.Move(loc, actor, actor, InventoryContainerBelt)
+				if err != nil {
+					t.Fatalf("Object.Move(): %s", err)
+				}
+			}
+
+			err = rock.Move(from, actor, actor, InventoryContainerHands)
+			if err != tc.expectedErr {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
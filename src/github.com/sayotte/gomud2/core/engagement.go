@@ -0,0 +1,509 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// How often a Zone checks its engaged Actors to see whether any of them are
+// ready to swing again.
+var combatRoundInterval = time.Millisecond * 250
+
+const (
+	combatFleeBaseFailChance      = 0.5
+	combatFleeStaminaReductionMax = 0.25
+)
+
+const (
+	CombatDisengageReasonDisengage = "disengage"
+	CombatDisengageReasonFlee      = "flee"
+	CombatDisengageReasonDeath     = "death"
+	CombatDisengageReasonDeparted  = "departed"
+)
+
+var (
+	ErrActorNotEngaged  = errors.New("Actor is not engaged in combat")
+	ErrActorCannotFlee  = errors.New("Actor has nowhere to flee to")
+	ErrActorFleeFailed  = errors.New("Actor failed to flee")
+	ErrActorEngagedSelf = errors.New("Actor cannot engage itself")
+)
+
+//////// Actor methods
+
+// EngagedWith returns the Actor this Actor is currently auto-attacking, or nil.
+func (a *Actor) EngagedWith() *Actor {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.engagedWith
+}
+
+func (a *Actor) setEngagedWith(target *Actor) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	a.engagedWith = target
+}
+
+// Engage starts automatic attack rounds against the target, which will
+// continue until one of them dies, flees, leaves or disengages. If the target
+// isn't already engaged with someone else, it will fight back.
+func (a *Actor) Engage(target *Actor) error {
+	c := newCombatEngageCommand(a, target)
+	_, err := a.syncRequestToZone(c)
+	return err
+}
+
+func (a *Actor) Disengage() error {
+	c := newCombatDisengageCommand(a)
+	_, err := a.syncRequestToZone(c)
+	return err
+}
+
+// Flee attempts to escape combat through a random exit from the Actor's
+// current Location. Failing to get away is reported as ErrActorFleeFailed.
+func (a *Actor) Flee() error {
+	var fled bool
+	err := a.doDelayedAction(a.MoveDelay(), func() error {
+		val, err := a.syncRequestToZone(newCombatFleeCommand(a))
+		if err == nil {
+			fled = val.(bool)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if !fled {
+		return ErrActorFleeFailed
+	}
+	return nil
+}
+
+func (a *Actor) defaultMeleeType() string {
+	attrs := a.Attributes()
+	if attrs.NaturalBiteMax <= attrs.NaturalSlashMax {
+		return CombatMeleeDamageTypeSlash
+	}
+	for _, obj := range a.Inventory().ObjectsBySubcontainer(InventoryContainerHands) {
+		if obj.Attributes().SlashingDamageMax > attrs.NaturalBiteMax {
+			return CombatMeleeDamageTypeSlash
+		}
+	}
+	return CombatMeleeDamageTypeBite
+}
+
+//////// Zone-side processing
+
+func (z *Zone) processCombatEngageCommand(c Command) ([]Event, error) {
+	cmd := c.(*combatEngageCommand)
+
+	_, found := z.actorsById[cmd.attacker.ID()]
+	if !found || cmd.attacker.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	_, found = z.actorsById[cmd.target.ID()]
+	if !found || cmd.target.Zone() != z {
+		return nil, errors.New("target Actor not in Zone")
+	}
+	if cmd.attacker == cmd.target {
+		return nil, ErrActorEngagedSelf
+	}
+	if cmd.attacker.IsGhost() || cmd.target.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if cmd.attacker.Location() != cmd.target.Location() {
+		return nil, errors.New("attacker and target not in the same Location")
+	}
+	if cmd.attacker.EngagedWith() == cmd.target {
+		return nil, nil
+	}
+
+	outEvents := []Event{
+		NewCombatEngageEvent(cmd.attacker.ID(), cmd.target.ID(), z.ID(), cmd.attacker.Name(), cmd.target.Name()),
+	}
+	if cmd.target.EngagedWith() == nil {
+		outEvents = append(
+			outEvents,
+			NewCombatEngageEvent(cmd.target.ID(), cmd.attacker.ID(), z.ID(), cmd.target.Name(), cmd.attacker.Name()),
+		)
+	}
+	// starting a fight, as opposed to answering one, costs reputation with
+	// the target's factions
+	if cmd.target.EngagedWith() != cmd.attacker {
+		outEvents = append(outEvents, z.reputationEventsForAttack(cmd.attacker, cmd.target)...)
+	}
+
+	return z.sequenceAndApplyEvents(outEvents)
+}
+
+// engageEventsFor returns the events needed to engage an unengaged attacker
+// with its target, and an unengaged target with its attacker, along with the
+// attacker's loss of reputation if it started the fight. Actors who have died
+// or left in the meantime are skipped.
+func (z *Zone) engageEventsFor(attacker, target *Actor) []Event {
+	_, attackerPresent := z.actorsById[attacker.ID()]
+	_, targetPresent := z.actorsById[target.ID()]
+	if !attackerPresent || !targetPresent || attacker.Location() != target.Location() {
+		return nil
+	}
+
+	var outEvents []Event
+	if attacker.EngagedWith() != target && target.EngagedWith() != attacker {
+		outEvents = append(outEvents, z.reputationEventsForAttack(attacker, target)...)
+	}
+	if attacker.EngagedWith() == nil {
+		outEvents = append(
+			outEvents,
+			NewCombatEngageEvent(attacker.ID(), target.ID(), z.ID(), attacker.Name(), target.Name()),
+		)
+	}
+	if target.EngagedWith() == nil {
+		outEvents = append(
+			outEvents,
+			NewCombatEngageEvent(target.ID(), attacker.ID(), z.ID(), target.Name(), attacker.Name()),
+		)
+	}
+	return outEvents
+}
+
+func (z *Zone) processCombatDisengageCommand(c Command) ([]Event, error) {
+	cmd := c.(*combatDisengageCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	target := cmd.actor.EngagedWith()
+	if target == nil {
+		return nil, ErrActorNotEngaged
+	}
+
+	e := NewCombatDisengageEvent(
+		cmd.actor.ID(),
+		target.ID(),
+		z.ID(),
+		cmd.actor.Name(),
+		target.Name(),
+		CombatDisengageReasonDisengage,
+	)
+	return z.sequenceAndApplyEvents([]Event{e})
+}
+
+func (z *Zone) processCombatFleeCommand(c Command) (interface{}, []Event, error) {
+	cmd := c.(*combatFleeCommand)
+	actor := cmd.actor
+
+	_, found := z.actorsById[actor.ID()]
+	if !found || actor.Zone() != z {
+		return nil, nil, errors.New("Actor not in Zone")
+	}
+	if actor.EngagedWith() == nil && len(z.actorsEngagedWith(actor)) == 0 {
+		return nil, nil, ErrActorNotEngaged
+	}
+
+	// FIXME fleeing across Zone boundaries requires a World.MigrateActor(),
+	// FIXME which can't be done from inside the Zone's command processing
+	var exits ExitList
+	for _, exit := range actor.Location().OutExits() {
+		if exit.Destination() == nil {
+			continue
+		}
+		// nobody can flee through a closed door
+		if exit.IsClosed() {
+			continue
+		}
+		exits = append(exits, exit)
+	}
+	if len(exits) == 0 {
+		return nil, nil, ErrActorCannotFlee
+	}
+	exit := exits[z.rando.Intn(len(exits))]
+
+	stamina := float64(actor.Attributes().Stamina)
+	if stamina < 0 {
+		stamina = 0
+	} else if stamina > 100 {
+		stamina = 100
+	}
+	failChance := combatFleeBaseFailChance - (stamina/100)*combatFleeStaminaReductionMax
+	if rollFloat64(z.rando) < failChance {
+		fleeEv := NewCombatFleeEvent(actor.ID(), z.ID(), actor.Name(), "", false)
+		outEvents, err := z.sequenceAndApplyEvents([]Event{fleeEv})
+		return false, outEvents, err
+	}
+
+	outEvents := []Event{
+		NewCombatFleeEvent(actor.ID(), z.ID(), actor.Name(), exit.Direction(), true),
+	}
+	outEvents = append(outEvents, z.disengageEventsFor(actor, CombatDisengageReasonFlee)...)
+	outEvents = append(outEvents, NewActorMoveEvent(
+		actor.Location().ID(),
+		exit.Destination().ID(),
+		actor.ID(),
+		z.ID(),
+	))
+
+	outEvents, err := z.sequenceAndApplyEvents(outEvents)
+	return true, outEvents, err
+}
+
+// processCombatRoundCommand resolves an automatic attack for every engaged
+// Actor that is ready to act, and ends engagements whose target has gone.
+func (z *Zone) processCombatRoundCommand(c Command) ([]Event, error) {
+	var outEvents []Event
+	now := time.Now()
+
+	for _, attacker := range z.actorsById {
+		target := attacker.EngagedWith()
+		if target == nil {
+			continue
+		}
+
+		_, targetPresent := z.actorsById[target.ID()]
+		if !targetPresent || target.Location() != attacker.Location() {
+			disengageEv := NewCombatDisengageEvent(
+				attacker.ID(),
+				target.ID(),
+				z.ID(),
+				attacker.Name(),
+				target.Name(),
+				CombatDisengageReasonDeparted,
+			)
+			applied, err := z.sequenceAndApplyEvents([]Event{disengageEv})
+			outEvents = append(outEvents, applied...)
+			if err != nil {
+				fmt.Printf("CORE ERROR: zone %q combat round, disengaging Actor %q: %s\n", z.Tag(), attacker.ID(), err)
+			}
+			continue
+		}
+
+		if attacker.NextActionAt().After(now) {
+			continue
+		}
+
+		meleeEvents, err := newCombatMeleeCommand(attacker, target, attacker.defaultMeleeType()).Do()
+		if err != nil {
+			fmt.Printf("CORE ERROR: zone %q combat round, Actor %q attacking: %s\n", z.Tag(), attacker.ID(), err)
+			continue
+		}
+		delay := attacker.MeleeDelay()
+		meleeEvents = append(meleeEvents, NewActorActionDelayEvent(attacker.ID(), z.ID(), delay))
+		applied, err := z.sequenceAndApplyEvents(meleeEvents)
+		outEvents = append(outEvents, applied...)
+		if err != nil {
+			fmt.Printf("CORE ERROR: zone %q combat round, Actor %q attacking: %s\n", z.Tag(), attacker.ID(), err)
+			continue
+		}
+
+		attacker.rwlock.Lock()
+		attacker.nextDelayedActionStart = now.Add(delay)
+		attacker.rwlock.Unlock()
+	}
+
+	return outEvents, nil
+}
+
+func (z *Zone) actorsEngagedWith(target *Actor) ActorList {
+	var out ActorList
+	for _, a := range z.actorsById {
+		if a.EngagedWith() == target {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// disengageEventsFor returns events ending every engagement involving the
+// given Actor, whether it's the attacker or the target.
+func (z *Zone) disengageEventsFor(actor *Actor, reason string) []Event {
+	var outEvents []Event
+	if target := actor.EngagedWith(); target != nil {
+		outEvents = append(outEvents, NewCombatDisengageEvent(
+			actor.ID(),
+			target.ID(),
+			z.ID(),
+			actor.Name(),
+			target.Name(),
+			reason,
+		))
+	}
+	for _, attacker := range z.actorsEngagedWith(actor) {
+		outEvents = append(outEvents, NewCombatDisengageEvent(
+			attacker.ID(),
+			actor.ID(),
+			z.ID(),
+			attacker.Name(),
+			actor.Name(),
+			reason,
+		))
+	}
+	return outEvents
+}
+
+func (z *Zone) combatObserversFor(actorIDs ...uuid.UUID) ObserverList {
+	var oList ObserverList
+	for _, id := range actorIDs {
+		actor, found := z.actorsById[id]
+		if !found {
+			continue
+		}
+		oList = append(oList, actor.Observers()...)
+		if actor.Location() != nil {
+			oList = append(oList, actor.Location().Observers()...)
+		}
+	}
+	return oList.Dedupe()
+}
+
+func (z *Zone) applyCombatEngageEvent(e *CombatEngageEvent) (ObserverList, error) {
+	attacker, found := z.actorsById[e.AttackerID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.AttackerID)
+	}
+	target, found := z.actorsById[e.TargetID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.TargetID)
+	}
+	attacker.setEngagedWith(target)
+	return z.combatObserversFor(e.AttackerID, e.TargetID), nil
+}
+
+func (z *Zone) applyCombatDisengageEvent(e *CombatDisengageEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	actor.setEngagedWith(nil)
+	return z.combatObserversFor(e.ActorID, e.TargetID), nil
+}
+
+func (z *Zone) applyCombatFleeEvent(e *CombatFleeEvent) (ObserverList, error) {
+	_, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	return z.combatObserversFor(e.ActorID), nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newCombatEngageCommand(attacker, target *Actor) *combatEngageCommand {
+	return &combatEngageCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeCombatEngage},
+		attacker:       attacker,
+		target:         target,
+	}
+}
+
+type combatEngageCommand struct {
+	commandGeneric
+	attacker, target *Actor
+}
+
+func newCombatDisengageCommand(actor *Actor) *combatDisengageCommand {
+	return &combatDisengageCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeCombatDisengage},
+		actor:          actor,
+	}
+}
+
+type combatDisengageCommand struct {
+	commandGeneric
+	actor *Actor
+}
+
+func newCombatFleeCommand(actor *Actor) *combatFleeCommand {
+	return &combatFleeCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeCombatFlee},
+		actor:          actor,
+	}
+}
+
+type combatFleeCommand struct {
+	commandGeneric
+	actor *Actor
+}
+
+func newCombatRoundCommand() *combatRoundCommand {
+	return &combatRoundCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeCombatRound},
+	}
+}
+
+type combatRoundCommand struct {
+	commandGeneric
+}
+
+func NewCombatEngageEvent(attackerID, targetID, zoneID uuid.UUID, attackerName, targetName string) *CombatEngageEvent {
+	return &CombatEngageEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeCombatEngage,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: false,
+		},
+		AttackerID:   attackerID,
+		TargetID:     targetID,
+		AttackerName: attackerName,
+		TargetName:   targetName,
+	}
+}
+
+type CombatEngageEvent struct {
+	*eventGeneric
+	AttackerID               uuid.UUID
+	TargetID                 uuid.UUID
+	AttackerName, TargetName string
+}
+
+func NewCombatDisengageEvent(actorID, targetID, zoneID uuid.UUID, actorName, targetName, reason string) *CombatDisengageEvent {
+	return &CombatDisengageEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeCombatDisengage,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: false,
+		},
+		ActorID:    actorID,
+		TargetID:   targetID,
+		ActorName:  actorName,
+		TargetName: targetName,
+		Reason:     reason,
+	}
+}
+
+type CombatDisengageEvent struct {
+	*eventGeneric
+	ActorID               uuid.UUID
+	TargetID              uuid.UUID
+	ActorName, TargetName string
+	Reason                string
+}
+
+func NewCombatFleeEvent(actorID, zoneID uuid.UUID, actorName, direction string, success bool) *CombatFleeEvent {
+	return &CombatFleeEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeCombatFlee,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: false,
+		},
+		ActorID:   actorID,
+		ActorName: actorName,
+		Direction: direction,
+		Success:   success,
+	}
+}
+
+type CombatFleeEvent struct {
+	*eventGeneric
+	ActorID   uuid.UUID
+	ActorName string
+	Direction string
+	Success   bool
+}
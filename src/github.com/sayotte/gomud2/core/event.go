@@ -30,6 +30,58 @@ const (
 	EventTypeZoneSetDefaultLocation
 	EventTypeCombatMeleeDamage
 	EventTypeCombatDodge
+	EventTypeActorActionDelay
+	EventTypeCombatEngage
+	EventTypeCombatDisengage
+	EventTypeCombatFlee
+	EventTypeActorBecomeGhost
+	EventTypeActorRespawn
+	EventTypeActorSetBindLocation
+	EventTypeSorceryInvoke
+	EventTypeActorLearnReaction
+	EventTypeDeityAdd
+	EventTypeDeitySacrifice
+	EventTypeDeityPrayerAnswered
+	EventTypeActorDedicate
+	EventTypeActorSacrifice
+	EventTypeActorPray
+	EventTypeActorLearnTechnique
+	EventTypeActorScribe
+	EventTypeActorExert
+	EventTypeExitSetDoor
+	EventTypeExitDoor
+	EventTypeObjectContainerAction
+	EventTypeObjectCoins
+	EventTypeShopTrade
+	EventTypeActorSetShop
+	EventTypeTradeOffer
+	EventTypeTradeAccept
+	EventTypeTradeCancel
+	EventTypeTradeComplete
+	EventTypeActorSetHireTerms
+	EventTypeActorHire
+	EventTypeActorRelease
+	EventTypeActorRelationship
+	EventTypeActorOrder
+	EventTypeActorJoinFaction
+	EventTypeActorLeaveFaction
+	EventTypeActorReputation
+	EventTypeZoneSetLaw
+	EventTypeCrimeCommit
+	EventTypeCrimeReport
+	EventTypeCrimeResolve
+	EventTypeObjectOwnership
+	EventTypeActorQuestAccept
+	EventTypeActorQuestProgress
+	EventTypeActorQuestComplete
+	EventTypeActorQuestAbandon
+	EventTypeActorConsume
+	EventTypeActorEffect
+	EventTypeActorEffectStart
+	EventTypeActorEffectEnd
+	EventTypeActorCraft
+	EventTypeObjectPrototypeUpdate
+	EventTypeDeityReversal
 )
 
 type Event interface {
@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/satori/go.uuid"
@@ -11,17 +12,92 @@ import (
 )
 
 const (
-	ExitDirectionNorth = "north"
-	ExitDirectionSouth = "south"
-	ExitDirectionEast  = "east"
-	ExitDirectionWest  = "west"
+	ExitDirectionNorth     = "north"
+	ExitDirectionSouth     = "south"
+	ExitDirectionEast      = "east"
+	ExitDirectionWest      = "west"
+	ExitDirectionNortheast = "northeast"
+	ExitDirectionNorthwest = "northwest"
+	ExitDirectionSoutheast = "southeast"
+	ExitDirectionSouthwest = "southwest"
+	ExitDirectionUp        = "up"
+	ExitDirectionDown      = "down"
 )
 
+// ValidDirections are the standard directions. Exits may also use custom
+// directions, such as "enter portal"; see IsValidDirection.
 var ValidDirections = map[string]bool{
-	ExitDirectionNorth: true,
-	ExitDirectionSouth: true,
-	ExitDirectionEast:  true,
-	ExitDirectionWest:  true,
+	ExitDirectionNorth:     true,
+	ExitDirectionSouth:     true,
+	ExitDirectionEast:      true,
+	ExitDirectionWest:      true,
+	ExitDirectionNortheast: true,
+	ExitDirectionNorthwest: true,
+	ExitDirectionSoutheast: true,
+	ExitDirectionSouthwest: true,
+	ExitDirectionUp:        true,
+	ExitDirectionDown:      true,
+}
+
+// DirectionAbbreviations maps the short forms of the standard directions to
+// the directions themselves.
+var DirectionAbbreviations = map[string]string{
+	"n":  ExitDirectionNorth,
+	"s":  ExitDirectionSouth,
+	"e":  ExitDirectionEast,
+	"w":  ExitDirectionWest,
+	"ne": ExitDirectionNortheast,
+	"nw": ExitDirectionNorthwest,
+	"se": ExitDirectionSoutheast,
+	"sw": ExitDirectionSouthwest,
+	"u":  ExitDirectionUp,
+	"d":  ExitDirectionDown,
+}
+
+var oppositeDirections = map[string]string{
+	ExitDirectionNorth:     ExitDirectionSouth,
+	ExitDirectionSouth:     ExitDirectionNorth,
+	ExitDirectionEast:      ExitDirectionWest,
+	ExitDirectionWest:      ExitDirectionEast,
+	ExitDirectionNortheast: ExitDirectionSouthwest,
+	ExitDirectionNorthwest: ExitDirectionSoutheast,
+	ExitDirectionSoutheast: ExitDirectionNorthwest,
+	ExitDirectionSouthwest: ExitDirectionNortheast,
+	ExitDirectionUp:        ExitDirectionDown,
+	ExitDirectionDown:      ExitDirectionUp,
+}
+
+// customDirectionRegexp matches custom directions: one or more lowercase
+// words, e.g. "climb rope".
+var customDirectionRegexp = regexp.MustCompile(`^[a-z]+( [a-z]+)*$`)
+
+// IsValidDirection reports whether an Exit may lead in the given direction;
+// either one of the ValidDirections, or a custom direction made up of one or
+// more lowercase words. Abbreviations of the standard directions are reserved,
+// so can't be used as custom directions.
+func IsValidDirection(direction string) bool {
+	if ValidDirections[direction] {
+		return true
+	}
+	if _, found := DirectionAbbreviations[direction]; found {
+		return false
+	}
+	return customDirectionRegexp.MatchString(direction)
+}
+
+// ExpandDirection returns the standard direction an abbreviation stands for,
+// e.g. "northeast" for "ne". Anything else is returned unchanged.
+func ExpandDirection(direction string) string {
+	if expanded, found := DirectionAbbreviations[direction]; found {
+		return expanded
+	}
+	return direction
+}
+
+// OppositeDirection returns the standard direction leading back the way the
+// given one came, or "" if there isn't one (e.g. for a custom direction).
+func OppositeDirection(direction string) string {
+	return oppositeDirections[direction]
 }
 
 func NewExit(id uuid.UUID, desc, direction string, src, dest *Location, zone *Zone, otherZoneID, otherLocID uuid.UUID) *Exit {
@@ -50,6 +126,11 @@ type Exit struct {
 	zone           *Zone
 	otherZoneID    uuid.UUID
 	otherZoneLocID uuid.UUID
+
+	// see door.go
+	hasDoor, closed, locked bool
+	keyID                   uuid.UUID
+	keyKeyword              string
 }
 
 func (ex Exit) ID() uuid.UUID {
@@ -152,6 +233,11 @@ func (ex Exit) snapshot(sequenceNum uint64) Event {
 		ex.zone.ID(),
 		ex.otherZoneID,
 	)
+	e.HasDoor = ex.hasDoor
+	e.Closed = ex.closed
+	e.Locked = ex.locked
+	e.KeyID = ex.keyID
+	e.KeyKeyword = ex.keyKeyword
 	e.SetSequenceNumber(sequenceNum)
 	return e
 }
@@ -203,19 +289,19 @@ type exitAddToZoneCommand struct {
 
 func NewExitAddToZoneEvent(desc, direction string, exitId, sourceId, destLocId, srcZoneId, destZoneID uuid.UUID) *ExitAddToZoneEvent {
 	return &ExitAddToZoneEvent{
-		&eventGeneric{
+		eventGeneric: &eventGeneric{
 			EventTypeNum:      EventTypeExitAddToZone,
 			TimeStamp:         time.Now(),
 			VersionNum:        1,
 			AggregateID:       srcZoneId,
 			ShouldPersistBool: true,
 		},
-		exitId,
-		desc,
-		direction,
-		sourceId,
-		destZoneID,
-		destLocId,
+		ExitID:           exitId,
+		Description:      desc,
+		Direction:        direction,
+		SourceLocationId: sourceId,
+		DestZoneID:       destZoneID,
+		DestLocationId:   destLocId,
 	}
 }
 
@@ -227,6 +313,10 @@ type ExitAddToZoneEvent struct {
 	SourceLocationId uuid.UUID
 	DestZoneID       uuid.UUID
 	DestLocationId   uuid.UUID
+	// door state, see door.go
+	HasDoor, Closed, Locked bool
+	KeyID                   uuid.UUID
+	KeyKeyword              string
 }
 
 func newExitUpdateCommand(wrapped *ExitUpdateEvent) exitUpdateCommand {
@@ -0,0 +1,505 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Factions are groups of Actors, such as the citizens of a town or a gang of
+// brigands. Every faction holds an opinion of every Actor, its reputation
+// with that faction, which starts from a default (better for members, worse
+// for members of the faction's enemies) and changes as the Actor treats the
+// faction's members well or badly. The faction's members regard Actors with
+// a poor enough reputation as hostile; see the "known-citizens" discussion in
+// dialoguePlan.md.
+//
+// Factions themselves are defined in a data file, like prayers; membership
+// and reputation are event-sourced as part of each Actor.
+
+const (
+	ReputationMin = -1000
+	ReputationMax = 1000
+
+	ReputationStandingHostile  = "hostile"
+	ReputationStandingNeutral  = "neutral"
+	ReputationStandingFriendly = "friendly"
+
+	ReputationReasonAttackedMember = "attacked-member"
+	ReputationReasonKilledMember   = "killed-member"
+	ReputationReasonKilledEnemy    = "killed-enemy"
+)
+
+var (
+	ErrFactionUnknown       = errors.New("no such faction")
+	ErrAlreadyFactionMember = errors.New("Actor is already a member of that faction")
+	ErrNotFactionMember     = errors.New("Actor is not a member of that faction")
+)
+
+// Faction describes a faction, and how its opinion of Actors changes.
+type Faction struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// Enemies are the names of other factions whose members start out with
+	// EnemyReputation.
+	Enemies []string `yaml:"enemies"`
+	// DefaultReputation is where an Actor's reputation starts, unless it's a
+	// member of this faction or of one of its enemies.
+	DefaultReputation int `yaml:"defaultReputation"`
+	MemberReputation  int `yaml:"memberReputation"`
+	EnemyReputation   int `yaml:"enemyReputation"`
+	// Members regard Actors whose reputation is below HostileBelow as hostile,
+	// and those whose reputation is at or above FriendlyAtOrAbove as friends.
+	HostileBelow      int `yaml:"hostileBelow"`
+	FriendlyAtOrAbove int `yaml:"friendlyAtOrAbove"`
+	// How much an Actor's reputation falls when it attacks, or kills, one of
+	// the faction's members.
+	AttackedMemberPenalty int `yaml:"attackedMemberPenalty"`
+	KilledMemberPenalty   int `yaml:"killedMemberPenalty"`
+	// How much an Actor's reputation rises when it kills a member of one of
+	// the faction's enemies.
+	KilledEnemyBonus int `yaml:"killedEnemyBonus"`
+}
+
+// Standing describes the given reputation with the faction, as one of the
+// ReputationStanding* constants.
+func (f Faction) Standing(reputation int) string {
+	switch {
+	case reputation < f.HostileBelow:
+		return ReputationStandingHostile
+	case reputation >= f.FriendlyAtOrAbove:
+		return ReputationStandingFriendly
+	default:
+		return ReputationStandingNeutral
+	}
+}
+
+func (f Faction) isEnemyOf(factionName string) bool {
+	for _, enemy := range f.Enemies {
+		if enemy == factionName {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	factionsLock   = &sync.RWMutex{}
+	factionsByName = make(map[string]Faction)
+)
+
+// SetFactions replaces the set of factions, typically with definitions loaded
+// from a data file at startup.
+func SetFactions(factions []Faction) error {
+	byName := make(map[string]Faction, len(factions))
+	for _, f := range factions {
+		if f.Name == "" {
+			return errors.New("faction with empty name")
+		}
+		if _, duplicate := byName[f.Name]; duplicate {
+			return fmt.Errorf("duplicate faction %q", f.Name)
+		}
+		if f.HostileBelow > f.FriendlyAtOrAbove {
+			return fmt.Errorf("faction %q is hostile above the reputation at which it's friendly", f.Name)
+		}
+		byName[f.Name] = f
+	}
+	for _, f := range factions {
+		for _, enemy := range f.Enemies {
+			if _, found := byName[enemy]; !found {
+				return fmt.Errorf("faction %q has unknown enemy %q", f.Name, enemy)
+			}
+		}
+	}
+
+	factionsLock.Lock()
+	defer factionsLock.Unlock()
+	factionsByName = byName
+	return nil
+}
+
+func FactionByName(name string) (Faction, bool) {
+	factionsLock.RLock()
+	defer factionsLock.RUnlock()
+	f, found := factionsByName[name]
+	return f, found
+}
+
+func Factions() []Faction {
+	factionsLock.RLock()
+	defer factionsLock.RUnlock()
+	out := make([]Faction, 0, len(factionsByName))
+	for _, f := range factionsByName {
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func clampReputation(reputation int) int {
+	if reputation < ReputationMin {
+		return ReputationMin
+	}
+	if reputation > ReputationMax {
+		return ReputationMax
+	}
+	return reputation
+}
+
+//////// Actor methods
+
+// Factions returns the names of the factions the Actor is a member of.
+func (a *Actor) Factions() []string {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return append([]string(nil), a.factions...)
+}
+
+func (a *Actor) IsFactionMember(factionName string) bool {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.isFactionMember(factionName)
+}
+
+func (a *Actor) isFactionMember(factionName string) bool {
+	for _, name := range a.factions {
+		if name == factionName {
+			return true
+		}
+	}
+	return false
+}
+
+// Reputation returns the Actor's reputation with the named faction. Until it
+// has done something to change it, that's the faction's default for an Actor
+// of its memberships.
+func (a *Actor) Reputation(factionName string) int {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	if reputation, found := a.reputation[factionName]; found {
+		return reputation
+	}
+	faction, found := FactionByName(factionName)
+	if !found {
+		return 0
+	}
+	if a.isFactionMember(factionName) {
+		return faction.MemberReputation
+	}
+	for _, name := range a.factions {
+		if faction.isEnemyOf(name) {
+			return faction.EnemyReputation
+		}
+	}
+	return faction.DefaultReputation
+}
+
+// reputationSnapshot returns the reputations the Actor has earned, as opposed
+// to those it has by default. The map must not be modified.
+func (a *Actor) reputationSnapshot() map[string]int {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.reputation
+}
+
+// ReputationStanding describes the Actor's reputation with the named faction
+// as one of the ReputationStanding* constants.
+func (a *Actor) ReputationStanding(factionName string) string {
+	faction, found := FactionByName(factionName)
+	if !found {
+		return ReputationStandingNeutral
+	}
+	return faction.Standing(a.Reputation(factionName))
+}
+
+// HostileFactions returns the names of the factions whose members regard the
+// Actor as hostile.
+func (a *Actor) HostileFactions() []string {
+	var out []string
+	for _, faction := range Factions() {
+		if faction.Standing(a.Reputation(faction.Name)) == ReputationStandingHostile {
+			out = append(out, faction.Name)
+		}
+	}
+	return out
+}
+
+// RegardsAsHostile reports whether any of the Actor's factions regards the
+// other Actor as hostile.
+func (a *Actor) RegardsAsHostile(other *Actor) bool {
+	for _, name := range a.Factions() {
+		if other.ReputationStanding(name) == ReputationStandingHostile {
+			return true
+		}
+	}
+	return false
+}
+
+// JoinFaction makes the Actor a member of the named faction.
+func (a *Actor) JoinFaction(factionName string) error {
+	_, err := a.syncRequestToZone(newActorFactionMembershipCommand(a, factionName, true))
+	return err
+}
+
+// LeaveFaction ends the Actor's membership of the named faction. Its
+// reputation with the faction is unaffected.
+func (a *Actor) LeaveFaction(factionName string) error {
+	_, err := a.syncRequestToZone(newActorFactionMembershipCommand(a, factionName, false))
+	return err
+}
+
+//////// Zone-side processing
+
+// reputationEvents turns per-faction changes to an Actor's reputation into
+// events, in a stable order.
+func (z *Zone) reputationEvents(actor *Actor, deltas map[string]int, reasons map[string]string) []Event {
+	names := make([]string, 0, len(deltas))
+	for name := range deltas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var outEvents []Event
+	for _, name := range names {
+		faction, found := FactionByName(name)
+		if !found || deltas[name] == 0 {
+			continue
+		}
+		reputation := clampReputation(actor.Reputation(name) + deltas[name])
+		outEvents = append(outEvents, NewActorReputationEvent(
+			actor.ID(),
+			z.id,
+			actor.Name(),
+			name,
+			reasons[name],
+			deltas[name],
+			reputation,
+			faction.Standing(reputation),
+		))
+	}
+	return outEvents
+}
+
+// reputationEventsForAttack returns the events lowering the attacker's
+// reputation with each of the target's factions, for starting a fight with
+// it.
+func (z *Zone) reputationEventsForAttack(attacker, target *Actor) []Event {
+	if attacker == target {
+		return nil
+	}
+	deltas := make(map[string]int)
+	reasons := make(map[string]string)
+	for _, name := range target.Factions() {
+		faction, found := FactionByName(name)
+		if !found {
+			continue
+		}
+		deltas[name] -= faction.AttackedMemberPenalty
+		reasons[name] = ReputationReasonAttackedMember
+	}
+	return z.reputationEvents(attacker, deltas, reasons)
+}
+
+// reputationEventsForKill returns the events changing the killer's reputation
+// with the victim's factions, and with their enemies.
+func (z *Zone) reputationEventsForKill(killer, victim *Actor) []Event {
+	if killer == nil || killer == victim {
+		return nil
+	}
+	victimFactions := victim.Factions()
+	deltas := make(map[string]int)
+	reasons := make(map[string]string)
+	for _, faction := range Factions() {
+		for _, name := range victimFactions {
+			if faction.isEnemyOf(name) {
+				deltas[faction.Name] += faction.KilledEnemyBonus
+				reasons[faction.Name] = ReputationReasonKilledEnemy
+				break
+			}
+		}
+	}
+	for _, name := range victimFactions {
+		faction, found := FactionByName(name)
+		if !found {
+			continue
+		}
+		deltas[name] -= faction.KilledMemberPenalty
+		reasons[name] = ReputationReasonKilledMember
+	}
+	return z.reputationEvents(killer, deltas, reasons)
+}
+
+func (z *Zone) processActorFactionMembershipCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorFactionMembershipCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	if _, found := FactionByName(cmd.factionName); !found {
+		return nil, ErrFactionUnknown
+	}
+
+	var e Event
+	if cmd.join {
+		if cmd.actor.IsFactionMember(cmd.factionName) {
+			return nil, ErrAlreadyFactionMember
+		}
+		e = NewActorJoinFactionEvent(cmd.actor.ID(), z.id, cmd.actor.Name(), cmd.factionName)
+	} else {
+		if !cmd.actor.IsFactionMember(cmd.factionName) {
+			return nil, ErrNotFactionMember
+		}
+		e = NewActorLeaveFactionEvent(cmd.actor.ID(), z.id, cmd.actor.Name(), cmd.factionName)
+	}
+	return z.sequenceAndApplyEvents([]Event{e})
+}
+
+func (z *Zone) applyActorJoinFactionEvent(e *ActorJoinFactionEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q joining faction", e.ActorID)
+	}
+	actor.rwlock.Lock()
+	// copy-on-write, as the old slice may be captured in a snapshot
+	factions := append(append([]string(nil), actor.factions...), e.Faction)
+	sort.Strings(factions)
+	actor.factions = factions
+	actor.rwlock.Unlock()
+	return actor.location.Observers(), nil
+}
+
+func (z *Zone) applyActorLeaveFactionEvent(e *ActorLeaveFactionEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q leaving faction", e.ActorID)
+	}
+	actor.rwlock.Lock()
+	var factions []string
+	for _, name := range actor.factions {
+		if name != e.Faction {
+			factions = append(factions, name)
+		}
+	}
+	actor.factions = factions
+	actor.rwlock.Unlock()
+	return actor.location.Observers(), nil
+}
+
+func (z *Zone) applyActorReputationEvent(e *ActorReputationEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q whose reputation changed", e.ActorID)
+	}
+	actor.rwlock.Lock()
+	// copy-on-write, as the old map may be captured in a snapshot
+	reputation := make(map[string]int, len(actor.reputation)+1)
+	for name, value := range actor.reputation {
+		reputation[name] = value
+	}
+	reputation[e.Faction] = e.Reputation
+	actor.reputation = reputation
+	actor.rwlock.Unlock()
+	// bystanders see the change too, so that faction members nearby know
+	// whom to treat as hostile
+	return actor.location.Observers(), nil
+}
+
+//////// Commands and events
+
+func newActorFactionMembershipCommand(actor *Actor, factionName string, join bool) *actorFactionMembershipCommand {
+	return &actorFactionMembershipCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorFactionMembership},
+		actor:          actor,
+		factionName:    factionName,
+		join:           join,
+	}
+}
+
+type actorFactionMembershipCommand struct {
+	commandGeneric
+	actor       *Actor
+	factionName string
+	join        bool
+}
+
+func NewActorJoinFactionEvent(actorID, zoneID uuid.UUID, actorName, faction string) *ActorJoinFactionEvent {
+	return &ActorJoinFactionEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorJoinFaction,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		ActorName: actorName,
+		Faction:   faction,
+	}
+}
+
+type ActorJoinFactionEvent struct {
+	*eventGeneric
+	ActorID   uuid.UUID
+	ActorName string
+	Faction   string
+}
+
+func NewActorLeaveFactionEvent(actorID, zoneID uuid.UUID, actorName, faction string) *ActorLeaveFactionEvent {
+	return &ActorLeaveFactionEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorLeaveFaction,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		ActorName: actorName,
+		Faction:   faction,
+	}
+}
+
+type ActorLeaveFactionEvent struct {
+	*eventGeneric
+	ActorID   uuid.UUID
+	ActorName string
+	Faction   string
+}
+
+func NewActorReputationEvent(actorID, zoneID uuid.UUID, actorName, faction, reason string, delta, reputation int, standing string) *ActorReputationEvent {
+	return &ActorReputationEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorReputation,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:    actorID,
+		ActorName:  actorName,
+		Faction:    faction,
+		Reason:     reason,
+		Delta:      delta,
+		Reputation: reputation,
+		Standing:   standing,
+	}
+}
+
+// ActorReputationEvent records an Actor's reputation with a faction changing
+// by Delta, to Reputation, for one of the ReputationReason* reasons. Standing
+// is the faction's view of the Actor afterwards.
+type ActorReputationEvent struct {
+	*eventGeneric
+	ActorID    uuid.UUID
+	ActorName  string
+	Faction    string
+	Reason     string
+	Delta      int
+	Reputation int
+	Standing   string
+}
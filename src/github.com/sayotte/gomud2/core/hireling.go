@@ -0,0 +1,717 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+// Actors with HireTerms are for hire: another Actor can pay them a wage, up
+// front, for a term of service, and so become their employer. A hireling
+// takes orders from its employer (and nobody else), and keeps lists of the
+// friends it guards and the enemies it attacks; these are all part of its
+// HirelingContract, which is event-sourced like the rest of the Actor.
+// Orders are only recorded here; carrying them out is up to the hireling's
+// brain, which hears about them through the hireling's observers.
+//
+// Hiring an Actor again before its term runs out extends the contract by
+// another term. Once the term is up, or the employer fires it, the hireling
+// forgets its employer, friends, enemies and orders.
+
+const (
+	HirelingOrderAttack    = "attack"
+	HirelingOrderStandDown = "stand-down"
+	HirelingOrderGuard     = "guard"
+	HirelingOrderStay      = "stay"
+	HirelingOrderFollow    = "follow"
+	HirelingOrderReport    = "report"
+)
+
+var AllHirelingOrders = []string{
+	HirelingOrderAttack,
+	HirelingOrderStandDown,
+	HirelingOrderGuard,
+	HirelingOrderStay,
+	HirelingOrderFollow,
+	HirelingOrderReport,
+}
+
+const (
+	HirelingReleaseReasonFired   = "fired"
+	HirelingReleaseReasonExpired = "expired"
+)
+
+const (
+	HirelingRelationshipNone   = ""
+	HirelingRelationshipFriend = "friend"
+	HirelingRelationshipEnemy  = "enemy"
+)
+
+// How often a Zone checks for hireling contracts which have run out.
+var hirelingContractCheckInterval = time.Second
+
+var (
+	ErrNotForHire           = errors.New("Actor is not for hire")
+	ErrHireSelf             = errors.New("Actor cannot hire itself")
+	ErrAlreadyHired         = errors.New("Actor is already hired by someone else")
+	ErrNotEmployer          = errors.New("Actor is not that hireling's employer")
+	ErrHirelingNotHere      = errors.New("hireling is not here")
+	ErrHirelingNoRoom       = errors.New("hireling has no room for its wage")
+	ErrHirelingOrderTarget  = errors.New("that order needs someone else as a target")
+	ErrHirelingOrderSelf    = errors.New("hireling cannot be ordered against itself")
+	ErrHirelingOrderUnknown = errors.New("unknown order")
+)
+
+// HireTerms make an Actor available for hire.
+type HireTerms struct {
+	// Wage is the price, in coins, of one term of service.
+	Wage int
+	// Term is how long one term of service lasts.
+	Term time.Duration
+}
+
+// HirelingContract describes a hireling's service to its employer.
+type HirelingContract struct {
+	EmployerID   uuid.UUID
+	EmployerName string
+	HiredUntil   time.Time
+	// Friends are guarded by the hireling, as well as its employer.
+	Friends []uuid.UUID
+	// Enemies are attacked by the hireling on sight.
+	Enemies []uuid.UUID
+	// Order is the standing order the hireling was last given; status
+	// reports don't replace it.
+	Order HirelingOrder
+}
+
+type HirelingOrder struct {
+	Order      string
+	TargetID   uuid.UUID
+	TargetName string
+	// LocationID is where the hireling was ordered to stay.
+	LocationID uuid.UUID
+}
+
+func (hc HirelingContract) IsFriend(actorID uuid.UUID) bool {
+	return myuuid.UUIDList(hc.Friends).IndexOf(actorID) != -1
+}
+
+func (hc HirelingContract) IsEnemy(actorID uuid.UUID) bool {
+	return myuuid.UUIDList(hc.Enemies).IndexOf(actorID) != -1
+}
+
+// Relationship returns how the hireling regards the given Actor, as one of the
+// HirelingRelationship* constants.
+func (hc HirelingContract) Relationship(actorID uuid.UUID) string {
+	switch {
+	case hc.IsFriend(actorID):
+		return HirelingRelationshipFriend
+	case hc.IsEnemy(actorID):
+		return HirelingRelationshipEnemy
+	default:
+		return HirelingRelationshipNone
+	}
+}
+
+// copy returns a copy of the contract which can be changed without affecting
+// this one, e.g. one already captured in a snapshot.
+func (hc HirelingContract) copy() *HirelingContract {
+	out := hc
+	out.Friends = append([]uuid.UUID(nil), hc.Friends...)
+	out.Enemies = append([]uuid.UUID(nil), hc.Enemies...)
+	return &out
+}
+
+//////// Actor methods
+
+// HireTerms returns the terms the Actor can be hired on, or nil if it isn't
+// for hire.
+func (a *Actor) HireTerms() *HireTerms {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.hireTerms
+}
+
+// SetHireTerms puts the Actor up for hire on the given terms, or stops it
+// being for hire if terms is nil. Existing contracts are unaffected.
+func (a *Actor) SetHireTerms(terms *HireTerms) error {
+	e := NewActorSetHireTermsEvent(a.id, a.zone.ID(), terms)
+	_, err := a.syncRequestToZone(newActorSetHireTermsCommand(e))
+	return err
+}
+
+// HirelingContract returns the Actor's contract with its employer, or nil if
+// it isn't a hireling. The contract must not be modified.
+func (a *Actor) HirelingContract() *HirelingContract {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.contract
+}
+
+// Employer returns the ID of the Actor's employer, or uuid.Nil.
+func (a *Actor) Employer() uuid.UUID {
+	contract := a.HirelingContract()
+	if contract == nil {
+		return uuid.Nil
+	}
+	return contract.EmployerID
+}
+
+func (a *Actor) setHirelingContract(contract *HirelingContract) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	a.contract = contract
+}
+
+// Hire pays the hireling's wage and takes it into the Actor's service for one
+// term, or extends its service by a term if it's already in the Actor's
+// employ. The hireling must be in the same Location.
+func (a *Actor) Hire(hireling *Actor) error {
+	_, err := a.syncRequestToZone(newActorHireCommand(a, hireling))
+	return err
+}
+
+// Fire ends the hireling's contract with the Actor early. Wages aren't
+// refunded.
+func (a *Actor) Fire(hireling *Actor) error {
+	_, err := a.syncRequestToZone(newActorFireCommand(a, hireling))
+	return err
+}
+
+// Order gives the hireling, which must be in the same Location, one of the
+// HirelingOrder* orders. Attack and guard orders need a target.
+func (a *Actor) Order(hireling *Actor, order string, target *Actor) error {
+	_, err := a.syncRequestToZone(newActorOrderCommand(a, hireling, order, target))
+	return err
+}
+
+//////// Zone-side processing
+
+// hirelingObserversFor returns the observers who should hear about a change
+// to the hireling's contract: those where the hireling is, and its employer
+// wherever it is in the Zone.
+func (z *Zone) hirelingObserversFor(hireling *Actor, employerID uuid.UUID) ObserverList {
+	oList := hireling.Location().Observers()
+	employer, found := z.actorsById[employerID]
+	if found && employer.Location() != hireling.Location() {
+		oList = append(oList, employer.Observers()...)
+	}
+	return oList
+}
+
+func (z *Zone) processActorSetHireTermsCommand(c Command) ([]Event, error) {
+	cmd := c.(actorSetHireTermsCommand)
+	e := cmd.wrappedEvent
+
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	if e.HireTerms != nil {
+		if actor.IsPlayerCharacter() {
+			return nil, errors.New("player characters can't be put up for hire")
+		}
+		if e.HireTerms.Wage < 0 || e.HireTerms.Term <= 0 {
+			return nil, errors.New("hire terms need a positive term and a non-negative wage")
+		}
+	}
+
+	return z.sequenceAndApplyEvents([]Event{e})
+}
+
+func (z *Zone) processActorHireCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorHireCommand)
+
+	for _, actor := range []*Actor{cmd.employer, cmd.hireling} {
+		_, found := z.actorsById[actor.ID()]
+		if !found || actor.Zone() != z {
+			return nil, errors.New("Actor not in Zone")
+		}
+	}
+	if cmd.employer.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if cmd.employer == cmd.hireling {
+		return nil, ErrHireSelf
+	}
+	if cmd.hireling.Location() != cmd.employer.Location() || cmd.hireling.IsGhost() {
+		return nil, ErrHirelingNotHere
+	}
+	terms := cmd.hireling.HireTerms()
+	if terms == nil {
+		return nil, ErrNotForHire
+	}
+	hiredUntil := time.Now()
+	contract := cmd.hireling.HirelingContract()
+	if contract != nil {
+		if !uuid.Equal(contract.EmployerID, cmd.employer.ID()) {
+			return nil, ErrAlreadyHired
+		}
+		if contract.HiredUntil.After(hiredUntil) {
+			hiredUntil = contract.HiredUntil
+		}
+	}
+	hiredUntil = hiredUntil.Add(terms.Term)
+	if cmd.employer.spendableCoins() < terms.Wage {
+		return nil, ErrCannotAfford
+	}
+
+	// the wage is added to a stack the hireling already holds, if it has one
+	coinSub := InventoryContainerHands
+	var hasStack bool
+	for _, obj := range cmd.hireling.inventory.Objects() {
+		if obj.IsCurrency() {
+			hasStack = true
+			break
+		}
+	}
+	if terms.Wage > 0 && !hasStack {
+		coinSub = cmd.hireling.inventory.roomFor(NewCoinStack(terms.Wage, nil, z))
+		if coinSub == "" {
+			return nil, ErrHirelingNoRoom
+		}
+	}
+
+	events := []Event{
+		NewActorHireEvent(
+			cmd.hireling.ID(),
+			cmd.employer.ID(),
+			z.id,
+			cmd.hireling.Name(),
+			cmd.employer.Name(),
+			terms.Wage,
+			hiredUntil,
+		),
+	}
+	events = append(events, z.coinDebitEvents(cmd.employer, terms.Wage)...)
+	events = append(events, z.coinCreditEvents(cmd.hireling, terms.Wage, coinSub)...)
+	return z.sequenceAndApplyEvents(events)
+}
+
+func (z *Zone) processActorFireCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorFireCommand)
+
+	for _, actor := range []*Actor{cmd.employer, cmd.hireling} {
+		_, found := z.actorsById[actor.ID()]
+		if !found || actor.Zone() != z {
+			return nil, errors.New("Actor not in Zone")
+		}
+	}
+	if !uuid.Equal(cmd.hireling.Employer(), cmd.employer.ID()) {
+		return nil, ErrNotEmployer
+	}
+
+	return z.sequenceAndApplyEvents([]Event{
+		NewActorReleaseEvent(
+			HirelingReleaseReasonFired,
+			cmd.hireling.ID(),
+			cmd.employer.ID(),
+			z.id,
+			cmd.hireling.Name(),
+			cmd.employer.Name(),
+		),
+	})
+}
+
+func (z *Zone) processActorOrderCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorOrderCommand)
+
+	for _, actor := range []*Actor{cmd.employer, cmd.hireling} {
+		_, found := z.actorsById[actor.ID()]
+		if !found || actor.Zone() != z {
+			return nil, errors.New("Actor not in Zone")
+		}
+	}
+	if cmd.employer.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	contract := cmd.hireling.HirelingContract()
+	if contract == nil || !uuid.Equal(contract.EmployerID, cmd.employer.ID()) {
+		return nil, ErrNotEmployer
+	}
+	// orders are given in person
+	if cmd.hireling.Location() != cmd.employer.Location() {
+		return nil, ErrHirelingNotHere
+	}
+
+	var events []Event
+	order := HirelingOrder{Order: cmd.order}
+	switch cmd.order {
+	case HirelingOrderAttack, HirelingOrderGuard:
+		if cmd.target == nil {
+			return nil, ErrHirelingOrderTarget
+		}
+		if _, found := z.actorsById[cmd.target.ID()]; !found {
+			return nil, fmt.Errorf("unknown Actor %q", cmd.target.ID())
+		}
+		if cmd.target == cmd.hireling {
+			return nil, ErrHirelingOrderSelf
+		}
+		relationship := HirelingRelationshipEnemy
+		if cmd.order == HirelingOrderGuard {
+			relationship = HirelingRelationshipFriend
+		}
+		if contract.Relationship(cmd.target.ID()) != relationship {
+			events = append(events, NewActorRelationshipEvent(
+				relationship,
+				cmd.hireling.ID(),
+				cmd.target.ID(),
+				z.id,
+				cmd.target.Name(),
+			))
+		}
+		order.TargetID = cmd.target.ID()
+		order.TargetName = cmd.target.Name()
+	case HirelingOrderStandDown:
+		// standing down means forgetting all enemies
+		for _, enemyID := range contract.Enemies {
+			var enemyName string
+			if enemy, found := z.actorsById[enemyID]; found {
+				enemyName = enemy.Name()
+			}
+			events = append(events, NewActorRelationshipEvent(
+				HirelingRelationshipNone,
+				cmd.hireling.ID(),
+				enemyID,
+				z.id,
+				enemyName,
+			))
+		}
+	case HirelingOrderStay:
+		order.LocationID = cmd.hireling.Location().ID()
+	case HirelingOrderFollow:
+		order.TargetID = cmd.employer.ID()
+		order.TargetName = cmd.employer.Name()
+	case HirelingOrderReport:
+	default:
+		return nil, ErrHirelingOrderUnknown
+	}
+
+	events = append(events, NewActorOrderEvent(
+		order,
+		cmd.hireling.ID(),
+		cmd.employer.ID(),
+		z.id,
+		cmd.hireling.Name(),
+		cmd.employer.Name(),
+	))
+	return z.sequenceAndApplyEvents(events)
+}
+
+// processHirelingContractCheckCommand releases every hireling whose contract
+// has run out.
+func (z *Zone) processHirelingContractCheckCommand(c Command) ([]Event, error) {
+	var outEvents []Event
+	now := time.Now()
+
+	for _, actor := range z.actorsById {
+		contract := actor.HirelingContract()
+		if contract == nil || now.Before(contract.HiredUntil) {
+			continue
+		}
+		e := NewActorReleaseEvent(
+			HirelingReleaseReasonExpired,
+			actor.ID(),
+			contract.EmployerID,
+			z.id,
+			actor.Name(),
+			contract.EmployerName,
+		)
+		applied, err := z.sequenceAndApplyEvents([]Event{e})
+		outEvents = append(outEvents, applied...)
+		if err != nil {
+			fmt.Printf("CORE ERROR: zone %q releasing hireling %q: %s\n", z.Tag(), actor.ID(), err)
+		}
+	}
+
+	return outEvents, nil
+}
+
+func (z *Zone) applyActorSetHireTermsEvent(e *ActorSetHireTermsEvent) error {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	actor.rwlock.Lock()
+	defer actor.rwlock.Unlock()
+	actor.hireTerms = e.HireTerms
+	return nil
+}
+
+func (z *Zone) applyActorHireEvent(e *ActorHireEvent) (ObserverList, error) {
+	hireling, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find hireling %q", e.ActorID)
+	}
+
+	var contract *HirelingContract
+	if old := hireling.HirelingContract(); old != nil && uuid.Equal(old.EmployerID, e.EmployerID) {
+		contract = old.copy()
+	} else {
+		contract = &HirelingContract{EmployerID: e.EmployerID}
+	}
+	contract.EmployerName = e.EmployerName
+	contract.HiredUntil = e.HiredUntil
+	hireling.setHirelingContract(contract)
+
+	return z.hirelingObserversFor(hireling, e.EmployerID), nil
+}
+
+func (z *Zone) applyActorReleaseEvent(e *ActorReleaseEvent) (ObserverList, error) {
+	hireling, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find hireling %q", e.ActorID)
+	}
+	hireling.setHirelingContract(nil)
+	return z.hirelingObserversFor(hireling, e.EmployerID), nil
+}
+
+func (z *Zone) applyActorRelationshipEvent(e *ActorRelationshipEvent) (ObserverList, error) {
+	hireling, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find hireling %q", e.ActorID)
+	}
+	old := hireling.HirelingContract()
+	if old == nil {
+		return nil, fmt.Errorf("Actor %q is not a hireling", e.ActorID)
+	}
+
+	contract := old.copy()
+	contract.Friends = myuuid.UUIDList(contract.Friends).Remove(e.OtherID)
+	contract.Enemies = myuuid.UUIDList(contract.Enemies).Remove(e.OtherID)
+	switch e.Relationship {
+	case HirelingRelationshipFriend:
+		contract.Friends = append(contract.Friends, e.OtherID)
+	case HirelingRelationshipEnemy:
+		contract.Enemies = append(contract.Enemies, e.OtherID)
+	case HirelingRelationshipNone:
+	default:
+		return nil, fmt.Errorf("unknown relationship %q", e.Relationship)
+	}
+	hireling.setHirelingContract(contract)
+
+	// only the hireling and its employer need to know who it has taken
+	// against
+	oList := hireling.Observers()
+	if employer, found := z.actorsById[contract.EmployerID]; found {
+		oList = append(oList, employer.Observers()...)
+	}
+	return oList, nil
+}
+
+func (z *Zone) applyActorOrderEvent(e *ActorOrderEvent) (ObserverList, error) {
+	hireling, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find hireling %q", e.ActorID)
+	}
+	old := hireling.HirelingContract()
+	if old == nil {
+		return nil, fmt.Errorf("Actor %q is not a hireling", e.ActorID)
+	}
+
+	if e.Order.Order != HirelingOrderReport {
+		contract := old.copy()
+		contract.Order = e.Order
+		hireling.setHirelingContract(contract)
+	}
+
+	// orders are given out loud
+	return hireling.Location().Observers(), nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newActorSetHireTermsCommand(wrapped *ActorSetHireTermsEvent) actorSetHireTermsCommand {
+	return actorSetHireTermsCommand{
+		commandGeneric{commandType: CommandTypeActorSetHireTerms},
+		wrapped,
+	}
+}
+
+type actorSetHireTermsCommand struct {
+	commandGeneric
+	wrappedEvent *ActorSetHireTermsEvent
+}
+
+func newActorHireCommand(employer, hireling *Actor) *actorHireCommand {
+	return &actorHireCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorHire},
+		employer:       employer,
+		hireling:       hireling,
+	}
+}
+
+type actorHireCommand struct {
+	commandGeneric
+	employer, hireling *Actor
+}
+
+func newActorFireCommand(employer, hireling *Actor) *actorFireCommand {
+	return &actorFireCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorFire},
+		employer:       employer,
+		hireling:       hireling,
+	}
+}
+
+type actorFireCommand struct {
+	commandGeneric
+	employer, hireling *Actor
+}
+
+func newActorOrderCommand(employer, hireling *Actor, order string, target *Actor) *actorOrderCommand {
+	return &actorOrderCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorOrder},
+		employer:       employer,
+		hireling:       hireling,
+		order:          order,
+		target:         target,
+	}
+}
+
+type actorOrderCommand struct {
+	commandGeneric
+	employer, hireling, target *Actor
+	order                      string
+}
+
+func newHirelingContractCheckCommand() *hirelingContractCheckCommand {
+	return &hirelingContractCheckCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeHirelingContractCheck},
+	}
+}
+
+type hirelingContractCheckCommand struct {
+	commandGeneric
+}
+
+func NewActorSetHireTermsEvent(actorID, zoneID uuid.UUID, terms *HireTerms) *ActorSetHireTermsEvent {
+	return &ActorSetHireTermsEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorSetHireTerms,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		HireTerms: terms,
+	}
+}
+
+type ActorSetHireTermsEvent struct {
+	*eventGeneric
+	ActorID   uuid.UUID
+	HireTerms *HireTerms
+}
+
+func NewActorHireEvent(actorID, employerID, zoneID uuid.UUID, actorName, employerName string, wage int, hiredUntil time.Time) *ActorHireEvent {
+	return &ActorHireEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorHire,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:      actorID,
+		EmployerID:   employerID,
+		ActorName:    actorName,
+		EmployerName: employerName,
+		Wage:         wage,
+		HiredUntil:   hiredUntil,
+	}
+}
+
+// ActorHireEvent records an employer hiring an Actor, or extending its
+// contract, until HiredUntil. It's followed by the events which pay the Wage.
+type ActorHireEvent struct {
+	*eventGeneric
+	ActorID, EmployerID     uuid.UUID
+	ActorName, EmployerName string
+	Wage                    int
+	HiredUntil              time.Time
+}
+
+func NewActorReleaseEvent(reason string, actorID, employerID, zoneID uuid.UUID, actorName, employerName string) *ActorReleaseEvent {
+	return &ActorReleaseEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorRelease,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		Reason:       reason,
+		ActorID:      actorID,
+		EmployerID:   employerID,
+		ActorName:    actorName,
+		EmployerName: employerName,
+	}
+}
+
+// ActorReleaseEvent records a hireling's contract ending, for the Reason
+// given by one of the HirelingReleaseReason* constants.
+type ActorReleaseEvent struct {
+	*eventGeneric
+	Reason                  string
+	ActorID, EmployerID     uuid.UUID
+	ActorName, EmployerName string
+}
+
+func NewActorRelationshipEvent(relationship string, actorID, otherID, zoneID uuid.UUID, otherName string) *ActorRelationshipEvent {
+	return &ActorRelationshipEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorRelationship,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		Relationship: relationship,
+		ActorID:      actorID,
+		OtherID:      otherID,
+		OtherName:    otherName,
+	}
+}
+
+// ActorRelationshipEvent records a hireling coming to regard another Actor
+// as a friend or enemy, or as neither, according to Relationship (one of the
+// HirelingRelationship* constants).
+type ActorRelationshipEvent struct {
+	*eventGeneric
+	Relationship     string
+	ActorID, OtherID uuid.UUID
+	OtherName        string
+}
+
+func NewActorOrderEvent(order HirelingOrder, actorID, employerID, zoneID uuid.UUID, actorName, employerName string) *ActorOrderEvent {
+	return &ActorOrderEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorOrder,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		Order:        order,
+		ActorID:      actorID,
+		EmployerID:   employerID,
+		ActorName:    actorName,
+		EmployerName: employerName,
+	}
+}
+
+// ActorOrderEvent records an employer giving its hireling an order. Any
+// changes to the hireling's friends and enemies which the order calls for
+// come just before it.
+type ActorOrderEvent struct {
+	*eventGeneric
+	Order                   HirelingOrder
+	ActorID, EmployerID     uuid.UUID
+	ActorName, EmployerName string
+}
@@ -32,10 +32,13 @@ type Location struct {
 	zone             *Zone
 	shortDescription string // e.g. "a house in the woods"
 	description      string // e.g. "A quaint house with blue shutters .... etc."
-	actors           ActorList
-	objects          ObjectList
-	outExits         ExitList
-	observers        ObserverList
+	// tags classify the Location for game systems, e.g. "lair" or "forest"
+	// to say where Actors may spawn
+	tags      []string
+	actors    ActorList
+	objects   ObjectList
+	outExits  ExitList
+	observers ObserverList
 }
 
 func (l Location) ID() uuid.UUID {
@@ -62,6 +65,27 @@ func (l Location) Description() string {
 	return l.description
 }
 
+// Tags returns the tags classifying the Location.
+func (l Location) Tags() []string {
+	out := make([]string, len(l.tags))
+	copy(out, l.tags)
+	return out
+}
+
+// HasTag reports whether the Location is tagged with the given tag.
+func (l Location) HasTag(tag string) bool {
+	for _, t := range l.tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Location) setTags(tags []string) {
+	l.tags = tags
+}
+
 func (l *Location) setDescription(s string) {
 	l.description = s
 }
@@ -179,6 +203,21 @@ func (l Location) Update(shortDesc, desc string) error {
 		l.id,
 		l.zone.ID(),
 	)
+	e.Tags = l.Tags()
+	cmd := newLocationUpdateCommand(e)
+	_, err := l.syncRequestToZone(cmd)
+	return err
+}
+
+// SetTags replaces the tags classifying the Location.
+func (l Location) SetTags(tags []string) error {
+	e := NewLocationUpdateEvent(
+		l.shortDescription,
+		l.description,
+		l.id,
+		l.zone.ID(),
+	)
+	e.Tags = tags
 	cmd := newLocationUpdateCommand(e)
 	_, err := l.syncRequestToZone(cmd)
 	return err
@@ -198,6 +237,7 @@ func (l Location) snapshot(sequenceNum uint64) Event {
 		l.id,
 		l.zone.ID(),
 	)
+	e.Tags = l.Tags()
 	e.SetSequenceNumber(sequenceNum)
 	return e
 }
@@ -241,6 +281,7 @@ func NewLocationAddToZoneEvent(shortDesc, desc string, locationId, zoneId uuid.U
 		locationId,
 		shortDesc,
 		desc,
+		nil,
 	}
 }
 
@@ -249,6 +290,7 @@ type LocationAddToZoneEvent struct {
 	LocationID uuid.UUID
 	ShortDesc  string
 	Desc       string
+	Tags       []string
 }
 
 func newLocationUpdateCommand(wrapped *LocationUpdateEvent) locationUpdateCommand {
@@ -275,6 +317,7 @@ func NewLocationUpdateEvent(shortDesc, desc string, locationID, zoneID uuid.UUID
 		locationID,
 		shortDesc,
 		desc,
+		nil,
 	}
 }
 
@@ -283,6 +326,7 @@ type LocationUpdateEvent struct {
 	LocationID uuid.UUID
 	ShortDesc  string
 	Desc       string
+	Tags       []string
 }
 
 func newLocationRemoveFromZoneCommand(wrapped *LocationRemoveFromZoneEvent) locationRemoveFromZoneCommand {
@@ -0,0 +1,577 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Prayers are requests made of a deity by its followers. See the "mysticism"
+// section of combatPlan.md.
+
+const (
+	PrayerEffectDamage = "damage"
+	PrayerEffectHeal   = "heal"
+	// mysticism "skill" never rises beyond this, however generous the follower
+	mysticismMax = 100.0
+)
+
+var (
+	// How much mysticism "skill" a follower gains from a single sacrifice.
+	MysticismSacrificeValue = 2.5
+	// How much mysticism "skill" a follower loses for each day without
+	// sacrifices.
+	MysticismDecayPerDay = 2.5
+	// ActorPrayDelay is the base delay following a prayer.
+	ActorPrayDelay = time.Millisecond * 1500
+)
+
+var (
+	ErrActorNotDedicated     = errors.New("Actor is not dedicated to a deity")
+	ErrActorAlreadyDedicated = errors.New("Actor is already dedicated to that deity")
+	ErrActorInsufficientZeal = errors.New("Actor lacks the zeal to make that prayer")
+	ErrPrayerUnknown         = errors.New("no such prayer")
+	ErrPrayerBeyondSkill     = errors.New("Actor's mysticism is too low for that prayer")
+	ErrPrayerUnanswered      = errors.New("deity is too weak to answer that prayer")
+)
+
+// Prayer describes something a deity may do at the request of a follower.
+type Prayer struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// SkillThreshold is the mysticism "skill" below which the prayer can't be
+	// made at all.
+	SkillThreshold float64 `yaml:"skillThreshold"`
+	ZealCost       int     `yaml:"zealCost"`
+	// StrengthCost is how much strength the deity loses by answering.
+	StrengthCost float64 `yaml:"strengthCost"`
+	// Effect is one of the PrayerEffect* constants.
+	Effect string `yaml:"effect"`
+	// MagnitudeMax is the magnitude of the effect at full efficacy, i.e. for a
+	// follower with 100 mysticism and 100 zeal.
+	MagnitudeMax float64 `yaml:"magnitudeMax"`
+}
+
+var (
+	prayersLock   = &sync.RWMutex{}
+	prayersByName = make(map[string]Prayer)
+)
+
+// SetPrayers replaces the set of prayers which deities will answer, typically
+// with definitions loaded from a data file at startup.
+func SetPrayers(prayers []Prayer) error {
+	byName := make(map[string]Prayer, len(prayers))
+	for _, p := range prayers {
+		if p.Name == "" {
+			return errors.New("prayer with empty name")
+		}
+		if _, duplicate := byName[p.Name]; duplicate {
+			return fmt.Errorf("duplicate prayer %q", p.Name)
+		}
+		switch p.Effect {
+		case PrayerEffectDamage, PrayerEffectHeal:
+		default:
+			return fmt.Errorf("prayer %q has unknown effect %q", p.Name, p.Effect)
+		}
+		byName[p.Name] = p
+	}
+
+	prayersLock.Lock()
+	defer prayersLock.Unlock()
+	prayersByName = byName
+	return nil
+}
+
+func PrayerByName(name string) (Prayer, bool) {
+	prayersLock.RLock()
+	defer prayersLock.RUnlock()
+	p, found := prayersByName[name]
+	return p, found
+}
+
+func Prayers() []Prayer {
+	prayersLock.RLock()
+	defer prayersLock.RUnlock()
+	out := make([]Prayer, 0, len(prayersByName))
+	for _, p := range prayersByName {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// prayerEfficacy returns how effective (0.0 - 1.0) prayers made by, or
+// against, an Actor are. Per combatPlan.md this scales 1:1 with mysticism
+// "skill" plus zeal, so 0 of each means no effect and no resistance, and 100
+// of each means full effect and immunity.
+func prayerEfficacy(mysticism float64, zeal int) float64 {
+	efficacy := (mysticism + float64(zeal)) / 200
+	return math.Max(0.0, math.Min(1.0, efficacy))
+}
+
+//////// Actor methods
+
+// DeityID returns the ID of the deity the Actor is dedicated to, or uuid.Nil.
+func (a *Actor) DeityID() uuid.UUID {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.deityID
+}
+
+func (a *Actor) MysticismAsOf() time.Time {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.mysticismAsOf
+}
+
+func (a *Actor) setDedication(deityID uuid.UUID, mysticism float64, asOf time.Time) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	a.deityID = deityID
+	a.skills.Mysticism = mysticism
+	a.mysticismAsOf = asOf
+}
+
+func (a *Actor) pantheon() *Pantheon {
+	z := a.Zone()
+	if z == nil || z.World() == nil {
+		return nil
+	}
+	return z.World().Pantheon()
+}
+
+// Deity returns the deity the Actor is dedicated to, or nil.
+func (a *Actor) Deity() *Deity {
+	p := a.pantheon()
+	if p == nil {
+		return nil
+	}
+	return p.DeityByID(a.DeityID())
+}
+
+// Mysticism returns the Actor's current mysticism "skill". This decays over
+// time since the Actor's last sacrifice, and is capped by the strength of the
+// Actor's deity.
+func (a *Actor) Mysticism() float64 {
+	deity := a.Deity()
+	if deity == nil {
+		return 0
+	}
+	return math.Min(a.decayedMysticism(time.Now()), deity.Strength())
+}
+
+func (a *Actor) decayedMysticism(now time.Time) float64 {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	days := now.Sub(a.mysticismAsOf).Hours() / 24
+	return math.Max(0, a.skills.Mysticism-(days*MysticismDecayPerDay))
+}
+
+// Dedicate makes the Actor a follower of the given deity. Any standing the
+// Actor had with a previous deity is lost.
+func (a *Actor) Dedicate(deity *Deity) error {
+	e := NewActorDedicateEvent(a.ID(), deity.ID(), a.Zone().ID(), a.Name(), deity.Name())
+	_, err := a.syncRequestToZone(newActorDedicateCommand(e))
+	return err
+}
+
+// Sacrifice offers up an Object to the Actor's deity, consuming it.
+func (a *Actor) Sacrifice(obj *Object) error {
+	deity := a.Deity()
+	if deity == nil {
+		return ErrActorNotDedicated
+	}
+	// the deity and the Object live in different aggregates, so the deity is
+	// credited first and the credit taken back if the Object can't be had
+	pantheon := a.pantheon()
+	err := pantheon.receiveSacrifice(deity, a, MysticismSacrificeValue)
+	if err != nil {
+		return err
+	}
+	_, err = a.syncRequestToZone(newActorSacrificeCommand(a, obj, deity))
+	if err != nil {
+		pantheon.reverseOrWarn(deity, a, -MysticismSacrificeValue)
+		return err
+	}
+	return nil
+}
+
+func (a *Actor) PrayDelay() time.Duration {
+	return ActionDelay(ActorPrayDelay, a.Attributes())
+}
+
+// Pray asks the Actor's deity to answer the named prayer on behalf of the
+// target.
+func (a *Actor) Pray(prayerName string, target *Actor) error {
+	deity := a.Deity()
+	if deity == nil {
+		return ErrActorNotDedicated
+	}
+	prayer, found := PrayerByName(prayerName)
+	if !found {
+		return ErrPrayerUnknown
+	}
+	return a.doDelayedAction(a.PrayDelay(), func() error {
+		// the deity's strength is spent first, so that it can't be spent
+		// twice over by prayers from other Zones, and refunded if the prayer
+		// then can't be carried out here
+		pantheon := a.pantheon()
+		err := pantheon.answerPrayer(deity, a, prayer)
+		if err != nil {
+			return err
+		}
+		_, err = a.syncRequestToZone(newActorPrayCommand(a, target, prayer, deity))
+		if err != nil {
+			pantheon.reverseOrWarn(deity, a, prayer.StrengthCost)
+			return err
+		}
+		return nil
+	})
+}
+
+//////// Zone-side processing
+
+func (z *Zone) processActorDedicateCommand(c Command) ([]Event, error) {
+	cmd := c.(actorDedicateCommand)
+	e := cmd.wrappedEvent
+
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	if actor.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if uuid.Equal(actor.DeityID(), e.DeityID) {
+		return nil, ErrActorAlreadyDedicated
+	}
+
+	return z.sequenceAndApplyEvents([]Event{e})
+}
+
+func (z *Zone) processActorSacrificeCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorSacrificeCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	_, found = z.objectsById[cmd.obj.ID()]
+	if !found {
+		return nil, errors.New("Object not in Zone")
+	}
+	if cmd.actor.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if !uuid.Equal(cmd.actor.DeityID(), cmd.deity.ID()) {
+		return nil, ErrActorNotDedicated
+	}
+	// offerings must be held, or lying at the Actor's feet
+	switch cmd.obj.Container() {
+	case cmd.actor:
+	case cmd.actor.Location():
+		if !cmd.obj.CanBeLootedBy(cmd.actor) {
+			return nil, ErrObjectLootRightsReserved
+		}
+	default:
+		return nil, errors.New("Object is out of reach")
+	}
+	if cmd.obj.lockedInTrade() {
+		return nil, ErrObjectLockedInTrade
+	}
+
+	now := time.Now()
+	mysticism := math.Min(cmd.actor.decayedMysticism(now)+MysticismSacrificeValue, mysticismMax)
+	sacrificeEv := NewActorSacrificeEvent(
+		cmd.actor.ID(),
+		cmd.obj.ID(),
+		cmd.deity.ID(),
+		z.id,
+		cmd.actor.Name(),
+		cmd.obj.Name(),
+		MysticismSacrificeValue,
+		mysticism,
+	)
+	sacrificeEv.SetTimestamp(now)
+
+	// whatever was inside the offering spills onto the ground
+	var outEvents []Event
+	loc := cmd.actor.Location()
+	for _, contained := range cmd.obj.Objects() {
+		relocEv := NewObjectAdminRelocateEvent(contained.ID(), z.ID())
+		relocEv.ToLocationContainerID = loc.ID()
+		outEvents = append(outEvents, relocEv)
+	}
+	outEvents = append(
+		outEvents,
+		sacrificeEv,
+		NewObjectRemoveFromZoneEvent(cmd.obj.Name(), cmd.obj.ID(), z.id),
+	)
+
+	return z.sequenceAndApplyEvents(outEvents)
+}
+
+func (z *Zone) processActorPrayCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorPrayCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	_, found = z.actorsById[cmd.target.ID()]
+	if !found || cmd.target.Zone() != z {
+		return nil, errors.New("target Actor not in Zone")
+	}
+	if cmd.actor.Location() != cmd.target.Location() {
+		return nil, errors.New("supplicant and target not in the same Location")
+	}
+	if cmd.actor.IsGhost() || cmd.target.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if !uuid.Equal(cmd.actor.DeityID(), cmd.deity.ID()) {
+		return nil, ErrActorNotDedicated
+	}
+
+	mysticism := cmd.actor.Mysticism()
+	if mysticism < cmd.prayer.SkillThreshold {
+		return nil, ErrPrayerBeyondSkill
+	}
+	// spending the last of one's zeal is fatal, so don't allow it
+	actorAttrs := cmd.actor.Attributes()
+	if cmd.prayer.ZealCost > 0 && actorAttrs.Zeal <= cmd.prayer.ZealCost {
+		return nil, ErrActorInsufficientZeal
+	}
+
+	magnitude := cmd.prayer.MagnitudeMax * prayerEfficacy(mysticism, actorAttrs.Zeal)
+	if cmd.prayer.Effect == PrayerEffectDamage && cmd.target != cmd.actor {
+		resistance := prayerEfficacy(cmd.target.Mysticism(), cmd.target.Attributes().Zeal)
+		magnitude *= 1.0 - resistance
+	}
+
+	prayEv := NewActorPrayEvent(
+		cmd.prayer.Name,
+		cmd.prayer.Effect,
+		cmd.actor.ID(),
+		cmd.target.ID(),
+		cmd.deity.ID(),
+		z.id,
+		cmd.actor.Name(),
+		cmd.target.Name(),
+		cmd.prayer.ZealCost,
+		int(math.Ceil(magnitude)),
+	)
+	outEvents := []Event{prayEv}
+	if cmd.prayer.Effect == PrayerEffectDamage && prayEv.Magnitude > 0 {
+		outEvents = append(outEvents, z.crimeEventsForAssault(cmd.actor, cmd.target)...)
+		if cmd.target.Attributes().Physical-prayEv.Magnitude <= 0 {
+			outEvents = append(outEvents, doActorDeath(cmd.target, cmd.actor, z)...)
+		} else if cmd.target != cmd.actor {
+			outEvents = append(outEvents, z.engageEventsFor(cmd.actor, cmd.target)...)
+		}
+	}
+
+	return z.sequenceAndApplyEvents(outEvents)
+}
+
+func (z *Zone) applyActorDedicateEvent(e *ActorDedicateEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q to dedicate", e.ActorID)
+	}
+	beforeAttrs, beforeSkills := actor.Attributes(), actor.Skills()
+	beforeSkills.Mysticism = actor.decayedMysticism(e.Timestamp())
+	actor.setDedication(e.DeityID, 0, e.Timestamp())
+	actor.recordProgressionSince(e.Timestamp(), ProgressionReasonDedication, "", beforeAttrs, beforeSkills)
+	return actor.Location().Observers(), nil
+}
+
+func (z *Zone) applyActorSacrificeEvent(e *ActorSacrificeEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q making sacrifice", e.ActorID)
+	}
+	// mysticism decays between sacrifices, so it's what's left of it that
+	// the sacrifice raises
+	beforeAttrs, beforeSkills := actor.Attributes(), actor.Skills()
+	beforeSkills.Mysticism = actor.decayedMysticism(e.Timestamp())
+	actor.setDedication(e.DeityID, e.Mysticism, e.Timestamp())
+	actor.recordProgressionSince(e.Timestamp(), ProgressionReasonSacrifice, e.ObjectName, beforeAttrs, beforeSkills)
+	return actor.Location().Observers(), nil
+}
+
+func (z *Zone) applyActorPrayEvent(e *ActorPrayEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find praying Actor %q", e.ActorID)
+	}
+	target, found := z.actorsById[e.TargetID]
+	if !found {
+		return nil, fmt.Errorf("cannot find target Actor %q", e.TargetID)
+	}
+
+	actorAttrs := actor.Attributes()
+	actorAttrs.Zeal -= e.ZealCost
+	actor.setAttributes(actorAttrs)
+
+	targetAttrs := target.Attributes()
+	switch e.Effect {
+	case PrayerEffectDamage:
+		targetAttrs.Physical -= e.Magnitude
+	case PrayerEffectHeal:
+		targetAttrs.Physical += e.Magnitude
+		maxPhys := respawnAttributes(targetAttrs).Physical
+		if targetAttrs.Physical > maxPhys {
+			targetAttrs.Physical = maxPhys
+		}
+	}
+	target.setAttributes(targetAttrs)
+
+	dedupeObserverMap := make(map[Observer]struct{})
+	for _, o := range actor.Observers() {
+		dedupeObserverMap[o] = struct{}{}
+	}
+	for _, o := range target.Observers() {
+		dedupeObserverMap[o] = struct{}{}
+	}
+	for _, o := range target.Location().Observers() {
+		dedupeObserverMap[o] = struct{}{}
+	}
+
+	oList := make(ObserverList, 0, len(dedupeObserverMap))
+	for o := range dedupeObserverMap {
+		oList = append(oList, o)
+	}
+	return oList, nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newActorDedicateCommand(wrapped *ActorDedicateEvent) actorDedicateCommand {
+	return actorDedicateCommand{
+		commandGeneric{commandType: CommandTypeActorDedicate},
+		wrapped,
+	}
+}
+
+type actorDedicateCommand struct {
+	commandGeneric
+	wrappedEvent *ActorDedicateEvent
+}
+
+func newActorSacrificeCommand(actor *Actor, obj *Object, deity *Deity) *actorSacrificeCommand {
+	return &actorSacrificeCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorSacrifice},
+		actor:          actor,
+		obj:            obj,
+		deity:          deity,
+	}
+}
+
+type actorSacrificeCommand struct {
+	commandGeneric
+	actor *Actor
+	obj   *Object
+	deity *Deity
+}
+
+func newActorPrayCommand(actor, target *Actor, prayer Prayer, deity *Deity) *actorPrayCommand {
+	return &actorPrayCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorPray},
+		actor:          actor,
+		target:         target,
+		prayer:         prayer,
+		deity:          deity,
+	}
+}
+
+type actorPrayCommand struct {
+	commandGeneric
+	actor, target *Actor
+	prayer        Prayer
+	deity         *Deity
+}
+
+func NewActorDedicateEvent(actorID, deityID, zoneID uuid.UUID, actorName, deityName string) *ActorDedicateEvent {
+	return &ActorDedicateEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorDedicate,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		DeityID:   deityID,
+		ActorName: actorName,
+		DeityName: deityName,
+	}
+}
+
+type ActorDedicateEvent struct {
+	*eventGeneric
+	ActorID, DeityID     uuid.UUID
+	ActorName, DeityName string
+}
+
+func NewActorSacrificeEvent(actorID, objectID, deityID, zoneID uuid.UUID, actorName, objectName string, value, mysticism float64) *ActorSacrificeEvent {
+	return &ActorSacrificeEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorSacrifice,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:    actorID,
+		ObjectID:   objectID,
+		DeityID:    deityID,
+		ActorName:  actorName,
+		ObjectName: objectName,
+		Value:      value,
+		Mysticism:  mysticism,
+	}
+}
+
+type ActorSacrificeEvent struct {
+	*eventGeneric
+	ActorID, ObjectID, DeityID uuid.UUID
+	ActorName, ObjectName      string
+	Value                      float64
+	// Mysticism is the Actor's mysticism "skill" immediately after the
+	// sacrifice, before it starts to decay again.
+	Mysticism float64
+}
+
+func NewActorPrayEvent(prayerName, effect string, actorID, targetID, deityID, zoneID uuid.UUID, actorName, targetName string, zealCost, magnitude int) *ActorPrayEvent {
+	return &ActorPrayEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorPray,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		PrayerName: prayerName,
+		Effect:     effect,
+		ActorID:    actorID,
+		TargetID:   targetID,
+		DeityID:    deityID,
+		ActorName:  actorName,
+		TargetName: targetName,
+		ZealCost:   zealCost,
+		Magnitude:  magnitude,
+	}
+}
+
+type ActorPrayEvent struct {
+	*eventGeneric
+	PrayerName, Effect         string
+	ActorID, TargetID, DeityID uuid.UUID
+	ActorName, TargetName      string
+	ZealCost, Magnitude        int
+}
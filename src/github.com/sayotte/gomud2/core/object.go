@@ -29,18 +29,30 @@ func NewObject(id uuid.UUID, name, desc string, keywords []string, container Con
 }
 
 type Object struct {
-	id             uuid.UUID
-	name           string
-	description    string
-	inventorySlots int // FIXME this is a dupe of containerCapacity, and neither is currently used in all places
-	keywords       []string
-	container      Container
-	zone           *Zone
-
+	id          uuid.UUID
+	name        string
+	description string
+	keywords    []string
+	container   Container
+	zone        *Zone
+
+	// containerCapacity is how many inventory slots' worth of Objects this
+	// Object can contain
 	containerCapacity int
 	containedObjects  ObjectList
+	closed, locked    bool
 
 	attributes ObjectAttributes
+	ownership  ObjectOwnership
+	// prototypeID identifies the ObjectPrototype this Object was made from,
+	// if any
+	prototypeID uuid.UUID
+
+	decayAt            time.Time
+	lootRightsActorIDs []uuid.UUID
+	lootRightsUntil    time.Time
+	// corpseOfID identifies the Actor this Object is the corpse of, if any
+	corpseOfID uuid.UUID
 }
 
 func (o Object) ID() uuid.UUID {
@@ -55,8 +67,10 @@ func (o Object) Description() string {
 	return o.description
 }
 
+// InventorySlots returns how many inventory slots the Object takes up, e.g.
+// ObjectSizeMediumSlots for a sword.
 func (o Object) InventorySlots() int {
-	return o.inventorySlots
+	return o.attributes.InventorySlots
 }
 
 func (o Object) Keywords() []string {
@@ -189,6 +203,14 @@ func (o *Object) snapshot(sequenceNum uint64) Event {
 		o.container.SubcontainerFor(o),
 		o.attributes,
 	)
+	e.DecayAt = o.decayAt
+	e.LootRightsActorIDs = o.LootRightsActorIDs()
+	e.LootRightsUntil = o.lootRightsUntil
+	e.CorpseOfID = o.corpseOfID
+	e.Closed = o.closed
+	e.Locked = o.locked
+	e.Ownership = o.Ownership()
+	e.PrototypeID = o.prototypeID
 	switch o.container.(type) {
 	case *Location:
 		e.LocationContainerID = o.container.ID()
@@ -273,6 +295,13 @@ type ObjectAddToZoneEvent struct {
 	Subcontainer                                             string
 	Capacity                                                 int
 	Attributes                                               ObjectAttributes
+	DecayAt                                                  time.Time
+	LootRightsActorIDs                                       []uuid.UUID
+	LootRightsUntil                                          time.Time
+	CorpseOfID                                               uuid.UUID
+	Closed, Locked                                           bool
+	Ownership                                                ObjectOwnership
+	PrototypeID                                              uuid.UUID
 }
 
 func newObjectRemoveFromZoneCommand(wrapped *ObjectRemoveFromZoneEvent) objectRemoveFromZoneCommand {
@@ -455,6 +484,13 @@ type ObjectMigrateInEvent struct {
 	Subcontainer                                             string
 	Capacity                                                 int
 	Attributes                                               ObjectAttributes
+	DecayAt                                                  time.Time
+	LootRightsActorIDs                                       []uuid.UUID
+	LootRightsUntil                                          time.Time
+	CorpseOfID                                               uuid.UUID
+	Closed, Locked                                           bool
+	Ownership                                                ObjectOwnership
+	PrototypeID                                              uuid.UUID
 }
 
 func NewObjectMigrateOutEvent(name string, objID, toZoneID, zoneID uuid.UUID) *ObjectMigrateOutEvent {
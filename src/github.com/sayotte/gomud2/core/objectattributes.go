@@ -7,4 +7,34 @@ type ObjectAttributes struct {
 	StabbingDamageMax float64
 	BashingDamageMin  float64
 	BashingDamageMax  float64
+	// ScrollReaction names the sorcerous Reaction taught by reading this
+	// Object, if any.
+	ScrollReaction string
+	// ScrollTechnique names the technique (e.g. TechniqueDodging) taught by
+	// reading this Object, if any, and ScrollTechniqueLevel which of those
+	// techniques it is. Techniques must be learned in order.
+	ScrollTechnique      string
+	ScrollTechniqueLevel int
+	// BlankScroll marks an Object which a scroll can be scribed onto.
+	BlankScroll bool
+	// Weight counts against the carry limit of an Actor holding this Object.
+	Weight float64
+	// InventorySlots is how much room this Object takes up in an inventory or
+	// container, usually one of the ObjectSize*Slots constants.
+	InventorySlots int
+	// Closeable marks a container which can be opened and closed. If
+	// KeyKeyword is also set, it can be locked by anyone holding an Object
+	// with that keyword.
+	Closeable  bool
+	KeyKeyword string
+	// Value is what the Object is worth, in coins.
+	Value int
+	// Coins marks a stack of currency, holding this many coins.
+	Coins int
+	// ConsumeMethod marks food, drink or a potion, one of the ConsumeMethod*
+	// constants saying how it's consumed. It can be consumed Uses times (just
+	// once if Uses is zero), and each time has the given Effects.
+	ConsumeMethod string
+	Uses          int
+	Effects       []ConsumableEffect
 }
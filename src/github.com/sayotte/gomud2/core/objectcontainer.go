@@ -0,0 +1,306 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Objects with a capacity are containers, which can hold as many inventory
+// slots' worth of other Objects as their capacity allows. Containers marked
+// Closeable in their attributes can be opened and closed like the doors on an
+// Exit, and locked if they name a key; nothing can be put into or taken out of
+// a closed container, or anything inside it.
+
+var (
+	ErrObjectNotContainer     = errors.New("Object is not a container")
+	ErrContainerNotCloseable  = errors.New("container cannot be opened or closed")
+	ErrContainerNoLock        = errors.New("container has no lock")
+	ErrContainerClosed        = errors.New("container is closed")
+	ErrContainerLocked        = errors.New("container is locked")
+	ErrContainerAlreadyOpen   = errors.New("container is already open")
+	ErrContainerAlreadyClosed = errors.New("container is already closed")
+	ErrContainerAlreadyLocked = errors.New("container is already locked")
+	ErrContainerNotLocked     = errors.New("container is not locked")
+	ErrContainerNotClosed     = errors.New("container must be closed before it can be locked")
+	ErrContainerFull          = errors.New("Object will not fit in that container")
+	ErrContainerInsideItself  = errors.New("cannot put a container inside itself")
+	ErrContainerOutOfReach    = errors.New("container is out of reach")
+)
+
+//////// Object methods
+
+func (o *Object) IsContainer() bool {
+	return o.containerCapacity > 0
+}
+
+func (o *Object) IsCloseable() bool {
+	return o.attributes.Closeable
+}
+
+func (o *Object) IsClosed() bool {
+	return o.closed
+}
+
+func (o *Object) IsLocked() bool {
+	return o.locked
+}
+
+// IsLockable reports whether the container has a lock, i.e. whether it can be
+// closed and names a key.
+func (o *Object) IsLockable() bool {
+	return o.attributes.Closeable && o.attributes.KeyKeyword != ""
+}
+
+// IsKey reports whether the given Object opens this container's lock.
+func (o *Object) IsKey(key *Object) bool {
+	if !o.IsLockable() {
+		return false
+	}
+	for _, kw := range key.Keywords() {
+		if kw == o.attributes.KeyKeyword {
+			return true
+		}
+	}
+	return false
+}
+
+// SlotsUsed returns how many of the container's inventory slots are taken up
+// by the Objects directly inside it.
+func (o *Object) SlotsUsed() int {
+	var used int
+	for _, contained := range o.containedObjects {
+		used += contained.InventorySlots()
+	}
+	return used
+}
+
+// checkFits returns an error if the given Object can't be put inside this one.
+func (o *Object) checkFits(obj *Object) error {
+	if obj == o || obj.containsRecursive(o) {
+		return ErrContainerInsideItself
+	}
+	if !o.IsContainer() {
+		return ErrObjectNotContainer
+	}
+	if o.SlotsUsed()+obj.InventorySlots() > o.containerCapacity {
+		return ErrContainerFull
+	}
+	return nil
+}
+
+// containsRecursive reports whether the given Object is inside this one, or
+// inside anything inside it.
+func (o *Object) containsRecursive(other *Object) bool {
+	for _, contained := range o.containedObjects {
+		if contained == other || contained.containsRecursive(other) {
+			return true
+		}
+	}
+	return false
+}
+
+// sealedOff reports whether the Container is closed, or is inside a closed
+// container.
+func sealedOff(c Container) bool {
+	for {
+		obj, ok := c.(*Object)
+		if !ok {
+			return false
+		}
+		if obj.closed {
+			return true
+		}
+		c = obj.container
+	}
+}
+
+// holder returns the Container at the outside of any containers the Object
+// is inside, i.e. the Actor or Location it's ultimately in.
+func (o *Object) holder() Container {
+	var c Container = o
+	for {
+		obj, ok := c.(*Object)
+		if !ok {
+			return c
+		}
+		c = obj.container
+	}
+}
+
+// WithinReachOf reports whether the Actor can get at the Object: it must be on
+// the ground where the Actor is or in the Actor's inventory, possibly inside
+// other containers so long as none of them is closed.
+func (o *Object) WithinReachOf(a *Actor) bool {
+	switch holder := o.holder().(type) {
+	case *Location:
+		if holder != a.Location() {
+			return false
+		}
+	case *Actor:
+		if holder != a {
+			return false
+		}
+	}
+	return !sealedOff(o.container)
+}
+
+//////// Actor methods
+
+func (a *Actor) OpenContainer(obj *Object) error {
+	return a.containerAction(obj, ExitDoorActionOpen)
+}
+
+func (a *Actor) CloseContainer(obj *Object) error {
+	return a.containerAction(obj, ExitDoorActionClose)
+}
+
+// LockContainer locks the container, which must be closed, using a key the
+// Actor is carrying.
+func (a *Actor) LockContainer(obj *Object) error {
+	return a.containerAction(obj, ExitDoorActionLock)
+}
+
+// UnlockContainer unlocks the container using a key the Actor is carrying.
+func (a *Actor) UnlockContainer(obj *Object) error {
+	return a.containerAction(obj, ExitDoorActionUnlock)
+}
+
+func (a *Actor) containerAction(obj *Object, action string) error {
+	_, err := a.syncRequestToZone(newActorContainerActionCommand(a, obj, action))
+	return err
+}
+
+//////// Zone-side processing
+
+func (z *Zone) processActorContainerActionCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorContainerActionCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	_, found = z.objectsById[cmd.obj.ID()]
+	if !found {
+		return nil, fmt.Errorf("unknown Object %q", cmd.obj.ID())
+	}
+	if !cmd.obj.WithinReachOf(cmd.actor) {
+		return nil, ErrContainerOutOfReach
+	}
+	if cmd.actor.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if !cmd.obj.IsCloseable() {
+		return nil, ErrContainerNotCloseable
+	}
+
+	switch cmd.action {
+	case ExitDoorActionOpen:
+		if cmd.obj.IsLocked() {
+			return nil, ErrContainerLocked
+		}
+		if !cmd.obj.IsClosed() {
+			return nil, ErrContainerAlreadyOpen
+		}
+	case ExitDoorActionClose:
+		if cmd.obj.IsClosed() {
+			return nil, ErrContainerAlreadyClosed
+		}
+	case ExitDoorActionLock, ExitDoorActionUnlock:
+		if !cmd.obj.IsLockable() {
+			return nil, ErrContainerNoLock
+		}
+		if cmd.action == ExitDoorActionLock && !cmd.obj.IsClosed() {
+			return nil, ErrContainerNotClosed
+		}
+		if cmd.action == ExitDoorActionLock && cmd.obj.IsLocked() {
+			return nil, ErrContainerAlreadyLocked
+		}
+		if cmd.action == ExitDoorActionUnlock && !cmd.obj.IsLocked() {
+			return nil, ErrContainerNotLocked
+		}
+		var hasKey bool
+		for _, key := range cmd.actor.Inventory().Objects() {
+			if cmd.obj.IsKey(key) {
+				hasKey = true
+				break
+			}
+		}
+		if !hasKey {
+			return nil, ErrActorHasNoKey
+		}
+	default:
+		return nil, fmt.Errorf("unknown container action %q", cmd.action)
+	}
+
+	return z.sequenceAndApplyEvents([]Event{
+		NewObjectContainerActionEvent(cmd.action, cmd.obj.ID(), cmd.actor.ID(), z.id, cmd.obj.Name(), cmd.actor.Name()),
+	})
+}
+
+func (z *Zone) applyObjectContainerActionEvent(e *ObjectContainerActionEvent) (ObserverList, error) {
+	obj, found := z.objectsById[e.ObjectID]
+	if !found {
+		return nil, fmt.Errorf("unknown Object %q", e.ObjectID)
+	}
+	switch e.Action {
+	case ExitDoorActionOpen:
+		obj.closed = false
+	case ExitDoorActionClose:
+		obj.closed = true
+	case ExitDoorActionLock:
+		obj.locked = true
+	case ExitDoorActionUnlock:
+		obj.locked = false
+	default:
+		return nil, fmt.Errorf("unknown container action %q", e.Action)
+	}
+	return obj.Location().Observers(), nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newActorContainerActionCommand(actor *Actor, obj *Object, action string) *actorContainerActionCommand {
+	return &actorContainerActionCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorContainerAction},
+		actor:          actor,
+		obj:            obj,
+		action:         action,
+	}
+}
+
+type actorContainerActionCommand struct {
+	commandGeneric
+	actor  *Actor
+	obj    *Object
+	action string
+}
+
+func NewObjectContainerActionEvent(action string, objectID, actorID, zoneID uuid.UUID, objectName, actorName string) *ObjectContainerActionEvent {
+	return &ObjectContainerActionEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeObjectContainerAction,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		Action:     action,
+		ObjectID:   objectID,
+		ActorID:    actorID,
+		ObjectName: objectName,
+		ActorName:  actorName,
+	}
+}
+
+// ObjectContainerActionEvent records an Actor opening, closing, locking or
+// unlocking a container, according to Action (one of the ExitDoorAction*
+// constants, which containers share with doors).
+type ObjectContainerActionEvent struct {
+	*eventGeneric
+	Action                string
+	ObjectID, ActorID     uuid.UUID
+	ObjectName, ActorName string
+}
@@ -0,0 +1,276 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Objects can belong to an Actor. An unowned Object becomes the property of
+// whoever first picks it up, and ownership changes hands when the owner gives
+// it away, trades it or sells it. Anyone else who takes an owned Object, say
+// after its owner dropped it, doesn't become its owner; instead the Object is
+// flagged as stolen until it finds its way back to its owner, and where the
+// Zone is lawful (and its owner is there to be protected) the taking is a
+// theft. Each change is recorded in the Object's provenance, so shopkeepers,
+// guards and players can all see where something came from.
+//
+// Ownership is changed only by ObjectOwnershipEvents, so like everything else
+// about an Object it's rebuilt by replaying the Zone's event log.
+
+const (
+	OwnershipReasonClaimed   = "claimed"
+	OwnershipReasonGiven     = "given"
+	OwnershipReasonTraded    = "traded"
+	OwnershipReasonPurchased = "purchased"
+	OwnershipReasonLooted    = "looted"
+	OwnershipReasonStolen    = "stolen"
+	OwnershipReasonRecovered = "recovered"
+	OwnershipReasonCrafted   = "crafted"
+)
+
+// ProvenanceRecord is one entry in an Object's history of ownership: who
+// owned it afterward, why, and who did the taking or the handing over.
+type ProvenanceRecord struct {
+	Reason    string
+	OwnerID   uuid.UUID
+	OwnerName string
+	ActorID   uuid.UUID
+	ActorName string
+	At        time.Time
+}
+
+// ObjectOwnership is who an Object belongs to, whether it's currently stolen
+// from them, and how it came to be that way.
+type ObjectOwnership struct {
+	OwnerID    uuid.UUID
+	OwnerName  string
+	Stolen     bool
+	Provenance []ProvenanceRecord
+}
+
+func (oo ObjectOwnership) copy() ObjectOwnership {
+	out := oo
+	out.Provenance = make([]ProvenanceRecord, len(oo.Provenance))
+	copy(out.Provenance, oo.Provenance)
+	return out
+}
+
+func (oo ObjectOwnership) isOwned() bool {
+	return !uuid.Equal(oo.OwnerID, uuid.Nil)
+}
+
+//////// Object getters
+
+// Owner returns the ID and name of the Actor the Object belongs to, or
+// uuid.Nil if it has no owner.
+func (o *Object) Owner() (uuid.UUID, string) {
+	return o.ownership.OwnerID, o.ownership.OwnerName
+}
+
+// IsOwnedBy reports whether the Object belongs to the given Actor.
+func (o *Object) IsOwnedBy(a *Actor) bool {
+	return a != nil && uuid.Equal(o.ownership.OwnerID, a.ID())
+}
+
+// IsStolen reports whether someone other than the Object's owner took it, and
+// it hasn't since found its way back.
+func (o *Object) IsStolen() bool {
+	return o.ownership.Stolen
+}
+
+// Provenance returns the Object's history of ownership, oldest first.
+func (o *Object) Provenance() []ProvenanceRecord {
+	return o.Ownership().Provenance
+}
+
+// Ownership returns everything known about who the Object belongs to.
+func (o *Object) Ownership() ObjectOwnership {
+	return o.ownership.copy()
+}
+
+//////// Zone-side processing
+
+// ownershipEventsForTake returns the events recording an Actor taking the
+// Object, and everything in it, from the given Container: claiming whatever
+// is unowned, recovering whatever was stolen from it, and stealing everything
+// else, unless it holds loot rights on the corpse it's taking from. What the
+// dead owned is there for the looting once those rights lapse, so it's never
+// stolen from their corpse. If the stealing is a crime, that's recorded too.
+func (z *Zone) ownershipEventsForTake(obj *Object, from Container, taker *Actor) []Event {
+	if taker == nil || heldBy(from, taker) {
+		// rearranging one's own inventory changes nothing
+		return nil
+	}
+	looting := false
+	if fromObj, ok := from.(*Object); ok && len(fromObj.lootRightsActorIDs) > 0 {
+		looting = fromObj.CanBeLootedBy(taker) && time.Now().Before(fromObj.lootRightsUntil)
+	}
+	corpse := corpseHolding(from)
+
+	var events []Event
+	var victims []uuid.UUID
+	stolenFrom := make(map[uuid.UUID]*Object)
+	for _, o := range append([]*Object{obj}, objectsWithin(obj)...) {
+		if o.IsCurrency() {
+			continue
+		}
+		ownership := o.ownership
+		var reason string
+		switch {
+		case !ownership.isOwned():
+			reason = OwnershipReasonClaimed
+		case o.IsOwnedBy(taker):
+			if !ownership.Stolen {
+				continue
+			}
+			reason = OwnershipReasonRecovered
+		case corpse != nil && !ownership.Stolen && uuid.Equal(ownership.OwnerID, corpse.corpseOfID):
+			reason = OwnershipReasonLooted
+		case looting:
+			reason = OwnershipReasonLooted
+		case ownership.Stolen:
+			// already stolen; it's no more so for changing hands again
+			continue
+		default:
+			if _, found := stolenFrom[ownership.OwnerID]; !found {
+				victims = append(victims, ownership.OwnerID)
+				stolenFrom[ownership.OwnerID] = o
+			}
+			events = append(events, z.ownershipEvent(o, OwnershipReasonStolen, ownership.OwnerID, ownership.OwnerName, taker))
+			continue
+		}
+		events = append(events, z.ownershipEvent(o, reason, taker.ID(), taker.Name(), taker))
+	}
+
+	// the law can only judge what was done to an owner it knows
+	for _, victimID := range victims {
+		victim, found := z.actorsById[victimID]
+		if !found || !z.isCrime(taker, victim) {
+			continue
+		}
+		events = append(events, z.crimeEvent(CrimeTypeTheft, taker, victim.ID(), victim.Name(), stolenFrom[victimID].ID()))
+	}
+	return events
+}
+
+// corpseHolding returns the corpse the Container is, or is somewhere inside,
+// if any.
+func corpseHolding(c Container) *Object {
+	for {
+		obj, ok := c.(*Object)
+		if !ok || obj == nil {
+			return nil
+		}
+		if !uuid.Equal(obj.corpseOfID, uuid.Nil) {
+			return obj
+		}
+		c = obj.Container()
+	}
+}
+
+// ownershipEventsForTransfer returns the events recording the Object, and
+// everything in it, passing from one Actor to another for the given reason.
+// Only what the giver owns (or what nobody owns) changes owner; handing over
+// something stolen doesn't make it any less so, unless it's handed back to
+// its owner.
+func (z *Zone) ownershipEventsForTransfer(obj *Object, from, to *Actor, reason string) []Event {
+	var events []Event
+	for _, o := range append([]*Object{obj}, objectsWithin(obj)...) {
+		if o.IsCurrency() {
+			continue
+		}
+		switch {
+		case o.ownership.Stolen && o.IsOwnedBy(to):
+			events = append(events, z.ownershipEvent(o, OwnershipReasonRecovered, to.ID(), to.Name(), to))
+		case !o.ownership.isOwned() || (o.IsOwnedBy(from) && !o.ownership.Stolen):
+			events = append(events, z.ownershipEvent(o, reason, to.ID(), to.Name(), from))
+		}
+	}
+	return events
+}
+
+func (z *Zone) ownershipEvent(obj *Object, reason string, ownerID uuid.UUID, ownerName string, actor *Actor) *ObjectOwnershipEvent {
+	return NewObjectOwnershipEvent(
+		obj.ID(),
+		z.id,
+		obj.Name(),
+		ProvenanceRecord{
+			Reason:    reason,
+			OwnerID:   ownerID,
+			OwnerName: ownerName,
+			ActorID:   actor.ID(),
+			ActorName: actor.Name(),
+			At:        time.Now(),
+		},
+	)
+}
+
+// objectsWithin returns everything inside the Object, however deeply nested.
+func objectsWithin(obj *Object) []*Object {
+	var out []*Object
+	for _, tuple := range getObjectContainerTuplesRecursive(obj) {
+		out = append(out, tuple.obj)
+	}
+	return out
+}
+
+// heldBy reports whether the Container is the Actor, or is somewhere in its
+// inventory.
+func heldBy(c Container, a *Actor) bool {
+	for c != nil {
+		if c == Container(a) {
+			return true
+		}
+		obj, ok := c.(*Object)
+		if !ok {
+			return false
+		}
+		c = obj.Container()
+	}
+	return false
+}
+
+func (z *Zone) applyObjectOwnershipEvent(e *ObjectOwnershipEvent) (ObserverList, error) {
+	obj, found := z.objectsById[e.ObjectID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Object %q to change ownership of", e.ObjectID)
+	}
+
+	switch e.Record.Reason {
+	case OwnershipReasonStolen:
+		obj.ownership.Stolen = true
+	default:
+		obj.ownership.OwnerID = e.Record.OwnerID
+		obj.ownership.OwnerName = e.Record.OwnerName
+		obj.ownership.Stolen = false
+	}
+	obj.ownership.Provenance = append(obj.ownership.Provenance, e.Record)
+
+	return obj.Location().Observers(), nil
+}
+
+//////// Events
+
+func NewObjectOwnershipEvent(objectID, zoneID uuid.UUID, objectName string, record ProvenanceRecord) *ObjectOwnershipEvent {
+	return &ObjectOwnershipEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeObjectOwnership,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ObjectID:   objectID,
+		ObjectName: objectName,
+		Record:     record,
+	}
+}
+
+type ObjectOwnershipEvent struct {
+	*eventGeneric
+	ObjectID   uuid.UUID
+	ObjectName string
+	Record     ProvenanceRecord
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+func TestOwnershipEventsForTake_npcCorpse(t *testing.T) {
+	oldGracePeriod := CorpseLootRightsGracePeriod
+	defer func() { CorpseLootRightsGracePeriod = oldGracePeriod }()
+	// the window has always already closed by the time anything is taken
+	CorpseLootRightsGracePeriod = -time.Second
+
+	testCases := map[string]struct {
+		engaged bool
+	}{
+		"corpse with no killer":        {engaged: false},
+		"corpse of whoever was fought": {engaged: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			zone := NewZone(uuid.Nil, "test", nil)
+			zone.StartCommandProcessing()
+			defer zone.StopCommandProcessing()
+			loc, err := zone.AddLocation(NewLocation(uuid.Nil, zone, "a room", "an empty room"))
+			if err != nil {
+				t.Fatalf("Zone.AddLocation(): %s", err)
+			}
+			attrs := AttributeSet{Strength: 50, Physical: 100, Stamina: 100, Focus: 100}
+			npc, err := zone.AddActor(NewActor(uuid.Nil, "a bandit", "", loc, zone, attrs, Skillset{}, DefaultHumanInventoryConstraints))
+			if err != nil {
+				t.Fatalf("Zone.AddActor(): %s", err)
+			}
+			taker, err := zone.AddActor(NewActor(uuid.Nil, "bob", PlayerParkingBrainType, loc, zone, attrs, Skillset{}, DefaultHumanInventoryConstraints))
+			if err != nil {
+				t.Fatalf("Zone.AddActor(): %s", err)
+			}
+
+			// gear an NPC spawns with belongs to it, as with ActorPrototype.Equip
+			swordProto := NewObject(uuid.Nil, "a sword", "a sword", []string{"sword"}, loc, 0, zone, ObjectAttributes{Weight: 1, InventorySlots: ObjectSizeMediumSlots, Value: 10})
+			sword, err := zone.AddObject(swordProto, loc)
+			if err != nil {
+				t.Fatalf("Zone.AddObject(): %s", err)
+			}
+			err = sword.Move(loc, npc, npc, ContainerDefaultSubcontainer)
+			if err != nil {
+				t.Fatalf("Object.Move(): %s", err)
+			}
+			if !sword.IsOwnedBy(npc) {
+				t.Fatal("expected the NPC to own its gear")
+			}
+
+			if tc.engaged {
+				err = taker.Engage(npc)
+				if err != nil {
+					t.Fatalf("Actor.Engage(): %s", err)
+				}
+			}
+			err = npc.Die()
+			if err != nil {
+				t.Fatalf("Actor.Die(): %s", err)
+			}
+			corpse, ok := sword.Container().(*Object)
+			if !ok || !uuid.Equal(corpse.CorpseOf(), npc.ID()) {
+				t.Fatal("expected the sword to be in the NPC's corpse")
+			}
+
+			err = sword.Move(corpse, taker, taker, ContainerDefaultSubcontainer)
+			if err != nil {
+				t.Fatalf("Object.Move(): %s", err)
+			}
+			if sword.IsStolen() {
+				t.Error("expected the sword not to be stolen")
+			}
+			if !sword.IsOwnedBy(taker) {
+				t.Error("expected the sword to belong to whoever looted it")
+			}
+			provenance := sword.Provenance()
+			last := provenance[len(provenance)-1]
+			if last.Reason != OwnershipReasonLooted {
+				t.Errorf("expected reason %q, got %q", OwnershipReasonLooted, last.Reason)
+			}
+			if len(zone.Crimes()) != 0 {
+				t.Errorf("expected no crimes, got %+v", zone.Crimes())
+			}
+		})
+	}
+}
@@ -0,0 +1,526 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/rpc"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+// Deities are shared by every Zone in the World, so rather than belonging to
+// any one Zone they live in a Pantheon, which is an event-sourced aggregate in
+// its own right.
+
+const (
+	// DeityStrengthMax is the strength at which a deity can grant its
+	// followers the full measure of their mysticism.
+	DeityStrengthMax = 100.0
+	// Used to set the capacity of the channel to which commands are sent.
+	pantheonRequestChannelCapacity = 4
+)
+
+var (
+	ErrNoPantheon   = errors.New("World has no Pantheon")
+	ErrDeityUnknown = errors.New("no such deity")
+)
+
+func NewPantheon(id uuid.UUID, persister EventPersister) *Pantheon {
+	newID := id
+	if uuid.Equal(id, uuid.Nil) {
+		newID = myuuid.NewId()
+	}
+	return &Pantheon{
+		id:          newID,
+		deitiesByID: make(map[uuid.UUID]*Deity),
+		persister:   persister,
+	}
+}
+
+type Pantheon struct {
+	id             uuid.UUID
+	nextSequenceId uint64
+	deitiesByID    map[uuid.UUID]*Deity
+
+	privateRequestChan chan rpc.Request
+	stopChan           chan struct{}
+	stopWG             *sync.WaitGroup
+	persister          EventPersister
+}
+
+//////// getters + non-command-setters
+
+func (p *Pantheon) ID() uuid.UUID {
+	return p.id
+}
+
+func (p *Pantheon) setPersister(ep EventPersister) {
+	p.persister = ep
+}
+
+func (p *Pantheon) Deities() []*Deity {
+	out := make([]*Deity, 0, len(p.deitiesByID))
+	for _, d := range p.deitiesByID {
+		out = append(out, d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name() < out[j].Name() })
+	return out
+}
+
+func (p *Pantheon) DeityByID(id uuid.UUID) *Deity {
+	return p.deitiesByID[id]
+}
+
+// DeityByName finds a deity by case-insensitive name.
+func (p *Pantheon) DeityByName(name string) *Deity {
+	for _, d := range p.deitiesByID {
+		if strings.EqualFold(d.Name(), name) {
+			return d
+		}
+	}
+	return nil
+}
+
+//////// public command methods
+
+func (p *Pantheon) AddDeity(name, description string, strength float64) (*Deity, error) {
+	e := NewDeityAddEvent(myuuid.NewId(), p.id, name, description, strength)
+	val, err := p.syncRequestToSelf(newDeityAddCommand(e))
+	if err != nil {
+		return nil, err
+	}
+	return val.(*Deity), nil
+}
+
+func (p *Pantheon) receiveSacrifice(deity *Deity, actor *Actor, value float64) error {
+	e := NewDeitySacrificeEvent(deity.ID(), actor.ID(), p.id, actor.Name(), value)
+	_, err := p.syncRequestToSelf(newDeitySacrificeCommand(e))
+	return err
+}
+
+// answerPrayer spends the deity's strength on answering the prayer, failing
+// with ErrPrayerUnanswered if it hasn't enough left.
+func (p *Pantheon) answerPrayer(deity *Deity, actor *Actor, prayer Prayer) error {
+	e := NewDeityPrayerAnsweredEvent(deity.ID(), actor.ID(), p.id, actor.Name(), prayer.Name, prayer.StrengthCost)
+	_, err := p.syncRequestToSelf(newDeityPrayerAnsweredCommand(e))
+	return err
+}
+
+// reverse undoes a change in the deity's strength made by receiveSacrifice or
+// answerPrayer, when what was offered or prayed for couldn't then be carried
+// out in the Actor's Zone.
+func (p *Pantheon) reverse(deity *Deity, actor *Actor, strengthDelta float64) error {
+	e := NewDeityReversalEvent(deity.ID(), actor.ID(), p.id, actor.Name(), strengthDelta)
+	_, err := p.syncRequestToSelf(newDeityReversalCommand(e))
+	return err
+}
+
+func (p *Pantheon) reverseOrWarn(deity *Deity, actor *Actor, strengthDelta float64) {
+	err := p.reverse(deity, actor, strengthDelta)
+	if err != nil {
+		fmt.Printf("WARNING: failed to reverse change of %.1f to strength of deity %q: %s\n", strengthDelta, deity.Name(), err)
+	}
+}
+
+//////// command processing
+
+func (p *Pantheon) syncRequestToSelf(c Command) (interface{}, error) {
+	req := rpc.NewRequest(c)
+	p.privateRequestChan <- req
+	response := <-req.ResponseChan
+	return response.Value, response.Err
+}
+
+func (p *Pantheon) StartCommandProcessing() {
+	p.privateRequestChan = make(chan rpc.Request, pantheonRequestChannelCapacity)
+	p.stopChan = make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-p.stopChan:
+				p.stopWG.Done()
+				return
+			case req := <-p.privateRequestChan:
+				value, err := p.processCommand(req.Payload.(Command))
+				req.ResponseChan <- rpc.Response{
+					Err:   err,
+					Value: value,
+				}
+			}
+		}
+	}()
+}
+
+func (p *Pantheon) StopCommandProcessing() {
+	if p.stopWG == nil {
+		p.stopWG = &sync.WaitGroup{}
+	}
+	p.stopWG.Add(1)
+	close(p.stopChan)
+	p.stopWG.Wait()
+}
+
+func (p *Pantheon) processCommand(c Command) (interface{}, error) {
+	var outEvents []Event
+	var err error
+	var out interface{}
+
+	switch c.CommandType() {
+	case CommandTypeDeityAdd:
+		out, outEvents, err = p.processDeityAddCommand(c)
+	case CommandTypeDeitySacrifice:
+		outEvents, err = p.processDeitySacrificeCommand(c)
+	case CommandTypeDeityPrayerAnswered:
+		outEvents, err = p.processDeityPrayerAnsweredCommand(c)
+	case CommandTypeDeityReversal:
+		outEvents, err = p.processDeityReversalCommand(c)
+	default:
+		err = fmt.Errorf("unrecognized Command type %d", c.CommandType())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range outEvents {
+		if p.persister != nil && e.ShouldPersist() {
+			err = p.persister.PersistEvent(e)
+		}
+	}
+
+	return out, err
+}
+
+func (p *Pantheon) processDeityAddCommand(c Command) (interface{}, []Event, error) {
+	cmd := c.(deityAddCommand)
+	e := cmd.wrappedEvent
+
+	if _, duplicate := p.deitiesByID[e.DeityID]; duplicate {
+		return nil, nil, fmt.Errorf("deity %q already present in Pantheon", e.DeityID)
+	}
+	if p.DeityByName(e.Name) != nil {
+		return nil, nil, fmt.Errorf("deity named %q already present in Pantheon", e.Name)
+	}
+
+	events, err := p.sequenceAndApplyEvents([]Event{e})
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.deitiesByID[e.DeityID], events, nil
+}
+
+func (p *Pantheon) processDeitySacrificeCommand(c Command) ([]Event, error) {
+	cmd := c.(deitySacrificeCommand)
+	e := cmd.wrappedEvent
+
+	if _, found := p.deitiesByID[e.DeityID]; !found {
+		return nil, ErrDeityUnknown
+	}
+	return p.sequenceAndApplyEvents([]Event{e})
+}
+
+func (p *Pantheon) processDeityPrayerAnsweredCommand(c Command) ([]Event, error) {
+	cmd := c.(deityPrayerAnsweredCommand)
+	e := cmd.wrappedEvent
+
+	deity, found := p.deitiesByID[e.DeityID]
+	if !found {
+		return nil, ErrDeityUnknown
+	}
+	// checked here rather than in the supplicant's Zone, so that prayers from
+	// several Zones at once can't between them spend more than the deity has
+	if deity.Strength() < e.StrengthCost {
+		return nil, ErrPrayerUnanswered
+	}
+	return p.sequenceAndApplyEvents([]Event{e})
+}
+
+func (p *Pantheon) processDeityReversalCommand(c Command) ([]Event, error) {
+	cmd := c.(deityReversalCommand)
+	e := cmd.wrappedEvent
+
+	if _, found := p.deitiesByID[e.DeityID]; !found {
+		return nil, ErrDeityUnknown
+	}
+	return p.sequenceAndApplyEvents([]Event{e})
+}
+
+func (p *Pantheon) sequenceAndApplyEvents(events []Event) ([]Event, error) {
+	for _, e := range events {
+		e.SetSequenceNumber(p.nextSequenceId)
+		err := p.applyEvent(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+//////// Event processing
+
+// this is used to rebuild state from an Event store; it is not to be used
+// during normal operations
+func (p *Pantheon) ReplayEvents(inChan <-chan rpc.Response) error {
+	for res := range inChan {
+		if res.Err != nil {
+			return res.Err
+		}
+		err := p.applyEvent(res.Value.(Event))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Pantheon) applyEvent(e Event) error {
+	var err error
+	switch e.Type() {
+	case EventTypeDeityAdd:
+		err = p.applyDeityAddEvent(e.(*DeityAddEvent))
+	case EventTypeDeitySacrifice:
+		err = p.applyDeitySacrificeEvent(e.(*DeitySacrificeEvent))
+	case EventTypeDeityPrayerAnswered:
+		err = p.applyDeityPrayerAnsweredEvent(e.(*DeityPrayerAnsweredEvent))
+	case EventTypeDeityReversal:
+		err = p.applyDeityReversalEvent(e.(*DeityReversalEvent))
+	default:
+		err = fmt.Errorf("unknown Event type %T", e)
+	}
+	if err != nil {
+		return err
+	}
+
+	p.nextSequenceId = e.SequenceNumber() + 1
+	return nil
+}
+
+func (p *Pantheon) applyDeityAddEvent(e *DeityAddEvent) error {
+	p.deitiesByID[e.DeityID] = &Deity{
+		id:          e.DeityID,
+		name:        e.Name,
+		description: e.Description,
+		strength:    e.Strength,
+		rwlock:      &sync.RWMutex{},
+	}
+	return nil
+}
+
+func (p *Pantheon) applyDeitySacrificeEvent(e *DeitySacrificeEvent) error {
+	deity, found := p.deitiesByID[e.DeityID]
+	if !found {
+		return fmt.Errorf("cannot find deity %q to receive sacrifice", e.DeityID)
+	}
+	deity.adjustStrength(e.Value)
+	return nil
+}
+
+func (p *Pantheon) applyDeityPrayerAnsweredEvent(e *DeityPrayerAnsweredEvent) error {
+	deity, found := p.deitiesByID[e.DeityID]
+	if !found {
+		return fmt.Errorf("cannot find deity %q to answer prayer", e.DeityID)
+	}
+	deity.adjustStrength(-e.StrengthCost)
+	return nil
+}
+
+func (p *Pantheon) applyDeityReversalEvent(e *DeityReversalEvent) error {
+	deity, found := p.deitiesByID[e.DeityID]
+	if !found {
+		return fmt.Errorf("cannot find deity %q to reverse", e.DeityID)
+	}
+	deity.adjustStrength(e.StrengthDelta)
+	return nil
+}
+
+//////// Deity
+
+// Deity is a god which Actors may dedicate themselves to. A deity grows
+// stronger as sacrifices are made to it, and weaker as it answers prayers.
+type Deity struct {
+	id          uuid.UUID
+	name        string
+	description string
+	strength    float64
+	rwlock      *sync.RWMutex
+}
+
+func (d *Deity) ID() uuid.UUID {
+	return d.id
+}
+
+func (d *Deity) Name() string {
+	return d.name
+}
+
+func (d *Deity) Description() string {
+	return d.description
+}
+
+func (d *Deity) Strength() float64 {
+	d.rwlock.RLock()
+	defer d.rwlock.RUnlock()
+	return d.strength
+}
+
+func (d *Deity) adjustStrength(delta float64) {
+	d.rwlock.Lock()
+	defer d.rwlock.Unlock()
+	d.strength += delta
+	if d.strength < 0 {
+		d.strength = 0
+	}
+	if d.strength > DeityStrengthMax {
+		d.strength = DeityStrengthMax
+	}
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newDeityAddCommand(wrapped *DeityAddEvent) deityAddCommand {
+	return deityAddCommand{
+		commandGeneric{commandType: CommandTypeDeityAdd},
+		wrapped,
+	}
+}
+
+type deityAddCommand struct {
+	commandGeneric
+	wrappedEvent *DeityAddEvent
+}
+
+func newDeitySacrificeCommand(wrapped *DeitySacrificeEvent) deitySacrificeCommand {
+	return deitySacrificeCommand{
+		commandGeneric{commandType: CommandTypeDeitySacrifice},
+		wrapped,
+	}
+}
+
+type deitySacrificeCommand struct {
+	commandGeneric
+	wrappedEvent *DeitySacrificeEvent
+}
+
+func newDeityPrayerAnsweredCommand(wrapped *DeityPrayerAnsweredEvent) deityPrayerAnsweredCommand {
+	return deityPrayerAnsweredCommand{
+		commandGeneric{commandType: CommandTypeDeityPrayerAnswered},
+		wrapped,
+	}
+}
+
+type deityPrayerAnsweredCommand struct {
+	commandGeneric
+	wrappedEvent *DeityPrayerAnsweredEvent
+}
+
+func newDeityReversalCommand(wrapped *DeityReversalEvent) deityReversalCommand {
+	return deityReversalCommand{
+		commandGeneric{commandType: CommandTypeDeityReversal},
+		wrapped,
+	}
+}
+
+type deityReversalCommand struct {
+	commandGeneric
+	wrappedEvent *DeityReversalEvent
+}
+
+func NewDeityAddEvent(deityID, pantheonID uuid.UUID, name, description string, strength float64) *DeityAddEvent {
+	return &DeityAddEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeDeityAdd,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       pantheonID,
+			ShouldPersistBool: true,
+		},
+		DeityID:     deityID,
+		Name:        name,
+		Description: description,
+		Strength:    strength,
+	}
+}
+
+type DeityAddEvent struct {
+	*eventGeneric
+	DeityID           uuid.UUID
+	Name, Description string
+	Strength          float64
+}
+
+func NewDeitySacrificeEvent(deityID, actorID, pantheonID uuid.UUID, actorName string, value float64) *DeitySacrificeEvent {
+	return &DeitySacrificeEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeDeitySacrifice,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       pantheonID,
+			ShouldPersistBool: true,
+		},
+		DeityID:   deityID,
+		ActorID:   actorID,
+		ActorName: actorName,
+		Value:     value,
+	}
+}
+
+type DeitySacrificeEvent struct {
+	*eventGeneric
+	DeityID, ActorID uuid.UUID
+	ActorName        string
+	Value            float64
+}
+
+func NewDeityPrayerAnsweredEvent(deityID, actorID, pantheonID uuid.UUID, actorName, prayerName string, strengthCost float64) *DeityPrayerAnsweredEvent {
+	return &DeityPrayerAnsweredEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeDeityPrayerAnswered,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       pantheonID,
+			ShouldPersistBool: true,
+		},
+		DeityID:      deityID,
+		ActorID:      actorID,
+		ActorName:    actorName,
+		PrayerName:   prayerName,
+		StrengthCost: strengthCost,
+	}
+}
+
+type DeityPrayerAnsweredEvent struct {
+	*eventGeneric
+	DeityID, ActorID      uuid.UUID
+	ActorName, PrayerName string
+	StrengthCost          float64
+}
+
+func NewDeityReversalEvent(deityID, actorID, pantheonID uuid.UUID, actorName string, strengthDelta float64) *DeityReversalEvent {
+	return &DeityReversalEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeDeityReversal,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       pantheonID,
+			ShouldPersistBool: true,
+		},
+		DeityID:       deityID,
+		ActorID:       actorID,
+		ActorName:     actorName,
+		StrengthDelta: strengthDelta,
+	}
+}
+
+// DeityReversalEvent records a sacrifice or answered prayer being undone,
+// because it couldn't be carried out in the Actor's Zone.
+type DeityReversalEvent struct {
+	*eventGeneric
+	DeityID, ActorID uuid.UUID
+	ActorName        string
+	StrengthDelta    float64
+}
@@ -0,0 +1,283 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// PlayerDeathPenalty describes what a player character loses when it dies.
+type PlayerDeathPenalty struct {
+	// SkillLossFraction is the fraction (0.0 - 1.0) of each skill's current
+	// value which is lost on respawn. Skill caps are unaffected.
+	SkillLossFraction float64
+	// LeaveGearOnCorpse determines whether a player character's inventory is
+	// left behind on its corpse, or stays with the character's ghost.
+	LeaveGearOnCorpse bool
+}
+
+var (
+	PlayerCharacterDeathPenalty = PlayerDeathPenalty{
+		SkillLossFraction: 0.05,
+		LeaveGearOnCorpse: true,
+	}
+	// How long a player character spends as a ghost before respawning.
+	PlayerGhostDuration = time.Second * 30
+	// How often a Zone checks for ghosts which are ready to respawn.
+	actorRespawnCheckInterval = time.Second
+)
+
+var ErrActorIsGhost = errors.New("Actor is a ghost")
+
+// IsPlayerCharacter reports whether the Actor is controlled by a player rather
+// than a brain. Player characters become ghosts when they die, rather than
+// being removed from the World.
+func (a *Actor) IsPlayerCharacter() bool {
+	return a.brainType == PlayerParkingBrainType
+}
+
+func (a *Actor) IsGhost() bool {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return !a.ghostUntil.IsZero()
+}
+
+// GhostUntil returns the time at which the Actor will respawn, or the zero
+// time if the Actor is not a ghost.
+func (a *Actor) GhostUntil() time.Time {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.ghostUntil
+}
+
+func (a *Actor) setGhostUntil(t time.Time) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	a.ghostUntil = t
+}
+
+// BindLocationID returns the ID of the Location where the Actor respawns after
+// death. If that Location can't be found in the Actor's Zone at the time of
+// respawn, the Zone's default Location is used instead.
+func (a *Actor) BindLocationID() uuid.UUID {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.bindLocationID
+}
+
+func (a *Actor) setBindLocationID(id uuid.UUID) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	a.bindLocationID = id
+}
+
+// Bind makes the Actor's current Location the place it respawns after death.
+func (a *Actor) Bind() error {
+	e := NewActorSetBindLocationEvent(a.id, a.Location().ID(), a.Zone().ID())
+	_, err := a.syncRequestToZone(newActorSetBindLocationCommand(e))
+	return err
+}
+
+// respawnAttributes restores a dead Actor's derived attributes from their
+// corresponding base attributes.
+func respawnAttributes(attrs AttributeSet) AttributeSet {
+	attrs.Physical = attrs.Strength
+	attrs.Stamina = attrs.Fitness
+	attrs.Focus = attrs.Will
+	attrs.Zeal = attrs.Faith
+	return attrs
+}
+
+//////// Zone-side processing
+
+// processActorRespawnCommand respawns every ghost whose time has come, at its
+// bind Location if possible and the Zone's default Location otherwise.
+func (z *Zone) processActorRespawnCommand(c Command) ([]Event, error) {
+	var outEvents []Event
+	now := time.Now()
+
+	for _, actor := range z.actorsById {
+		ghostUntil := actor.GhostUntil()
+		if ghostUntil.IsZero() || now.Before(ghostUntil) {
+			continue
+		}
+
+		loc, found := z.locationsById[actor.BindLocationID()]
+		if !found {
+			loc = z.defaultLocation
+		}
+		if loc == nil {
+			loc = actor.Location()
+		}
+
+		e := NewActorRespawnEvent(
+			actor.Name(),
+			actor.ID(),
+			loc.ID(),
+			z.id,
+			respawnAttributes(actor.Attributes()),
+			actor.Skills().reducedBy(PlayerCharacterDeathPenalty.SkillLossFraction),
+		)
+		applied, err := z.sequenceAndApplyEvents([]Event{e})
+		outEvents = append(outEvents, applied...)
+		if err != nil {
+			fmt.Printf("CORE ERROR: zone %q respawning Actor %q: %s\n", z.Tag(), actor.ID(), err)
+		}
+	}
+
+	return outEvents, nil
+}
+
+func (z *Zone) processActorSetBindLocationCommand(c Command) ([]Event, error) {
+	cmd := c.(actorSetBindLocationCommand)
+	e := cmd.wrappedEvent
+
+	if _, found := z.actorsById[e.ActorID]; !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	if _, found := z.locationsById[e.LocationID]; !found {
+		return nil, fmt.Errorf("unknown Location %q", e.LocationID)
+	}
+
+	return z.sequenceAndApplyEvents([]Event{e})
+}
+
+func (z *Zone) applyActorBecomeGhostEvent(e *ActorBecomeGhostEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q to make a ghost", e.ActorID)
+	}
+	actor.setGhostUntil(e.RespawnAt)
+	return actor.Location().Observers(), nil
+}
+
+func (z *Zone) applyActorRespawnEvent(e *ActorRespawnEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q to respawn", e.ActorID)
+	}
+	toLoc, found := z.locationsById[e.ToLocationID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Location %q to respawn Actor in", e.ToLocationID)
+	}
+
+	var oList ObserverList
+	fromLoc := actor.Location()
+	if fromLoc != nil && fromLoc != toLoc {
+		// the Actor's own observers hear about this via toLoc, below
+		fromLoc.removeActor(actor)
+		oList = fromLoc.Observers()
+		toLoc.addActor(actor)
+		actor.setLocation(toLoc)
+	}
+	beforeAttrs, beforeSkills := actor.Attributes(), actor.Skills()
+	actor.setAttributes(e.Attributes)
+	actor.setSkills(e.Skills)
+	actor.recordProgressionSince(e.Timestamp(), ProgressionReasonDeath, "", beforeAttrs, beforeSkills)
+	actor.setGhostUntil(time.Time{})
+
+	return append(oList, toLoc.Observers()...), nil
+}
+
+func (z *Zone) applyActorSetBindLocationEvent(e *ActorSetBindLocationEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q to bind", e.ActorID)
+	}
+	actor.setBindLocationID(e.LocationID)
+	return actor.Observers(), nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newActorRespawnCommand() *actorRespawnCommand {
+	return &actorRespawnCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorRespawn},
+	}
+}
+
+type actorRespawnCommand struct {
+	commandGeneric
+}
+
+func newActorSetBindLocationCommand(wrapped *ActorSetBindLocationEvent) actorSetBindLocationCommand {
+	return actorSetBindLocationCommand{
+		commandGeneric{commandType: CommandTypeActorSetBindLocation},
+		wrapped,
+	}
+}
+
+type actorSetBindLocationCommand struct {
+	commandGeneric
+	wrappedEvent *ActorSetBindLocationEvent
+}
+
+func NewActorBecomeGhostEvent(name string, actorID, zoneID uuid.UUID, respawnAt time.Time) *ActorBecomeGhostEvent {
+	return &ActorBecomeGhostEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorBecomeGhost,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorName: name,
+		ActorID:   actorID,
+		RespawnAt: respawnAt,
+	}
+}
+
+type ActorBecomeGhostEvent struct {
+	*eventGeneric
+	ActorName string
+	ActorID   uuid.UUID
+	RespawnAt time.Time
+}
+
+func NewActorRespawnEvent(name string, actorID, toLocID, zoneID uuid.UUID, attrs AttributeSet, skills Skillset) *ActorRespawnEvent {
+	return &ActorRespawnEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorRespawn,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorName:    name,
+		ActorID:      actorID,
+		ToLocationID: toLocID,
+		Attributes:   attrs,
+		Skills:       skills,
+	}
+}
+
+type ActorRespawnEvent struct {
+	*eventGeneric
+	ActorName    string
+	ActorID      uuid.UUID
+	ToLocationID uuid.UUID
+	Attributes   AttributeSet
+	Skills       Skillset
+}
+
+func NewActorSetBindLocationEvent(actorID, locID, zoneID uuid.UUID) *ActorSetBindLocationEvent {
+	return &ActorSetBindLocationEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorSetBindLocation,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:    actorID,
+		LocationID: locID,
+	}
+}
+
+type ActorSetBindLocationEvent struct {
+	*eventGeneric
+	ActorID    uuid.UUID
+	LocationID uuid.UUID
+}
@@ -0,0 +1,45 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRespawnAttributes(t *testing.T) {
+	testCases := map[string]struct {
+		inAttrs       AttributeSet
+		expectedAttrs AttributeSet
+	}{
+		"derived attributes restored from base": {
+			inAttrs: AttributeSet{
+				Strength: 10, StrengthCap: 20,
+				Fitness: 11, FitnessCap: 21,
+				Will: 12, WillCap: 22,
+				Faith: 13, Faithcap: 23,
+				Physical: -5, Stamina: 0, Focus: 3, Zeal: 1,
+				NaturalBiteMin: 1, NaturalBiteMax: 2,
+			},
+			expectedAttrs: AttributeSet{
+				Strength: 10, StrengthCap: 20,
+				Fitness: 11, FitnessCap: 21,
+				Will: 12, WillCap: 22,
+				Faith: 13, Faithcap: 23,
+				Physical: 10, Stamina: 11, Focus: 12, Zeal: 13,
+				NaturalBiteMin: 1, NaturalBiteMax: 2,
+			},
+		},
+		"derived attributes above base are lowered": {
+			inAttrs:       AttributeSet{Strength: 5, Physical: 50},
+			expectedAttrs: AttributeSet{Strength: 5, Physical: 5},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			attrs := respawnAttributes(tc.inAttrs)
+			if !reflect.DeepEqual(attrs, tc.expectedAttrs) {
+				t.Errorf("expected %+v, got %+v", tc.expectedAttrs, attrs)
+			}
+		})
+	}
+}
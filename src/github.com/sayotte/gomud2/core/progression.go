@@ -0,0 +1,174 @@
+package core
+
+import (
+	"time"
+)
+
+// There are no experience points: Actors progress by raising their skills
+// and attributes (and the caps on them) through practice, reading and
+// devotion, and they can lose ground too, say on dying. Each Actor keeps a
+// ledger of its most recent gains and losses, so that players can see how
+// they're getting on. Entries are added as the events changing an Actor's
+// skills and attributes are applied, so the ledger is rebuilt along with
+// everything else when the Zone's events are replayed.
+
+const (
+	ProgressionReasonReading    = "reading"
+	ProgressionReasonScribing   = "scribing"
+	ProgressionReasonCrafting   = "crafting"
+	ProgressionReasonDedication = "dedication"
+	ProgressionReasonSacrifice  = "sacrifice"
+	ProgressionReasonDeath      = "death"
+
+	// TraitKnownReactions is the trait recording how many sorcery reactions
+	// an Actor knows.
+	TraitKnownReactions = "Reactions known"
+)
+
+// ProgressionLedgerLength is how many of its most recent gains and losses
+// each Actor's ledger keeps.
+var ProgressionLedgerLength = 20
+
+// Trait is one of an Actor's base attributes or skills, and the cap on it.
+type Trait struct {
+	Name       string
+	Value, Cap float64
+}
+
+// BaseAttributeTraits returns the base attributes in the AttributeSet, with
+// their caps.
+func BaseAttributeTraits(attrs AttributeSet) []Trait {
+	return []Trait{
+		{"Strength", float64(attrs.Strength), float64(attrs.StrengthCap)},
+		{"Fitness", float64(attrs.Fitness), float64(attrs.FitnessCap)},
+		{"Will", float64(attrs.Will), float64(attrs.WillCap)},
+		{"Faith", float64(attrs.Faith), float64(attrs.Faithcap)},
+	}
+}
+
+// DerivedAttributeTraits returns the derived attributes in the AttributeSet,
+// each capped at what respawning would restore it to.
+func DerivedAttributeTraits(attrs AttributeSet) []Trait {
+	full := respawnAttributes(attrs)
+	return []Trait{
+		{"Physical", float64(attrs.Physical), float64(full.Physical)},
+		{"Stamina", float64(attrs.Stamina), float64(full.Stamina)},
+		{"Focus", float64(attrs.Focus), float64(full.Focus)},
+		{"Zeal", float64(attrs.Zeal), float64(full.Zeal)},
+	}
+}
+
+// SkillTraits returns the skills in the Skillset, with their caps.
+func SkillTraits(skills Skillset) []Trait {
+	return []Trait{
+		{"Slashing", skills.Slashing, skills.SlashingCap},
+		{"Stabbing", skills.Stabbing, skills.StabbingCap},
+		{"Bashing", skills.Bashing, skills.BashingCap},
+		{"Biting", skills.Biting, skills.BitingCap},
+		{"Dodging", skills.Dodging, skills.DodgingCap},
+		{"Dodging techniques", float64(skills.DodgingTechniques), float64(skills.DodgingTechniquesCap)},
+		{"Deflecting", skills.Deflecting, skills.DeflectingCap},
+		{"Blocking", skills.Blocking, skills.BlockingCap},
+		{"Sorcery", skills.Sorcery, skills.SorceryCap},
+		{"Mysticism", skills.Mysticism, skills.MysticismCap},
+		{"Inscription", skills.Inscription, skills.InscriptionCap},
+		{"Crafting", skills.Crafting, skills.CraftingCap},
+	}
+}
+
+// ProgressionEntry records one of an Actor's traits, or the cap on it,
+// changing from one value to another, and why. Detail names what was learned,
+// if anything in particular was.
+type ProgressionEntry struct {
+	At       time.Time
+	Reason   string
+	Trait    string
+	From, To float64
+	Detail   string
+}
+
+// IsGain reports whether the entry records an improvement.
+func (pe ProgressionEntry) IsGain() bool {
+	return pe.To > pe.From
+}
+
+// progressionEntries compares an Actor's base attributes and skills before
+// and after some event, returning an entry for each value or cap that
+// changed.
+func progressionEntries(at time.Time, reason, detail string, beforeAttrs, afterAttrs AttributeSet, beforeSkills, afterSkills Skillset) []ProgressionEntry {
+	before := append(BaseAttributeTraits(beforeAttrs), SkillTraits(beforeSkills)...)
+	after := append(BaseAttributeTraits(afterAttrs), SkillTraits(afterSkills)...)
+
+	var entries []ProgressionEntry
+	for i := range before {
+		if before[i].Value != after[i].Value {
+			entries = append(entries, ProgressionEntry{
+				At:     at,
+				Reason: reason,
+				Trait:  before[i].Name,
+				From:   before[i].Value,
+				To:     after[i].Value,
+				Detail: detail,
+			})
+		}
+		if before[i].Cap != after[i].Cap {
+			entries = append(entries, ProgressionEntry{
+				At:     at,
+				Reason: reason,
+				Trait:  before[i].Name + " cap",
+				From:   before[i].Cap,
+				To:     after[i].Cap,
+				Detail: detail,
+			})
+		}
+	}
+	return entries
+}
+
+//////// Actor methods
+
+// Progression returns the Actor's most recent gains and losses, oldest
+// first.
+func (a *Actor) Progression() []ProgressionEntry {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return append([]ProgressionEntry(nil), a.progression...)
+}
+
+// progressionSnapshot returns the Actor's ledger. The slice must not be
+// modified.
+func (a *Actor) progressionSnapshot() []ProgressionEntry {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.progression
+}
+
+// recordProgression adds entries to the Actor's ledger, forgetting the oldest
+// of them once it's full.
+func (a *Actor) recordProgression(entries []ProgressionEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	// copy-on-write, as the old slice may be captured in a snapshot
+	progression := append(append([]ProgressionEntry(nil), a.progression...), entries...)
+	if len(progression) > ProgressionLedgerLength {
+		progression = progression[len(progression)-ProgressionLedgerLength:]
+	}
+	a.progression = progression
+}
+
+// recordProgressionSince adds entries to the Actor's ledger for each of its
+// base attributes and skills that has changed from the given values.
+func (a *Actor) recordProgressionSince(at time.Time, reason, detail string, beforeAttrs AttributeSet, beforeSkills Skillset) {
+	a.recordProgression(progressionEntries(
+		at,
+		reason,
+		detail,
+		beforeAttrs,
+		a.Attributes(),
+		beforeSkills,
+		a.Skills(),
+	))
+}
@@ -0,0 +1,629 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Quests give Actors goals: a list of objectives, such as killing a few of
+// the Actors spawned from some prototype, delivering an Object to someone or
+// reaching a Location, and the rewards for completing them all. An Actor
+// accepts a quest (from its giver, if it has one), and thereafter the Zone
+// watches the events it processes for whatever advances the quest's
+// objectives; when the last is met the quest is complete and the rewards are
+// handed out.
+//
+// Quests themselves are defined in a data file, like factions; each Actor's
+// progress is event-sourced as part of the Actor, so it follows the Actor
+// between Zones and survives restarts.
+
+const (
+	QuestObjectiveKill    = "kill"
+	QuestObjectiveDeliver = "deliver"
+	QuestObjectiveReach   = "reach"
+
+	ReputationReasonQuestReward = "quest-reward"
+)
+
+var (
+	ErrQuestUnknown         = errors.New("no such quest")
+	ErrQuestAlreadyActive   = errors.New("Actor is already on that quest")
+	ErrQuestAlreadyComplete = errors.New("Actor has already completed that quest")
+	ErrQuestNotActive       = errors.New("Actor is not on that quest")
+	ErrQuestGiverNotHere    = errors.New("that quest's giver isn't here")
+)
+
+// QuestObjective is one of the things an Actor must do to complete a quest.
+type QuestObjective struct {
+	// Type is one of the QuestObjective* constants.
+	Type        string `yaml:"type"`
+	Description string `yaml:"description"`
+	// Actor is the name of the Actors to kill, which for spawned Actors is
+	// the name of their prototype, or of the Actor to deliver to.
+	Actor string `yaml:"actor,omitempty"`
+	// Object is a keyword of the Object to deliver.
+	Object string `yaml:"object,omitempty"`
+	// LocationID is the Location to reach.
+	LocationID uuid.UUID `yaml:"locationID,omitempty"`
+	// Count is how many times the objective must be met; zero means once.
+	Count int `yaml:"count,omitempty"`
+}
+
+// Required returns how many times the objective must be met.
+func (qo QuestObjective) Required() int {
+	if qo.Count < 1 {
+		return 1
+	}
+	return qo.Count
+}
+
+func (qo QuestObjective) validate() error {
+	switch qo.Type {
+	case QuestObjectiveKill:
+		if qo.Actor == "" {
+			return errors.New("kill objective without an Actor")
+		}
+	case QuestObjectiveDeliver:
+		if qo.Actor == "" || qo.Object == "" {
+			return errors.New("deliver objective without an Actor and Object")
+		}
+	case QuestObjectiveReach:
+		if uuid.Equal(qo.LocationID, uuid.Nil) {
+			return errors.New("reach objective without a Location")
+		}
+	default:
+		return fmt.Errorf("unknown objective type %q", qo.Type)
+	}
+	return nil
+}
+
+// QuestRewards are handed to an Actor when it completes a quest.
+type QuestRewards struct {
+	Coins int `yaml:"coins,omitempty"`
+	// Reputation is how much the Actor's reputation with each named faction
+	// rises (or falls).
+	Reputation map[string]int `yaml:"reputation,omitempty"`
+}
+
+// Quest describes a quest, what it takes to complete it, and its rewards.
+type Quest struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// Giver is the name of the Actor who must be present when the quest is
+	// accepted; if it's empty, the quest can be accepted anywhere.
+	Giver string `yaml:"giver,omitempty"`
+	// Repeatable quests can be accepted again once they're complete.
+	Repeatable bool             `yaml:"repeatable,omitempty"`
+	Objectives []QuestObjective `yaml:"objectives"`
+	Rewards    QuestRewards     `yaml:"rewards"`
+}
+
+var (
+	questsLock   = &sync.RWMutex{}
+	questsByName = make(map[string]Quest)
+)
+
+// SetQuests replaces the set of quests, typically with definitions loaded
+// from a data file at startup.
+func SetQuests(quests []Quest) error {
+	byName := make(map[string]Quest, len(quests))
+	for _, q := range quests {
+		if q.Name == "" {
+			return errors.New("quest with empty name")
+		}
+		if _, duplicate := byName[q.Name]; duplicate {
+			return fmt.Errorf("duplicate quest %q", q.Name)
+		}
+		if len(q.Objectives) == 0 {
+			return fmt.Errorf("quest %q has no objectives", q.Name)
+		}
+		for i, objective := range q.Objectives {
+			if err := objective.validate(); err != nil {
+				return fmt.Errorf("quest %q objective %d: %s", q.Name, i+1, err)
+			}
+		}
+		for name := range q.Rewards.Reputation {
+			if _, found := FactionByName(name); !found {
+				return fmt.Errorf("quest %q rewards reputation with unknown faction %q", q.Name, name)
+			}
+		}
+		byName[q.Name] = q
+	}
+
+	questsLock.Lock()
+	defer questsLock.Unlock()
+	questsByName = byName
+	return nil
+}
+
+func QuestByName(name string) (Quest, bool) {
+	questsLock.RLock()
+	defer questsLock.RUnlock()
+	q, found := questsByName[name]
+	return q, found
+}
+
+func Quests() []Quest {
+	questsLock.RLock()
+	defer questsLock.RUnlock()
+	out := make([]Quest, 0, len(questsByName))
+	for _, q := range questsByName {
+		out = append(out, q)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// QuestProgress is how far an Actor has got with a quest: how many times
+// it's met each of the quest's objectives, in order, and whether it's done.
+type QuestProgress struct {
+	Name        string
+	Progress    []int
+	Completed   bool
+	AcceptedAt  time.Time
+	CompletedAt time.Time
+}
+
+func (qp QuestProgress) copy() QuestProgress {
+	out := qp
+	out.Progress = append([]int(nil), qp.Progress...)
+	return out
+}
+
+// metAll reports whether every one of the quest's objectives has been met.
+func (qp QuestProgress) metAll(q Quest) bool {
+	for i, objective := range q.Objectives {
+		if i >= len(qp.Progress) || qp.Progress[i] < objective.Required() {
+			return false
+		}
+	}
+	return true
+}
+
+//////// Actor methods
+
+// Quests returns the Actor's progress with each quest it has accepted, in
+// order of name, whether or not it has completed them.
+func (a *Actor) Quests() []QuestProgress {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	out := make([]QuestProgress, 0, len(a.quests))
+	for _, qp := range a.quests {
+		out = append(out, qp.copy())
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// QuestProgress returns the Actor's progress with the named quest, if it has
+// ever accepted it.
+func (a *Actor) QuestProgress(name string) (QuestProgress, bool) {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	qp, found := a.quests[name]
+	return qp.copy(), found
+}
+
+// questsSnapshot returns the Actor's quest progress. The map must not be
+// modified.
+func (a *Actor) questsSnapshot() map[string]QuestProgress {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.quests
+}
+
+// AcceptQuest starts the Actor on the named quest; if the quest has a giver,
+// the giver must be in the same Location.
+func (a *Actor) AcceptQuest(name string) error {
+	_, err := a.syncRequestToZone(newActorQuestCommand(a, name, true))
+	return err
+}
+
+// AbandonQuest gives up the Actor's progress with the named quest.
+func (a *Actor) AbandonQuest(name string) error {
+	_, err := a.syncRequestToZone(newActorQuestCommand(a, name, false))
+	return err
+}
+
+//////// Zone-side processing
+
+func (z *Zone) processActorQuestCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorQuestCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	quest, found := QuestByName(cmd.questName)
+	if !found {
+		return nil, ErrQuestUnknown
+	}
+	progress, found := cmd.actor.QuestProgress(cmd.questName)
+
+	var e Event
+	if cmd.accept {
+		switch {
+		case found && !progress.Completed:
+			return nil, ErrQuestAlreadyActive
+		case found && !quest.Repeatable:
+			return nil, ErrQuestAlreadyComplete
+		}
+		if quest.Giver != "" && !questGiverPresent(quest, cmd.actor.Location()) {
+			return nil, ErrQuestGiverNotHere
+		}
+		e = NewActorQuestAcceptEvent(cmd.actor.ID(), z.id, cmd.actor.Name(), quest.Name, len(quest.Objectives))
+	} else {
+		if !found || progress.Completed {
+			return nil, ErrQuestNotActive
+		}
+		e = NewActorQuestAbandonEvent(cmd.actor.ID(), z.id, cmd.actor.Name(), quest.Name)
+	}
+	return z.sequenceAndApplyEvents([]Event{e})
+}
+
+func questGiverPresent(q Quest, loc *Location) bool {
+	for _, actor := range loc.Actors() {
+		if actor.Name() == q.Giver {
+			return true
+		}
+	}
+	return false
+}
+
+// questEventsFor looks through events the Zone has just applied for anything
+// advancing an Actor's quests, and sequences and applies the events recording
+// that progress, along with the completion of any quest whose objectives have
+// all now been met and the handing out of its rewards.
+func (z *Zone) questEventsFor(events []Event) ([]Event, error) {
+	var outEvents []Event
+	for _, e := range events {
+		var actorID uuid.UUID
+		var matches func(QuestObjective) bool
+		switch typedE := e.(type) {
+		case *ActorDeathEvent:
+			actorID = typedE.KillerID
+			matches = func(o QuestObjective) bool {
+				return o.Type == QuestObjectiveKill && o.Actor == typedE.ActorName
+			}
+		case *ObjectMoveEvent:
+			// only handing something directly to another Actor delivers it
+			if !uuid.Equal(typedE.ActorID, typedE.FromActorContainerID) {
+				continue
+			}
+			recipient, found := z.actorsById[typedE.ToActorContainerID]
+			obj, objFound := z.objectsById[typedE.ObjectID]
+			if !found || !objFound {
+				continue
+			}
+			actorID = typedE.ActorID
+			matches = func(o QuestObjective) bool {
+				return o.Type == QuestObjectiveDeliver && o.Actor == recipient.Name() && hasKeyword(obj, o.Object)
+			}
+		case *ActorMoveEvent:
+			actorID = typedE.ActorId
+			matches = func(o QuestObjective) bool {
+				return o.Type == QuestObjectiveReach && uuid.Equal(o.LocationID, typedE.ToLocationId)
+			}
+		case *ActorMigrateInEvent:
+			actorID = typedE.ActorID
+			matches = func(o QuestObjective) bool {
+				return o.Type == QuestObjectiveReach && uuid.Equal(o.LocationID, typedE.ToLocID)
+			}
+		default:
+			continue
+		}
+
+		actor, found := z.actorsById[actorID]
+		if !found {
+			continue
+		}
+		for _, progress := range actor.Quests() {
+			newEvents, err := z.questProgressEvents(actor, progress, matches)
+			outEvents = append(outEvents, newEvents...)
+			if err != nil {
+				return outEvents, err
+			}
+		}
+	}
+	return outEvents, nil
+}
+
+// questProgressEvents sequences and applies the events advancing the Actor's
+// progress with a quest, for each of the quest's objectives that matches what
+// it just did, and completing the quest if that was the last of them.
+func (z *Zone) questProgressEvents(actor *Actor, progress QuestProgress, matches func(QuestObjective) bool) ([]Event, error) {
+	quest, found := QuestByName(progress.Name)
+	if !found || progress.Completed {
+		return nil, nil
+	}
+
+	var events []Event
+	for i, objective := range quest.Objectives {
+		if i >= len(progress.Progress) || progress.Progress[i] >= objective.Required() || !matches(objective) {
+			continue
+		}
+		progress.Progress[i]++
+		events = append(events, NewActorQuestProgressEvent(
+			actor.ID(),
+			z.id,
+			actor.Name(),
+			quest.Name,
+			i,
+			progress.Progress[i],
+			objective.Required(),
+		))
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	if progress.metAll(quest) {
+		events = append(events, NewActorQuestCompleteEvent(actor.ID(), z.id, actor.Name(), quest.Name, quest.Rewards.Coins))
+	}
+	events, err := z.sequenceAndApplyEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	if !progress.metAll(quest) {
+		return events, nil
+	}
+
+	rewardEvents, err := z.sequenceAndApplyEvents(z.questRewardEvents(actor, quest))
+	if err != nil {
+		return nil, err
+	}
+	return append(events, rewardEvents...), nil
+}
+
+// questRewardEvents returns the events handing the quest's rewards to the
+// Actor. Coins it has no room for are left at its feet.
+func (z *Zone) questRewardEvents(actor *Actor, q Quest) []Event {
+	var events []Event
+	if q.Rewards.Coins > 0 {
+		hasStack := false
+		for _, obj := range actor.inventory.Objects() {
+			if obj.IsCurrency() {
+				hasStack = true
+				break
+			}
+		}
+		coinSub := actor.inventory.roomFor(NewCoinStack(q.Rewards.Coins, nil, z))
+		if hasStack || coinSub != "" {
+			events = append(events, z.coinCreditEvents(actor, q.Rewards.Coins, coinSub)...)
+		} else {
+			events = append(events, NewCoinStackAddToZoneEvent(
+				q.Rewards.Coins,
+				actor.Location().ID(),
+				uuid.Nil,
+				uuid.Nil,
+				z.id,
+				ContainerDefaultSubcontainer,
+			))
+		}
+	}
+
+	reasons := make(map[string]string, len(q.Rewards.Reputation))
+	for name := range q.Rewards.Reputation {
+		reasons[name] = ReputationReasonQuestReward
+	}
+	return append(events, z.reputationEvents(actor, q.Rewards.Reputation, reasons)...)
+}
+
+func hasKeyword(obj *Object, keyword string) bool {
+	for _, kw := range obj.Keywords() {
+		if kw == keyword {
+			return true
+		}
+	}
+	return false
+}
+
+func (z *Zone) applyActorQuestAcceptEvent(e *ActorQuestAcceptEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q accepting quest", e.ActorID)
+	}
+	actor.rwlock.Lock()
+	quests := actor.copyQuests()
+	quests[e.Quest] = QuestProgress{
+		Name:       e.Quest,
+		Progress:   make([]int, e.Objectives),
+		AcceptedAt: e.Timestamp(),
+	}
+	actor.quests = quests
+	actor.rwlock.Unlock()
+	return actor.Observers(), nil
+}
+
+func (z *Zone) applyActorQuestProgressEvent(e *ActorQuestProgressEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q progressing with quest", e.ActorID)
+	}
+	actor.rwlock.Lock()
+	quests := actor.copyQuests()
+	progress, found := quests[e.Quest]
+	if !found {
+		actor.rwlock.Unlock()
+		return nil, fmt.Errorf("Actor %q is not on quest %q", e.ActorID, e.Quest)
+	}
+	for len(progress.Progress) <= e.Objective {
+		progress.Progress = append(progress.Progress, 0)
+	}
+	progress.Progress[e.Objective] = e.Progress
+	quests[e.Quest] = progress
+	actor.quests = quests
+	actor.rwlock.Unlock()
+	return actor.Observers(), nil
+}
+
+func (z *Zone) applyActorQuestCompleteEvent(e *ActorQuestCompleteEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q completing quest", e.ActorID)
+	}
+	actor.rwlock.Lock()
+	quests := actor.copyQuests()
+	progress, found := quests[e.Quest]
+	if !found {
+		actor.rwlock.Unlock()
+		return nil, fmt.Errorf("Actor %q is not on quest %q", e.ActorID, e.Quest)
+	}
+	progress.Completed = true
+	progress.CompletedAt = e.Timestamp()
+	quests[e.Quest] = progress
+	actor.quests = quests
+	actor.rwlock.Unlock()
+	// bystanders see the quest completed, so the giver can thank the Actor
+	return actor.location.Observers(), nil
+}
+
+func (z *Zone) applyActorQuestAbandonEvent(e *ActorQuestAbandonEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q abandoning quest", e.ActorID)
+	}
+	actor.rwlock.Lock()
+	quests := actor.copyQuests()
+	delete(quests, e.Quest)
+	actor.quests = quests
+	actor.rwlock.Unlock()
+	return actor.Observers(), nil
+}
+
+// copyQuests returns a deep copy of the Actor's quest progress, for
+// copy-on-write, as the old map may be captured in a snapshot. The caller
+// must hold the Actor's lock.
+func (a *Actor) copyQuests() map[string]QuestProgress {
+	quests := make(map[string]QuestProgress, len(a.quests)+1)
+	for name, progress := range a.quests {
+		quests[name] = progress.copy()
+	}
+	return quests
+}
+
+//////// Commands and events
+
+func newActorQuestCommand(actor *Actor, questName string, accept bool) *actorQuestCommand {
+	return &actorQuestCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorQuest},
+		actor:          actor,
+		questName:      questName,
+		accept:         accept,
+	}
+}
+
+type actorQuestCommand struct {
+	commandGeneric
+	actor     *Actor
+	questName string
+	accept    bool
+}
+
+func NewActorQuestAcceptEvent(actorID, zoneID uuid.UUID, actorName, quest string, objectives int) *ActorQuestAcceptEvent {
+	return &ActorQuestAcceptEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorQuestAccept,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:    actorID,
+		ActorName:  actorName,
+		Quest:      quest,
+		Objectives: objectives,
+	}
+}
+
+// ActorQuestAcceptEvent records an Actor starting on a quest with the given
+// number of objectives, or starting over on one it has completed before.
+type ActorQuestAcceptEvent struct {
+	*eventGeneric
+	ActorID    uuid.UUID
+	ActorName  string
+	Quest      string
+	Objectives int
+}
+
+func NewActorQuestProgressEvent(actorID, zoneID uuid.UUID, actorName, quest string, objective, progress, required int) *ActorQuestProgressEvent {
+	return &ActorQuestProgressEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorQuestProgress,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		ActorName: actorName,
+		Quest:     quest,
+		Objective: objective,
+		Progress:  progress,
+		Required:  required,
+	}
+}
+
+// ActorQuestProgressEvent records an Actor having met one of a quest's
+// objectives, numbered from zero, Progress times out of Required.
+type ActorQuestProgressEvent struct {
+	*eventGeneric
+	ActorID   uuid.UUID
+	ActorName string
+	Quest     string
+	Objective int
+	Progress  int
+	Required  int
+}
+
+func NewActorQuestCompleteEvent(actorID, zoneID uuid.UUID, actorName, quest string, coins int) *ActorQuestCompleteEvent {
+	return &ActorQuestCompleteEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorQuestComplete,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		ActorName: actorName,
+		Quest:     quest,
+		Coins:     coins,
+	}
+}
+
+// ActorQuestCompleteEvent records an Actor completing a quest. The rewards
+// follow as events of their own; Coins is there for the telling.
+type ActorQuestCompleteEvent struct {
+	*eventGeneric
+	ActorID   uuid.UUID
+	ActorName string
+	Quest     string
+	Coins     int
+}
+
+func NewActorQuestAbandonEvent(actorID, zoneID uuid.UUID, actorName, quest string) *ActorQuestAbandonEvent {
+	return &ActorQuestAbandonEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorQuestAbandon,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		ActorName: actorName,
+		Quest:     quest,
+	}
+}
+
+type ActorQuestAbandonEvent struct {
+	*eventGeneric
+	ActorID   uuid.UUID
+	ActorName string
+	Quest     string
+}
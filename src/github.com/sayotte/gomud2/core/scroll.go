@@ -0,0 +1,348 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+// Scrolls teach either a sorcerous Reaction or a technique (e.g. a dodging
+// technique) to whoever reads them, and are consumed in the process. Scrolls
+// can be copied onto blank scrolls by scribing, which exercises the
+// Inscription skill. See the "scribing" section of combatPlan.md.
+
+var (
+	// ActorScribeDelay is the base delay following an attempt to scribe.
+	ActorScribeDelay = time.Second * 3
+	// How much Inscription skill is gained by a successful or failed attempt
+	// to scribe a scroll.
+	InscriptionGainOnSuccess = 1.0
+	InscriptionGainOnFailure = 0.5
+)
+
+var (
+	ErrObjectNotScroll       = errors.New("Object is not a scroll")
+	ErrObjectNotBlankScroll  = errors.New("Object is not a blank scroll")
+	ErrTechniqueUnknown      = errors.New("no such technique")
+	ErrTechniqueAlreadyKnown = errors.New("Actor already knows that technique")
+	ErrTechniqueTooAdvanced  = errors.New("Actor must learn earlier techniques first")
+	ErrTechniqueBeyondCap    = errors.New("Actor cannot learn any more of those techniques")
+	ErrScribeFailed          = errors.New("scribing failed")
+	ErrScribeSameObject      = errors.New("cannot scribe a scroll onto itself")
+)
+
+// ScribeSuccessChance returns the chance (0.0 - 1.0) that a scroll of the given
+// difficulty is successfully copied by a scribe of the given Inscription skill.
+// The chance is 50% when skill and difficulty are equal, moving linearly to 0%
+// and 100% at 25 points below and above the difficulty.
+func ScribeSuccessChance(skill, difficulty float64) float64 {
+	chance := 0.5 + (skill-difficulty)/50
+	return math.Max(0.0, math.Min(1.0, chance))
+}
+
+// scrollDifficulty returns the Inscription skill at which a scroll with the
+// given attributes is copied successfully half of the time.
+func scrollDifficulty(attrs ObjectAttributes) (float64, error) {
+	switch {
+	case attrs.ScrollReaction != "":
+		reaction, found := ReactionByName(attrs.ScrollReaction)
+		if !found {
+			return 0, ErrReactionUnknown
+		}
+		return reaction.SkillRequirement, nil
+	case attrs.ScrollTechnique != "":
+		// later techniques are only usable at higher skill, and are
+		// correspondingly harder to copy
+		return float64(attrs.ScrollTechniqueLevel) * combatDodgeTechniquesUsableAtSkillInterval, nil
+	default:
+		return 0, ErrObjectNotScroll
+	}
+}
+
+//////// Actor methods
+
+// ReadScroll teaches the Actor the reaction or technique written on a scroll
+// it is holding, consuming the scroll in the process.
+func (a *Actor) ReadScroll(scroll *Object) error {
+	_, err := a.syncRequestToZone(newActorReadScrollCommand(a, scroll))
+	return err
+}
+
+func (a *Actor) ScribeDelay() time.Duration {
+	return ActionDelay(ActorScribeDelay, a.Attributes())
+}
+
+// Scribe copies a scroll the Actor is holding onto a blank scroll it is also
+// holding. The blank scroll is used up whether or not the attempt succeeds; if
+// it fails, ErrScribeFailed is returned.
+func (a *Actor) Scribe(source, blank *Object) error {
+	var succeeded bool
+	err := a.doDelayedAction(a.ScribeDelay(), func() error {
+		val, err := a.syncRequestToZone(newActorScribeCommand(a, source, blank))
+		if err == nil {
+			succeeded = val.(bool)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if !succeeded {
+		return ErrScribeFailed
+	}
+	return nil
+}
+
+//////// Zone-side processing
+
+func (z *Zone) processActorReadScrollCommand(c Command) ([]Event, error) {
+	cmd := c.(*actorReadScrollCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	_, found = z.objectsById[cmd.scroll.ID()]
+	if !found || cmd.scroll.Container() != cmd.actor {
+		return nil, errors.New("Actor is not holding that Object")
+	}
+	if cmd.scroll.lockedInTrade() {
+		return nil, ErrObjectLockedInTrade
+	}
+	if cmd.actor.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+
+	var learnEvent Event
+	attrs := cmd.scroll.Attributes()
+	switch {
+	case attrs.ScrollReaction != "":
+		if _, found := ReactionByName(attrs.ScrollReaction); !found {
+			return nil, ErrReactionUnknown
+		}
+		if cmd.actor.KnowsReaction(attrs.ScrollReaction) {
+			return nil, ErrReactionAlreadyKnown
+		}
+		learnEvent = NewActorLearnReactionEvent(attrs.ScrollReaction, cmd.actor.Name(), cmd.actor.ID(), cmd.scroll.ID(), z.id)
+	case attrs.ScrollTechnique != "":
+		known, limit, ok := cmd.actor.Skills().techniques(attrs.ScrollTechnique)
+		switch {
+		case !ok:
+			return nil, ErrTechniqueUnknown
+		case attrs.ScrollTechniqueLevel <= known:
+			return nil, ErrTechniqueAlreadyKnown
+		case attrs.ScrollTechniqueLevel > limit:
+			return nil, ErrTechniqueBeyondCap
+		case attrs.ScrollTechniqueLevel > known+1:
+			return nil, ErrTechniqueTooAdvanced
+		}
+		learnEvent = NewActorLearnTechniqueEvent(
+			attrs.ScrollTechnique,
+			attrs.ScrollTechniqueLevel,
+			cmd.actor.Name(),
+			cmd.actor.ID(),
+			cmd.scroll.ID(),
+			z.id,
+		)
+	default:
+		return nil, ErrObjectNotScroll
+	}
+
+	return z.sequenceAndApplyEvents([]Event{
+		learnEvent,
+		// reading a scroll consumes it
+		NewObjectRemoveFromZoneEvent(cmd.scroll.Name(), cmd.scroll.ID(), z.id),
+	})
+}
+
+func (z *Zone) processActorScribeCommand(c Command) (interface{}, []Event, error) {
+	cmd := c.(*actorScribeCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, nil, errors.New("Actor not in Zone")
+	}
+	for _, obj := range []*Object{cmd.source, cmd.blank} {
+		_, found = z.objectsById[obj.ID()]
+		if !found || obj.Container() != cmd.actor {
+			return nil, nil, errors.New("Actor is not holding that Object")
+		}
+	}
+	if cmd.source == cmd.blank {
+		return nil, nil, ErrScribeSameObject
+	}
+	if cmd.blank.lockedInTrade() {
+		return nil, nil, ErrObjectLockedInTrade
+	}
+	if cmd.actor.IsGhost() {
+		return nil, nil, ErrActorIsGhost
+	}
+	if !cmd.blank.Attributes().BlankScroll {
+		return nil, nil, ErrObjectNotBlankScroll
+	}
+	difficulty, err := scrollDifficulty(cmd.source.Attributes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skills := cmd.actor.Skills()
+	success := rollFloat64(z.Rand()) < ScribeSuccessChance(skills.Inscription, difficulty)
+
+	gain := InscriptionGainOnFailure
+	if success {
+		gain = InscriptionGainOnSuccess
+	}
+	inscription := skills.Inscription
+	if inscription < skills.InscriptionCap {
+		inscription = math.Min(inscription+gain, skills.InscriptionCap)
+	}
+
+	outEvents := []Event{
+		NewActorScribeEvent(
+			cmd.actor.ID(),
+			cmd.source.ID(),
+			cmd.blank.ID(),
+			z.id,
+			cmd.actor.Name(),
+			cmd.source.Name(),
+			success,
+			inscription,
+		),
+		// the blank is used up either way; on success it's replaced by a copy
+		NewObjectRemoveFromZoneEvent(cmd.blank.Name(), cmd.blank.ID(), z.id),
+	}
+	if success {
+		outEvents = append(outEvents, NewObjectAddToZoneEvent(
+			cmd.source.Name(),
+			cmd.source.Description(),
+			cmd.source.Keywords(),
+			cmd.source.Capacity(),
+			myuuid.NewId(),
+			uuid.Nil,
+			cmd.actor.ID(),
+			uuid.Nil,
+			z.id,
+			InventoryContainerHands,
+			cmd.source.Attributes(),
+		))
+	}
+
+	applied, err := z.sequenceAndApplyEvents(outEvents)
+	if err != nil {
+		return nil, applied, err
+	}
+	return success, applied, nil
+}
+
+func (z *Zone) applyActorLearnTechniqueEvent(e *ActorLearnTechniqueEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q to teach", e.ActorID)
+	}
+	beforeAttrs, beforeSkills := actor.Attributes(), actor.Skills()
+	actor.setSkills(actor.Skills().withTechniques(e.Technique, e.Level))
+	actor.recordProgressionSince(e.Timestamp(), ProgressionReasonReading, e.Technique, beforeAttrs, beforeSkills)
+	return actor.Location().Observers(), nil
+}
+
+func (z *Zone) applyActorScribeEvent(e *ActorScribeEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find scribing Actor %q", e.ActorID)
+	}
+	beforeAttrs, beforeSkills := actor.Attributes(), actor.Skills()
+	skills := actor.Skills()
+	skills.Inscription = e.Inscription
+	actor.setSkills(skills)
+	actor.recordProgressionSince(e.Timestamp(), ProgressionReasonScribing, e.ScrollName, beforeAttrs, beforeSkills)
+	return actor.Location().Observers(), nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newActorReadScrollCommand(actor *Actor, scroll *Object) *actorReadScrollCommand {
+	return &actorReadScrollCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorReadScroll},
+		actor:          actor,
+		scroll:         scroll,
+	}
+}
+
+type actorReadScrollCommand struct {
+	commandGeneric
+	actor  *Actor
+	scroll *Object
+}
+
+func newActorScribeCommand(actor *Actor, source, blank *Object) *actorScribeCommand {
+	return &actorScribeCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorScribe},
+		actor:          actor,
+		source:         source,
+		blank:          blank,
+	}
+}
+
+type actorScribeCommand struct {
+	commandGeneric
+	actor         *Actor
+	source, blank *Object
+}
+
+func NewActorLearnTechniqueEvent(technique string, level int, actorName string, actorID, scrollID, zoneID uuid.UUID) *ActorLearnTechniqueEvent {
+	return &ActorLearnTechniqueEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorLearnTechnique,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		Technique: technique,
+		Level:     level,
+		ActorName: actorName,
+		ActorID:   actorID,
+		ScrollID:  scrollID,
+	}
+}
+
+type ActorLearnTechniqueEvent struct {
+	*eventGeneric
+	Technique         string
+	Level             int
+	ActorName         string
+	ActorID, ScrollID uuid.UUID
+}
+
+func NewActorScribeEvent(actorID, sourceID, blankID, zoneID uuid.UUID, actorName, scrollName string, success bool, inscription float64) *ActorScribeEvent {
+	return &ActorScribeEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorScribe,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:     actorID,
+		SourceID:    sourceID,
+		BlankID:     blankID,
+		ActorName:   actorName,
+		ScrollName:  scrollName,
+		Success:     success,
+		Inscription: inscription,
+	}
+}
+
+type ActorScribeEvent struct {
+	*eventGeneric
+	ActorID, SourceID, BlankID uuid.UUID
+	ActorName, ScrollName      string
+	Success                    bool
+	// Inscription is the Actor's Inscription skill after the attempt.
+	Inscription float64
+}
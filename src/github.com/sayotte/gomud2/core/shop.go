@@ -0,0 +1,503 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+// Currency is carried as stacks of coins: Objects whose Coins attribute says
+// how many coins they hold. Stacks merge when put together, and coins are too
+// light to count against anyone's carry limit.
+//
+// Shopkeepers are Actors with ShopRules. They sell the goods in their
+// inventory, and buy goods from others, at prices derived from each Object's
+// Value. Every trade happens in a single batch of events, so that goods and
+// coins always change hands together.
+
+const (
+	ShopTradeActionBuy  = "buy"
+	ShopTradeActionSell = "sell"
+)
+
+var (
+	CoinStackName        = "a pile of coins"
+	CoinStackDescription = "A pile of well-worn coins."
+	CoinStackKeywords    = []string{"coins", "coin"}
+)
+
+var (
+	ErrNotShopkeeper         = errors.New("Actor is not a shopkeeper")
+	ErrShopkeeperNotHere     = errors.New("shopkeeper is not here")
+	ErrShopNotForSale        = errors.New("shopkeeper is not selling that")
+	ErrShopWillNotBuy        = errors.New("shopkeeper will not buy that")
+	ErrShopWillNotBuyStolen  = errors.New("shopkeeper will not buy stolen goods")
+	ErrShopContainerNotEmpty = errors.New("containers must be emptied before they're sold")
+	ErrShopNoRoom            = errors.New("shopkeeper has no room for that")
+	ErrShopCannotAfford      = errors.New("shopkeeper cannot afford that")
+	ErrCannotAfford          = errors.New("Actor cannot afford that")
+	ErrObjectWorthless       = errors.New("Object is worthless")
+)
+
+// ShopRules make an Actor a shopkeeper, and decide the prices it trades at.
+type ShopRules struct {
+	// SellRate multiplies an Object's Value to give the price the
+	// shopkeeper sells it for.
+	SellRate float64
+	// BuyRate multiplies an Object's Value to give the price the shopkeeper
+	// pays for it.
+	BuyRate float64
+	// BuysKeywords limits the shopkeeper to buying Objects with one of these
+	// keywords. If empty, it buys anything of value.
+	BuysKeywords []string
+}
+
+// SellPrice returns what the shopkeeper charges for the Object; never less
+// than one coin.
+func (sr ShopRules) SellPrice(obj *Object) int {
+	return int(math.Max(1, math.Ceil(float64(obj.Value())*sr.SellRate)))
+}
+
+// BuyPrice returns what the shopkeeper pays for the Object.
+func (sr ShopRules) BuyPrice(obj *Object) int {
+	return int(math.Floor(float64(obj.Value()) * sr.BuyRate))
+}
+
+// WillBuy reports whether the shopkeeper deals in Objects like this one.
+func (sr ShopRules) WillBuy(obj *Object) bool {
+	if obj.IsCurrency() || obj.Value() <= 0 {
+		return false
+	}
+	if len(sr.BuysKeywords) == 0 {
+		return true
+	}
+	for _, kw := range obj.Keywords() {
+		for _, buysKW := range sr.BuysKeywords {
+			if kw == buysKW {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//////// Object methods
+
+func (o *Object) Value() int {
+	return o.attributes.Value
+}
+
+func (o *Object) IsCurrency() bool {
+	return o.attributes.Coins > 0
+}
+
+func (o *Object) Coins() int {
+	return o.attributes.Coins
+}
+
+//////// Actor methods
+
+// Shop returns the Actor's ShopRules, or nil if it isn't a shopkeeper.
+func (a *Actor) Shop() *ShopRules {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.shop
+}
+
+func (a *Actor) IsShopkeeper() bool {
+	return a.Shop() != nil
+}
+
+// SetShop makes the Actor a shopkeeper trading by the given rules, or stops
+// it being one if rules is nil.
+func (a *Actor) SetShop(rules *ShopRules) error {
+	e := NewActorSetShopEvent(a.id, a.zone.ID(), rules)
+	_, err := a.syncRequestToZone(newActorSetShopCommand(e))
+	return err
+}
+
+// Coins returns how many coins the Actor can spend: those in its inventory,
+// including inside any open containers it carries.
+func (a *Actor) Coins() int {
+	var coins int
+	for _, stack := range a.coinStacks() {
+		coins += stack.Coins()
+	}
+	return coins
+}
+
+// spendableCoins returns how many coins the Actor can spend on anything other
+// than its current trade; coins it has offered are set aside until the trade
+// is over.
+func (a *Actor) spendableCoins() int {
+	_, offered := a.TradeOffer()
+	if coins := a.Coins() - offered; coins > 0 {
+		return coins
+	}
+	return 0
+}
+
+func (a *Actor) coinStacks() ObjectList {
+	var stacks ObjectList
+	var collect func(objs ObjectList)
+	collect = func(objs ObjectList) {
+		for _, obj := range objs {
+			if obj.IsCurrency() {
+				stacks = append(stacks, obj)
+			}
+			if !obj.IsClosed() {
+				collect(obj.Objects())
+			}
+		}
+	}
+	collect(a.inventory.Objects())
+	return stacks
+}
+
+// Buy buys the Object from the shopkeeper, which must be in the same
+// Location and have it in its inventory.
+func (a *Actor) Buy(shopkeeper *Actor, obj *Object) error {
+	_, err := a.syncRequestToZone(newShopTradeCommand(ShopTradeActionBuy, a, shopkeeper, obj))
+	return err
+}
+
+// Sell sells the Object, which the Actor must be holding, to the shopkeeper.
+func (a *Actor) Sell(shopkeeper *Actor, obj *Object) error {
+	_, err := a.syncRequestToZone(newShopTradeCommand(ShopTradeActionSell, a, shopkeeper, obj))
+	return err
+}
+
+//////// Zone-side processing
+
+// coinDebitEvents returns the events needed to take the given number of coins
+// from the Actor's coin stacks, which must hold at least that many.
+func (z *Zone) coinDebitEvents(a *Actor, amount int) []Event {
+	var events []Event
+	for _, stack := range a.coinStacks() {
+		if amount <= 0 {
+			break
+		}
+		if stack.Coins() > amount {
+			events = append(events, NewObjectCoinsEvent(stack.ID(), z.id, stack.Coins()-amount))
+			break
+		}
+		amount -= stack.Coins()
+		events = append(events, NewObjectRemoveFromZoneEvent(stack.Name(), stack.ID(), z.id))
+	}
+	return events
+}
+
+// coinCreditEvents returns the events needed to give the Actor the given
+// number of coins, added to a stack it already holds or else to a new stack
+// in the given subcontainer.
+func (z *Zone) coinCreditEvents(a *Actor, amount int, subcontainer string) []Event {
+	if amount <= 0 {
+		return nil
+	}
+	for _, obj := range a.inventory.Objects() {
+		if obj.IsCurrency() {
+			return []Event{NewObjectCoinsEvent(obj.ID(), z.id, obj.Coins()+amount)}
+		}
+	}
+	return []Event{NewCoinStackAddToZoneEvent(amount, uuid.Nil, a.ID(), uuid.Nil, z.id, subcontainer)}
+}
+
+// coinMergeEvents returns the events needed to merge a stack of coins into
+// another stack already in the same Container, if there is one.
+func (z *Zone) coinMergeEvents(stack *Object, c Container) []Event {
+	for _, obj := range c.Objects() {
+		if obj != stack && obj.IsCurrency() {
+			return []Event{
+				NewObjectCoinsEvent(obj.ID(), z.id, obj.Coins()+stack.Coins()),
+				NewObjectRemoveFromZoneEvent(stack.Name(), stack.ID(), z.id),
+			}
+		}
+	}
+	return nil
+}
+
+func (z *Zone) processShopTradeCommand(c Command) ([]Event, error) {
+	cmd := c.(*shopTradeCommand)
+
+	for _, actor := range []*Actor{cmd.customer, cmd.shopkeeper} {
+		_, found := z.actorsById[actor.ID()]
+		if !found || actor.Zone() != z {
+			return nil, errors.New("Actor not in Zone")
+		}
+	}
+	_, found := z.objectsById[cmd.obj.ID()]
+	if !found {
+		return nil, fmt.Errorf("unknown Object %q", cmd.obj.ID())
+	}
+	if cmd.customer.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if cmd.obj.lockedInTrade() {
+		return nil, ErrObjectLockedInTrade
+	}
+	rules := cmd.shopkeeper.Shop()
+	if rules == nil {
+		return nil, ErrNotShopkeeper
+	}
+	if cmd.shopkeeper.Location() != cmd.customer.Location() || cmd.shopkeeper.IsGhost() {
+		return nil, ErrShopkeeperNotHere
+	}
+
+	var seller, buyer *Actor
+	var price int
+	var toSubcontainer string
+	switch cmd.action {
+	case ShopTradeActionBuy:
+		seller, buyer = cmd.shopkeeper, cmd.customer
+		if cmd.obj.Container() != seller || cmd.obj.IsCurrency() {
+			return nil, ErrShopNotForSale
+		}
+		price = rules.SellPrice(cmd.obj)
+		if buyer.spendableCoins() < price {
+			return nil, ErrCannotAfford
+		}
+		// purchases are handed over, just like picking something up
+		toSubcontainer = InventoryContainerHands
+		if err := buyer.inventory.checkAddObject(cmd.obj, toSubcontainer); err != nil {
+			return nil, err
+		}
+	case ShopTradeActionSell:
+		seller, buyer = cmd.customer, cmd.shopkeeper
+		if cmd.obj.Container() != seller {
+			return nil, errors.New("Actor is not holding that Object")
+		}
+		if !rules.WillBuy(cmd.obj) {
+			return nil, ErrShopWillNotBuy
+		}
+		if cmd.obj.IsStolen() {
+			return nil, ErrShopWillNotBuyStolen
+		}
+		if len(cmd.obj.Objects()) > 0 {
+			return nil, ErrShopContainerNotEmpty
+		}
+		price = rules.BuyPrice(cmd.obj)
+		if price <= 0 {
+			return nil, ErrObjectWorthless
+		}
+		if buyer.spendableCoins() < price {
+			return nil, ErrShopCannotAfford
+		}
+		// shopkeepers stow their stock wherever it fits
+		toSubcontainer = buyer.inventory.roomFor(cmd.obj)
+		if toSubcontainer == "" {
+			return nil, ErrShopNoRoom
+		}
+	default:
+		return nil, fmt.Errorf("unknown trade action %q", cmd.action)
+	}
+
+	// the goods are handed over by the seller
+	moveEv := NewObjectMoveEvent(cmd.obj.ID(), seller.ID(), z.id)
+	moveEv.FromActorContainerID = seller.ID()
+	moveEv.ToActorContainerID = buyer.ID()
+	moveEv.ToSubcontainer = toSubcontainer
+
+	events := []Event{
+		NewShopTradeEvent(
+			cmd.action,
+			cmd.shopkeeper.ID(),
+			cmd.customer.ID(),
+			cmd.obj.ID(),
+			z.id,
+			cmd.shopkeeper.Name(),
+			cmd.customer.Name(),
+			cmd.obj.Name(),
+			price,
+		),
+		moveEv,
+	}
+	events = append(events, z.ownershipEventsForTransfer(cmd.obj, seller, buyer, OwnershipReasonPurchased)...)
+	events = append(events, z.coinDebitEvents(buyer, price)...)
+	// if the seller needs a new stack for its takings, the goods just left
+	// room for it
+	events = append(events, z.coinCreditEvents(seller, price, seller.SubcontainerFor(cmd.obj))...)
+	return z.sequenceAndApplyEvents(events)
+}
+
+func (z *Zone) processActorSetShopCommand(c Command) ([]Event, error) {
+	cmd := c.(actorSetShopCommand)
+	e := cmd.wrappedEvent
+
+	if _, found := z.actorsById[e.ActorID]; !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	if e.Shop != nil && (e.Shop.SellRate < 0 || e.Shop.BuyRate < 0) {
+		return nil, errors.New("shop rates can't be negative")
+	}
+
+	return z.sequenceAndApplyEvents([]Event{e})
+}
+
+func (z *Zone) applyObjectCoinsEvent(e *ObjectCoinsEvent) (ObserverList, error) {
+	obj, found := z.objectsById[e.ObjectID]
+	if !found {
+		return nil, fmt.Errorf("unknown Object %q", e.ObjectID)
+	}
+	obj.attributes.Coins = e.Coins
+	return obj.Location().Observers(), nil
+}
+
+func (z *Zone) applyShopTradeEvent(e *ShopTradeEvent) (ObserverList, error) {
+	shopkeeper, found := z.actorsById[e.ShopkeeperID]
+	if !found {
+		return nil, fmt.Errorf("cannot find shopkeeper %q", e.ShopkeeperID)
+	}
+	return shopkeeper.Location().Observers(), nil
+}
+
+func (z *Zone) applyActorSetShopEvent(e *ActorSetShopEvent) error {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	actor.rwlock.Lock()
+	defer actor.rwlock.Unlock()
+	actor.shop = e.Shop
+	return nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newShopTradeCommand(action string, customer, shopkeeper *Actor, obj *Object) *shopTradeCommand {
+	return &shopTradeCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeShopTrade},
+		action:         action,
+		customer:       customer,
+		shopkeeper:     shopkeeper,
+		obj:            obj,
+	}
+}
+
+type shopTradeCommand struct {
+	commandGeneric
+	action               string
+	customer, shopkeeper *Actor
+	obj                  *Object
+}
+
+func newActorSetShopCommand(wrapped *ActorSetShopEvent) actorSetShopCommand {
+	return actorSetShopCommand{
+		commandGeneric{commandType: CommandTypeActorSetShop},
+		wrapped,
+	}
+}
+
+type actorSetShopCommand struct {
+	commandGeneric
+	wrappedEvent *ActorSetShopEvent
+}
+
+// NewCoinStackAddToZoneEvent creates a new stack of coins in the given
+// Container.
+// NewCoinStack returns a new pile of the given number of coins, to be added to
+// a Zone with Zone.AddObject.
+func NewCoinStack(coins int, container Container, zone *Zone) *Object {
+	return NewObject(
+		uuid.Nil,
+		CoinStackName,
+		CoinStackDescription,
+		CoinStackKeywords,
+		container,
+		0,
+		zone,
+		ObjectAttributes{Coins: coins},
+	)
+}
+
+func NewCoinStackAddToZoneEvent(coins int, locationContainerID, actorContainerID, objectContainerID, zoneID uuid.UUID, subcontainer string) *ObjectAddToZoneEvent {
+	return NewObjectAddToZoneEvent(
+		CoinStackName,
+		CoinStackDescription,
+		CoinStackKeywords,
+		0,
+		myuuid.NewId(),
+		locationContainerID,
+		actorContainerID,
+		objectContainerID,
+		zoneID,
+		subcontainer,
+		ObjectAttributes{Coins: coins},
+	)
+}
+
+func NewObjectCoinsEvent(objectID, zoneID uuid.UUID, coins int) *ObjectCoinsEvent {
+	return &ObjectCoinsEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeObjectCoins,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ObjectID: objectID,
+		Coins:    coins,
+	}
+}
+
+// ObjectCoinsEvent records the number of coins in a stack changing.
+type ObjectCoinsEvent struct {
+	*eventGeneric
+	ObjectID uuid.UUID
+	Coins    int
+}
+
+func NewShopTradeEvent(action string, shopkeeperID, customerID, objectID, zoneID uuid.UUID, shopkeeperName, customerName, objectName string, price int) *ShopTradeEvent {
+	return &ShopTradeEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeShopTrade,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		Action:         action,
+		ShopkeeperID:   shopkeeperID,
+		CustomerID:     customerID,
+		ObjectID:       objectID,
+		ShopkeeperName: shopkeeperName,
+		CustomerName:   customerName,
+		ObjectName:     objectName,
+		Price:          price,
+	}
+}
+
+// ShopTradeEvent records a customer buying an Object from, or selling one to,
+// a shopkeeper (according to Action, one of the ShopTradeAction* constants).
+// It's followed by the events which move the goods and coins.
+type ShopTradeEvent struct {
+	*eventGeneric
+	Action                                   string
+	ShopkeeperID, CustomerID, ObjectID       uuid.UUID
+	ShopkeeperName, CustomerName, ObjectName string
+	Price                                    int
+}
+
+func NewActorSetShopEvent(actorID, zoneID uuid.UUID, shop *ShopRules) *ActorSetShopEvent {
+	return &ActorSetShopEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorSetShop,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID: actorID,
+		Shop:    shop,
+	}
+}
+
+type ActorSetShopEvent struct {
+	*eventGeneric
+	ActorID uuid.UUID
+	Shop    *ShopRules
+}
@@ -18,4 +18,151 @@ type Skillset struct {
 	Sorcery, SorceryCap         float64
 	Mysticism, MysticismCap     float64
 	Inscription, InscriptionCap float64
+
+	// trade skills
+	Crafting, CraftingCap float64
+}
+
+// reducedBy returns a copy of the Skillset with each skill's current value
+// reduced by the given fraction. Caps are unaffected.
+func (s Skillset) reducedBy(fraction float64) Skillset {
+	if fraction <= 0 {
+		return s
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	keep := 1.0 - fraction
+	s.Slashing *= keep
+	s.Stabbing *= keep
+	s.Bashing *= keep
+	s.Biting *= keep
+	s.Dodging *= keep
+	s.Deflecting *= keep
+	s.Blocking *= keep
+	s.Sorcery *= keep
+	s.Mysticism *= keep
+	s.Inscription *= keep
+	s.Crafting *= keep
+	return s
+}
+
+// modifiedBy returns a copy of the Skillset with each skill's current value
+// adjusted by the corresponding value in mod, e.g. by a status effect. Caps
+// are unaffected, and no skill is reduced below zero.
+func (s Skillset) modifiedBy(mod Skillset) Skillset {
+	clamp := func(v float64) float64 {
+		if v < 0 {
+			return 0
+		}
+		return v
+	}
+	s.Slashing = clamp(s.Slashing + mod.Slashing)
+	s.Stabbing = clamp(s.Stabbing + mod.Stabbing)
+	s.Bashing = clamp(s.Bashing + mod.Bashing)
+	s.Biting = clamp(s.Biting + mod.Biting)
+	s.Dodging = clamp(s.Dodging + mod.Dodging)
+	s.DodgingTechniques += mod.DodgingTechniques
+	if s.DodgingTechniques < 0 {
+		s.DodgingTechniques = 0
+	}
+	s.Deflecting = clamp(s.Deflecting + mod.Deflecting)
+	s.Blocking = clamp(s.Blocking + mod.Blocking)
+	s.Sorcery = clamp(s.Sorcery + mod.Sorcery)
+	s.Mysticism = clamp(s.Mysticism + mod.Mysticism)
+	s.Inscription = clamp(s.Inscription + mod.Inscription)
+	s.Crafting = clamp(s.Crafting + mod.Crafting)
+	return s
+}
+
+// SkillCrafting names the Crafting skill; see Skillset.skill.
+const SkillCrafting = "crafting"
+
+// skill returns the current value of the named skill, e.g. "slashing" or
+// SkillCrafting, and its cap. The final return value is false if the
+// Skillset has no skill by that name.
+func (s Skillset) skill(name string) (value, limit float64, ok bool) {
+	switch name {
+	case "slashing":
+		return s.Slashing, s.SlashingCap, true
+	case "stabbing":
+		return s.Stabbing, s.StabbingCap, true
+	case "bashing":
+		return s.Bashing, s.BashingCap, true
+	case "biting":
+		return s.Biting, s.BitingCap, true
+	case "dodging":
+		return s.Dodging, s.DodgingCap, true
+	case "deflecting":
+		return s.Deflecting, s.DeflectingCap, true
+	case "blocking":
+		return s.Blocking, s.BlockingCap, true
+	case "sorcery":
+		return s.Sorcery, s.SorceryCap, true
+	case "mysticism":
+		return s.Mysticism, s.MysticismCap, true
+	case "inscription":
+		return s.Inscription, s.InscriptionCap, true
+	case SkillCrafting:
+		return s.Crafting, s.CraftingCap, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// withSkill returns a copy of the Skillset with the named skill's current
+// value set to v.
+func (s Skillset) withSkill(name string, v float64) Skillset {
+	switch name {
+	case "slashing":
+		s.Slashing = v
+	case "stabbing":
+		s.Stabbing = v
+	case "bashing":
+		s.Bashing = v
+	case "biting":
+		s.Biting = v
+	case "dodging":
+		s.Dodging = v
+	case "deflecting":
+		s.Deflecting = v
+	case "blocking":
+		s.Blocking = v
+	case "sorcery":
+		s.Sorcery = v
+	case "mysticism":
+		s.Mysticism = v
+	case "inscription":
+		s.Inscription = v
+	case SkillCrafting:
+		s.Crafting = v
+	}
+	return s
+}
+
+// TechniqueDodging names the dodging techniques, which can be taught by
+// reading a scroll. Each technique known grants another chance to dodge an
+// incoming attack; see Skillset.DodgingTechniques.
+const TechniqueDodging = "dodging"
+
+// techniques returns how many of the named techniques are known, and how many
+// can be known at most. The final return value is false if the Skillset has no
+// techniques by that name.
+func (s Skillset) techniques(name string) (known, limit int, ok bool) {
+	switch name {
+	case TechniqueDodging:
+		return s.DodgingTechniques, s.DodgingTechniquesCap, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// withTechniques returns a copy of the Skillset with the number of the named
+// techniques known set to n.
+func (s Skillset) withTechniques(name string, n int) Skillset {
+	switch name {
+	case TechniqueDodging:
+		s.DodgingTechniques = n
+	}
+	return s
 }
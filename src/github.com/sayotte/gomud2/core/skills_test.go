@@ -0,0 +1,127 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSkillset_reducedBy(t *testing.T) {
+	full := Skillset{
+		Slashing: 40, SlashingCap: 50,
+		Stabbing: 40, StabbingCap: 50,
+		Bashing: 40, BashingCap: 50,
+		Biting: 40, BitingCap: 50,
+		Dodging: 40, DodgingCap: 50,
+		DodgingTechniques: 2, DodgingTechniquesCap: 3,
+		Deflecting: 40, DeflectingCap: 50,
+		Blocking: 40, BlockingCap: 50,
+		Sorcery: 40, SorceryCap: 50,
+		Mysticism: 40, MysticismCap: 50,
+		Inscription: 40, InscriptionCap: 50,
+		Crafting: 40, CraftingCap: 50,
+	}
+	quartered := Skillset{
+		Slashing: 30, SlashingCap: 50,
+		Stabbing: 30, StabbingCap: 50,
+		Bashing: 30, BashingCap: 50,
+		Biting: 30, BitingCap: 50,
+		Dodging: 30, DodgingCap: 50,
+		DodgingTechniques: 2, DodgingTechniquesCap: 3,
+		Deflecting: 30, DeflectingCap: 50,
+		Blocking: 30, BlockingCap: 50,
+		Sorcery: 30, SorceryCap: 50,
+		Mysticism: 30, MysticismCap: 50,
+		Inscription: 30, InscriptionCap: 50,
+		Crafting: 30, CraftingCap: 50,
+	}
+	emptied := Skillset{
+		SlashingCap:          50,
+		StabbingCap:          50,
+		BashingCap:           50,
+		BitingCap:            50,
+		DodgingCap:           50,
+		DodgingTechniques:    2,
+		DodgingTechniquesCap: 3,
+		DeflectingCap:        50,
+		BlockingCap:          50,
+		SorceryCap:           50,
+		MysticismCap:         50,
+		InscriptionCap:       50,
+		CraftingCap:          50,
+	}
+
+	testCases := map[string]struct {
+		fraction       float64
+		expectedSkills Skillset
+	}{
+		"zero fraction":       {fraction: 0, expectedSkills: full},
+		"negative fraction":   {fraction: -0.5, expectedSkills: full},
+		"quarter":             {fraction: 0.25, expectedSkills: quartered},
+		"whole":               {fraction: 1, expectedSkills: emptied},
+		"more than the whole": {fraction: 2, expectedSkills: emptied},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			skills := full.reducedBy(tc.fraction)
+			if !reflect.DeepEqual(skills, tc.expectedSkills) {
+				t.Errorf("expected %+v, got %+v", tc.expectedSkills, skills)
+			}
+		})
+	}
+}
+
+func TestSkillset_modifiedBy(t *testing.T) {
+	base := Skillset{
+		Slashing: 40, SlashingCap: 50,
+		Stabbing: 40, StabbingCap: 50,
+		Bashing: 40, BashingCap: 50,
+		Biting: 40, BitingCap: 50,
+		Dodging: 40, DodgingCap: 50,
+		DodgingTechniques: 2, DodgingTechniquesCap: 3,
+		Deflecting: 40, DeflectingCap: 50,
+		Blocking: 40, BlockingCap: 50,
+		Sorcery: 40, SorceryCap: 50,
+		Mysticism: 40, MysticismCap: 50,
+		Inscription: 40, InscriptionCap: 50,
+		Crafting: 40, CraftingCap: 50,
+	}
+	buffed := base
+	buffed.Slashing = 55
+	buffed.DodgingTechniques = 3
+	buffed.Crafting = 42.5
+	debuffed := base
+	debuffed.Sorcery = 0
+	debuffed.DodgingTechniques = 0
+	debuffed.Blocking = 30
+
+	testCases := map[string]struct {
+		mod            Skillset
+		expectedSkills Skillset
+	}{
+		"no modifier": {
+			expectedSkills: base,
+		},
+		"buff may exceed the cap": {
+			mod:            Skillset{Slashing: 15, DodgingTechniques: 1, Crafting: 2.5},
+			expectedSkills: buffed,
+		},
+		"debuff stops at zero": {
+			mod:            Skillset{Sorcery: -60, DodgingTechniques: -5, Blocking: -10},
+			expectedSkills: debuffed,
+		},
+		"caps are unaffected": {
+			mod:            Skillset{SlashingCap: 10, DodgingTechniquesCap: 1, CraftingCap: -50},
+			expectedSkills: base,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			skills := base.modifiedBy(tc.mod)
+			if !reflect.DeepEqual(skills, tc.expectedSkills) {
+				t.Errorf("expected %+v, got %+v", tc.expectedSkills, skills)
+			}
+		})
+	}
+}
@@ -0,0 +1,378 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Reactions are the spells of sorcery, explicitly invoked by name. See the
+// "sorcery" section of combatPlan.md.
+
+const (
+	ReactionEffectDamage = "damage"
+	ReactionEffectHeal   = "heal"
+)
+
+// Per combatPlan.md, Focus improves the magnitude of a reaction by up to 50%.
+const sorceryFocusBonusMax = 0.50
+
+// ActorInvokeDelay is the base delay following the invocation of a reaction.
+var ActorInvokeDelay = time.Millisecond * 1500
+
+var (
+	ErrReactionUnknown        = errors.New("no such reaction")
+	ErrReactionNotKnown       = errors.New("Actor does not know that reaction")
+	ErrReactionAlreadyKnown   = errors.New("Actor already knows that reaction")
+	ErrReactionFailed         = errors.New("reaction failed")
+	ErrActorInsufficientFocus = errors.New("Actor lacks the focus to invoke that reaction")
+)
+
+// Reaction describes a sorcerous spell.
+type Reaction struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// SkillRequirement is the Sorcery skill at which the reaction succeeds
+	// 75% of the time.
+	SkillRequirement float64 `yaml:"skillRequirement"`
+	FocusCost        int     `yaml:"focusCost"`
+	// Effect is one of the ReactionEffect* constants.
+	Effect       string  `yaml:"effect"`
+	MagnitudeMin float64 `yaml:"magnitudeMin"`
+	MagnitudeMax float64 `yaml:"magnitudeMax"`
+}
+
+var (
+	reactionsLock   = &sync.RWMutex{}
+	reactionsByName = make(map[string]Reaction)
+)
+
+// SetReactions replaces the set of reactions known to the World, typically
+// with definitions loaded from a data file at startup.
+func SetReactions(reactions []Reaction) error {
+	byName := make(map[string]Reaction, len(reactions))
+	for _, r := range reactions {
+		if r.Name == "" {
+			return errors.New("reaction with empty name")
+		}
+		if _, duplicate := byName[r.Name]; duplicate {
+			return fmt.Errorf("duplicate reaction %q", r.Name)
+		}
+		switch r.Effect {
+		case ReactionEffectDamage, ReactionEffectHeal:
+		default:
+			return fmt.Errorf("reaction %q has unknown effect %q", r.Name, r.Effect)
+		}
+		if r.MagnitudeMax < r.MagnitudeMin {
+			return fmt.Errorf("reaction %q has magnitudeMax < magnitudeMin", r.Name)
+		}
+		byName[r.Name] = r
+	}
+
+	reactionsLock.Lock()
+	defer reactionsLock.Unlock()
+	reactionsByName = byName
+	return nil
+}
+
+func ReactionByName(name string) (Reaction, bool) {
+	reactionsLock.RLock()
+	defer reactionsLock.RUnlock()
+	r, found := reactionsByName[name]
+	return r, found
+}
+
+func Reactions() []Reaction {
+	reactionsLock.RLock()
+	defer reactionsLock.RUnlock()
+	out := make([]Reaction, 0, len(reactionsByName))
+	for _, r := range reactionsByName {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// SorcerySuccessChance returns the chance (0.0 - 1.0) that a reaction with the
+// given skill requirement succeeds when invoked with the given Sorcery skill.
+// Per combatPlan.md, the chance is 0% at 20 points below the requirement, 50%
+// at 10 below, 75% at the requirement and 100% at 10 above, varying linearly
+// in between.
+func SorcerySuccessChance(skill, requirement float64) float64 {
+	delta := skill - requirement
+	switch {
+	case delta <= -20:
+		return 0.0
+	case delta <= -10:
+		return 0.50 * (delta + 20) / 10
+	case delta <= 0:
+		return 0.50 + 0.25*(delta+10)/10
+	case delta < 10:
+		return 0.75 + 0.25*delta/10
+	default:
+		return 1.0
+	}
+}
+
+//////// Actor methods
+
+func (a *Actor) KnownReactions() []string {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	out := make([]string, len(a.knownReactions))
+	copy(out, a.knownReactions)
+	return out
+}
+
+func (a *Actor) KnowsReaction(name string) bool {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	for _, known := range a.knownReactions {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Actor) addKnownReaction(name string) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	a.knownReactions = append(a.knownReactions, name)
+}
+
+func (a *Actor) InvokeDelay() time.Duration {
+	return ActionDelay(ActorInvokeDelay, a.Attributes())
+}
+
+// Invoke invokes the named reaction on the target. If the invocation fails,
+// its focus cost is still paid and ErrReactionFailed is returned.
+func (a *Actor) Invoke(reactionName string, target *Actor) error {
+	var succeeded bool
+	err := a.doDelayedAction(a.InvokeDelay(), func() error {
+		val, err := a.syncRequestToZone(newSorceryInvokeCommand(a, target, reactionName))
+		if err == nil {
+			succeeded = val.(bool)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if !succeeded {
+		return ErrReactionFailed
+	}
+	return nil
+}
+
+//////// Zone-side processing
+
+func (z *Zone) processSorceryInvokeCommand(c Command) (interface{}, []Event, error) {
+	cmd := c.(*sorceryInvokeCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, nil, errors.New("Actor not in Zone")
+	}
+	_, found = z.actorsById[cmd.target.ID()]
+	if !found || cmd.target.Zone() != z {
+		return nil, nil, errors.New("target Actor not in Zone")
+	}
+	if cmd.actor.Location() != cmd.target.Location() {
+		return nil, nil, errors.New("invoker and target not in the same Location")
+	}
+	if cmd.actor.IsGhost() || cmd.target.IsGhost() {
+		return nil, nil, ErrActorIsGhost
+	}
+
+	reaction, found := ReactionByName(cmd.reactionName)
+	if !found {
+		return nil, nil, ErrReactionUnknown
+	}
+	if !cmd.actor.KnowsReaction(reaction.Name) {
+		return nil, nil, ErrReactionNotKnown
+	}
+	// spending the last of one's focus is fatal, so don't allow it
+	if cmd.actor.Attributes().Focus <= reaction.FocusCost {
+		return nil, nil, ErrActorInsufficientFocus
+	}
+
+	chance := SorcerySuccessChance(cmd.actor.Skills().Sorcery, reaction.SkillRequirement)
+	success := rollFloat64(z.Rand()) < chance
+
+	var magnitude int
+	if success {
+		baseMagnitude := (rollFloat64(z.Rand()) * (reaction.MagnitudeMax - reaction.MagnitudeMin)) + reaction.MagnitudeMin
+		focBonus := (float64(cmd.actor.Attributes().Focus) / 100) * sorceryFocusBonusMax * baseMagnitude
+		magnitude = int(math.Ceil(baseMagnitude + focBonus))
+	}
+
+	invokeEv := NewSorceryInvokeEvent(
+		reaction.Name,
+		reaction.Effect,
+		cmd.actor.ID(),
+		cmd.target.ID(),
+		z.id,
+		cmd.actor.Name(),
+		cmd.target.Name(),
+		success,
+		reaction.FocusCost,
+		magnitude,
+	)
+	outEvents := []Event{invokeEv}
+	if success && reaction.Effect == ReactionEffectDamage {
+		outEvents = append(outEvents, z.crimeEventsForAssault(cmd.actor, cmd.target)...)
+		if cmd.target.Attributes().Physical-magnitude <= 0 {
+			outEvents = append(outEvents, doActorDeath(cmd.target, cmd.actor, z)...)
+		} else if cmd.target != cmd.actor {
+			// a harmful reaction starts a fight, if there isn't one going already
+			outEvents = append(outEvents, z.engageEventsFor(cmd.actor, cmd.target)...)
+		}
+	}
+
+	applied, err := z.sequenceAndApplyEvents(outEvents)
+	if err != nil {
+		return nil, applied, err
+	}
+	return success, applied, nil
+}
+
+func (z *Zone) applySorceryInvokeEvent(e *SorceryInvokeEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find invoking Actor %q", e.ActorID)
+	}
+	target, found := z.actorsById[e.TargetID]
+	if !found {
+		return nil, fmt.Errorf("cannot find target Actor %q", e.TargetID)
+	}
+
+	actorAttrs := actor.Attributes()
+	actorAttrs.Focus -= e.FocusCost
+	actor.setAttributes(actorAttrs)
+
+	if e.Success {
+		targetAttrs := target.Attributes()
+		switch e.Effect {
+		case ReactionEffectDamage:
+			targetAttrs.Physical -= e.Magnitude
+		case ReactionEffectHeal:
+			// healing can't raise Physical beyond what respawning would
+			targetAttrs.Physical += e.Magnitude
+			maxPhys := respawnAttributes(targetAttrs).Physical
+			if targetAttrs.Physical > maxPhys {
+				targetAttrs.Physical = maxPhys
+			}
+		}
+		target.setAttributes(targetAttrs)
+	}
+
+	dedupeObserverMap := make(map[Observer]struct{})
+	for _, o := range actor.Observers() {
+		dedupeObserverMap[o] = struct{}{}
+	}
+	for _, o := range target.Observers() {
+		dedupeObserverMap[o] = struct{}{}
+	}
+	for _, o := range target.Location().Observers() {
+		dedupeObserverMap[o] = struct{}{}
+	}
+
+	oList := make(ObserverList, 0, len(dedupeObserverMap))
+	for o := range dedupeObserverMap {
+		oList = append(oList, o)
+	}
+	return oList, nil
+}
+
+func (z *Zone) applyActorLearnReactionEvent(e *ActorLearnReactionEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q to teach", e.ActorID)
+	}
+	known := len(actor.KnownReactions())
+	actor.addKnownReaction(e.ReactionName)
+	actor.recordProgression([]ProgressionEntry{{
+		At:     e.Timestamp(),
+		Reason: ProgressionReasonReading,
+		Trait:  TraitKnownReactions,
+		From:   float64(known),
+		To:     float64(known + 1),
+		Detail: e.ReactionName,
+	}})
+	return actor.Location().Observers(), nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newSorceryInvokeCommand(actor, target *Actor, reactionName string) *sorceryInvokeCommand {
+	return &sorceryInvokeCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeSorceryInvoke},
+		actor:          actor,
+		target:         target,
+		reactionName:   reactionName,
+	}
+}
+
+type sorceryInvokeCommand struct {
+	commandGeneric
+	actor, target *Actor
+	reactionName  string
+}
+
+func NewSorceryInvokeEvent(reactionName, effect string, actorID, targetID, zoneID uuid.UUID, actorName, targetName string, success bool, focusCost, magnitude int) *SorceryInvokeEvent {
+	return &SorceryInvokeEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeSorceryInvoke,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ReactionName: reactionName,
+		Effect:       effect,
+		ActorID:      actorID,
+		TargetID:     targetID,
+		ActorName:    actorName,
+		TargetName:   targetName,
+		Success:      success,
+		FocusCost:    focusCost,
+		Magnitude:    magnitude,
+	}
+}
+
+type SorceryInvokeEvent struct {
+	*eventGeneric
+	ReactionName, Effect  string
+	ActorID, TargetID     uuid.UUID
+	ActorName, TargetName string
+	Success               bool
+	FocusCost, Magnitude  int
+}
+
+func NewActorLearnReactionEvent(reactionName, actorName string, actorID, scrollID, zoneID uuid.UUID) *ActorLearnReactionEvent {
+	return &ActorLearnReactionEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorLearnReaction,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ReactionName: reactionName,
+		ActorName:    actorName,
+		ActorID:      actorID,
+		ScrollID:     scrollID,
+	}
+}
+
+type ActorLearnReactionEvent struct {
+	*eventGeneric
+	ReactionName, ActorName string
+	ActorID, ScrollID       uuid.UUID
+}
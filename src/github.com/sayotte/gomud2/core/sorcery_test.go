@@ -0,0 +1,33 @@
+package core
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSorcerySuccessChance(t *testing.T) {
+	testCases := map[string]struct {
+		skill, requirement float64
+		expectedChance     float64
+	}{
+		"far below requirement":    {skill: 0, requirement: 50, expectedChance: 0},
+		"20 below requirement":     {skill: 30, requirement: 50, expectedChance: 0},
+		"15 below requirement":     {skill: 35, requirement: 50, expectedChance: 0.25},
+		"10 below requirement":     {skill: 40, requirement: 50, expectedChance: 0.50},
+		"5 below requirement":      {skill: 45, requirement: 50, expectedChance: 0.625},
+		"at requirement":           {skill: 50, requirement: 50, expectedChance: 0.75},
+		"5 above requirement":      {skill: 55, requirement: 50, expectedChance: 0.875},
+		"10 above requirement":     {skill: 60, requirement: 50, expectedChance: 1},
+		"far above requirement":    {skill: 100, requirement: 50, expectedChance: 1},
+		"no skill, no requirement": {skill: 0, requirement: 0, expectedChance: 0.75},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			chance := SorcerySuccessChance(tc.skill, tc.requirement)
+			if math.Abs(chance-tc.expectedChance) > 1e-9 {
+				t.Errorf("expected %f, got %f", tc.expectedChance, chance)
+			}
+		})
+	}
+}
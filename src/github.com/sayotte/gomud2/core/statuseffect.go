@@ -0,0 +1,443 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+// Status effects are temporary conditions on an Actor: buffs and debuffs,
+// which adjust its attributes and skills for as long as they last, and
+// effects which tick, changing its Physical, Stamina or Focus every so often,
+// like a poison wearing it down. They're put on an Actor by things it
+// consumes, among others.
+//
+// An Actor's own attributes and skills are never changed by a buff or
+// debuff; instead, EffectiveAttributes and EffectiveSkills return them as
+// modified by whatever is affecting it, and combat uses those. Ticks do
+// change the Actor's own attributes, as damage in combat does.
+//
+// Every status effect starting, ticking and ending is recorded as an event of
+// its own, so that they're restored along with everything else when the
+// Zone's events are replayed.
+
+const (
+	EffectAttributePhysical = "physical"
+	EffectAttributeStamina  = "stamina"
+	EffectAttributeFocus    = "focus"
+
+	// StatusEffectStackingRefresh replaces any effect of the same name
+	// already on the Actor, so that it starts over. This is the default.
+	StatusEffectStackingRefresh = "refresh"
+	// StatusEffectStackingStack adds the effect alongside any of the same
+	// name, up to MaxStacks of them, replacing the oldest beyond that.
+	StatusEffectStackingStack = "stack"
+	// StatusEffectStackingIgnore leaves any effect of the same name alone,
+	// and the new one has no effect.
+	StatusEffectStackingIgnore = "ignore"
+
+	EffectEndReasonExpired  = "expired"
+	EffectEndReasonReplaced = "replaced"
+	EffectEndReasonDeath    = "death"
+)
+
+var effectCheckInterval = time.Second
+
+// StatusEffect describes a temporary condition on an Actor.
+type StatusEffect struct {
+	// Name describes an Actor under the effect, e.g. "poisoned"; effects of
+	// the same name stack according to Stacking, one of the
+	// StatusEffectStacking* constants.
+	Name      string `yaml:"name"`
+	Source    string `yaml:"source,omitempty"`
+	Stacking  string `yaml:"stacking,omitempty"`
+	MaxStacks int    `yaml:"maxStacks,omitempty"`
+	// Attributes and Skills adjust the Actor's own for as long as the effect
+	// lasts; negative values make for a debuff. Caps are unaffected.
+	Attributes AttributeSet `yaml:"attributes,omitempty"`
+	Skills     Skillset     `yaml:"skills,omitempty"`
+	// Every TickInterval, TickAttribute (one of the EffectAttribute*
+	// constants) changes by TickDelta; it's never restored beyond what
+	// respawning would.
+	TickAttribute string        `yaml:"tickAttribute,omitempty"`
+	TickDelta     int           `yaml:"tickDelta,omitempty"`
+	TickInterval  time.Duration `yaml:"tickInterval,omitempty"`
+	// Duration is how long the effect lasts; if zero, it lasts until the
+	// Actor dies.
+	Duration time.Duration `yaml:"duration,omitempty"`
+}
+
+// ActiveEffect is a status effect on an Actor, until it ends.
+type ActiveEffect struct {
+	ID     uuid.UUID
+	Effect StatusEffect
+	Start  time.Time
+	// Ticks is how many times the effect has ticked so far.
+	Ticks int
+}
+
+// Until returns when the effect ends, or the zero time if it lasts until the
+// Actor dies.
+func (ae ActiveEffect) Until() time.Time {
+	if ae.Effect.Duration == 0 {
+		return time.Time{}
+	}
+	return ae.Start.Add(ae.Effect.Duration)
+}
+
+func (ae ActiveEffect) expired(now time.Time) bool {
+	return ae.Effect.Duration > 0 && !now.Before(ae.Until())
+}
+
+// ticksDue returns how many times the effect should have ticked by now, less
+// the number of times it already has.
+func (ae ActiveEffect) ticksDue(now time.Time) int {
+	if ae.Effect.TickInterval <= 0 || ae.Effect.TickDelta == 0 {
+		return 0
+	}
+	if ae.expired(now) {
+		now = ae.Until()
+	}
+	return int(now.Sub(ae.Start)/ae.Effect.TickInterval) - ae.Ticks
+}
+
+// withAttributeDelta returns a copy of the AttributeSet with the given change
+// to the named attribute.
+func withAttributeDelta(attrs AttributeSet, attribute string, delta int) AttributeSet {
+	switch attribute {
+	case EffectAttributePhysical:
+		attrs.Physical += delta
+	case EffectAttributeStamina:
+		attrs.Stamina += delta
+	case EffectAttributeFocus:
+		attrs.Focus += delta
+	}
+	return attrs
+}
+
+// attributeValue returns the named attribute's value in the AttributeSet.
+func attributeValue(attrs AttributeSet, attribute string) int {
+	switch attribute {
+	case EffectAttributePhysical:
+		return attrs.Physical
+	case EffectAttributeStamina:
+		return attrs.Stamina
+	case EffectAttributeFocus:
+		return attrs.Focus
+	}
+	return 0
+}
+
+// cappedAttributeDelta limits a change to the named attribute so that it
+// isn't raised beyond what respawning would restore it to.
+func cappedAttributeDelta(attrs AttributeSet, attribute string, delta int) int {
+	if delta <= 0 {
+		return delta
+	}
+	room := attributeValue(respawnAttributes(attrs), attribute) - attributeValue(attrs, attribute)
+	if room < 0 {
+		room = 0
+	}
+	if delta > room {
+		return room
+	}
+	return delta
+}
+
+// isDeadly reports whether the AttributeSet's Physical, Stamina or Focus has
+// been exhausted, as would kill an Actor.
+func isDeadly(attrs AttributeSet) bool {
+	return attrs.Physical <= 0 || attrs.Stamina <= 0 || attrs.Focus <= 0
+}
+
+//////// Actor methods
+
+// ActiveEffects returns the status effects currently on the Actor, oldest
+// first.
+func (a *Actor) ActiveEffects() []ActiveEffect {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return append([]ActiveEffect(nil), a.activeEffects...)
+}
+
+// activeEffectsSnapshot returns the Actor's status effects. The slice must
+// not be modified.
+func (a *Actor) activeEffectsSnapshot() []ActiveEffect {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.activeEffects
+}
+
+// EffectiveAttributes returns the Actor's attributes as adjusted by its
+// status effects.
+func (a *Actor) EffectiveAttributes() AttributeSet {
+	attrs := a.Attributes()
+	for _, effect := range a.activeEffectsSnapshot() {
+		attrs = attrs.modifiedBy(effect.Effect.Attributes)
+	}
+	return attrs
+}
+
+// EffectiveSkills returns the Actor's skills as adjusted by its status
+// effects.
+func (a *Actor) EffectiveSkills() Skillset {
+	skills := a.Skills()
+	for _, effect := range a.activeEffectsSnapshot() {
+		skills = skills.modifiedBy(effect.Effect.Skills)
+	}
+	return skills
+}
+
+//////// Zone-side processing
+
+// statusEffectEventsFor returns the events putting a status effect on the
+// Actor, and ending any of the same name it replaces.
+func (z *Zone) statusEffectEventsFor(actor *Actor, effect StatusEffect) []Event {
+	var same []ActiveEffect
+	for _, active := range actor.ActiveEffects() {
+		if active.Effect.Name == effect.Name {
+			same = append(same, active)
+		}
+	}
+
+	var events []Event
+	switch effect.Stacking {
+	case StatusEffectStackingIgnore:
+		if len(same) > 0 {
+			return nil
+		}
+	case StatusEffectStackingStack:
+		for effect.MaxStacks > 0 && len(same) >= effect.MaxStacks {
+			events = append(events, NewActorEffectEndEvent(actor.ID(), z.id, actor.Name(), same[0], EffectEndReasonReplaced))
+			same = same[1:]
+		}
+	default:
+		for _, active := range same {
+			events = append(events, NewActorEffectEndEvent(actor.ID(), z.id, actor.Name(), active, EffectEndReasonReplaced))
+		}
+	}
+
+	return append(events, NewActorEffectStartEvent(
+		actor.ID(),
+		z.id,
+		actor.Name(),
+		ActiveEffect{
+			ID:     myuuid.NewId(),
+			Effect: effect,
+			Start:  time.Now(),
+		},
+	))
+}
+
+// processActorEffectCheckCommand ticks every status effect that's due,
+// killing those Actors it wears down too far, and ends every effect that has
+// expired.
+func (z *Zone) processActorEffectCheckCommand(c Command) ([]Event, error) {
+	var outEvents []Event
+	now := time.Now()
+
+	for _, actor := range z.actorsById {
+		var effectEvents, endEvents []Event
+		attrs := actor.Attributes()
+		for _, effect := range actor.ActiveEffects() {
+			for i := 0; i < effect.ticksDue(now); i++ {
+				// ticks that have nothing to restore still count as ticks
+				delta := cappedAttributeDelta(attrs, effect.Effect.TickAttribute, effect.Effect.TickDelta)
+				attrs = withAttributeDelta(attrs, effect.Effect.TickAttribute, delta)
+				effectEvents = append(effectEvents, NewActorEffectEvent(
+					actor.ID(),
+					effect.ID,
+					z.id,
+					actor.Name(),
+					effect.Effect.Source,
+					effect.Effect.Name,
+					effect.Effect.TickAttribute,
+					delta,
+				))
+			}
+			if effect.expired(now) {
+				endEvents = append(endEvents, NewActorEffectEndEvent(actor.ID(), z.id, actor.Name(), effect, EffectEndReasonExpired))
+			}
+		}
+		if isDeadly(attrs) && len(effectEvents) > 0 {
+			// whoever or whatever caused the effect, nobody is held
+			// responsible; dying ends every effect, expired or not
+			effectEvents = append(effectEvents, doActorDeath(actor, nil, z)...)
+		} else {
+			effectEvents = append(effectEvents, endEvents...)
+		}
+		if len(effectEvents) == 0 {
+			continue
+		}
+
+		applied, err := z.sequenceAndApplyEvents(effectEvents)
+		outEvents = append(outEvents, applied...)
+		if err != nil {
+			fmt.Printf("CORE ERROR: zone %q status effects on Actor %q: %s\n", z.Tag(), actor.ID(), err)
+		}
+	}
+
+	return outEvents, nil
+}
+
+// effectEndEventsFor returns the events ending every status effect on the
+// Actor, for the given reason.
+func (z *Zone) effectEndEventsFor(actor *Actor, reason string) []Event {
+	var events []Event
+	for _, effect := range actor.ActiveEffects() {
+		events = append(events, NewActorEffectEndEvent(actor.ID(), z.id, actor.Name(), effect, reason))
+	}
+	return events
+}
+
+func (z *Zone) applyActorEffectEvent(e *ActorEffectEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q affected by %q", e.ActorID, e.Source)
+	}
+	actor.setAttributes(withAttributeDelta(actor.Attributes(), e.Attribute, e.Delta))
+	if !uuid.Equal(e.EffectID, uuid.Nil) {
+		actor.rwlock.Lock()
+		// copy-on-write, as the old slice may be captured in a snapshot
+		effects := append([]ActiveEffect(nil), actor.activeEffects...)
+		for i := range effects {
+			if uuid.Equal(effects[i].ID, e.EffectID) {
+				effects[i].Ticks++
+			}
+		}
+		actor.activeEffects = effects
+		actor.rwlock.Unlock()
+	}
+	return actor.Observers(), nil
+}
+
+func (z *Zone) applyActorEffectStartEvent(e *ActorEffectStartEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q affected by %q", e.ActorID, e.Effect.Effect.Name)
+	}
+	actor.rwlock.Lock()
+	actor.activeEffects = append(append([]ActiveEffect(nil), actor.activeEffects...), e.Effect)
+	actor.rwlock.Unlock()
+	return actor.Location().Observers(), nil
+}
+
+func (z *Zone) applyActorEffectEndEvent(e *ActorEffectEndEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("cannot find Actor %q no longer %q", e.ActorID, e.Name)
+	}
+	actor.rwlock.Lock()
+	var effects []ActiveEffect
+	for _, effect := range actor.activeEffects {
+		if !uuid.Equal(effect.ID, e.EffectID) {
+			effects = append(effects, effect)
+		}
+	}
+	found = len(effects) < len(actor.activeEffects)
+	actor.activeEffects = effects
+	actor.rwlock.Unlock()
+	if !found {
+		return nil, fmt.Errorf("Actor %q isn't affected by %q", e.ActorID, e.EffectID)
+	}
+	return actor.Location().Observers(), nil
+}
+
+//////// Commands and events
+
+func newActorEffectCheckCommand() *actorEffectCheckCommand {
+	return &actorEffectCheckCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeActorEffectCheck},
+	}
+}
+
+type actorEffectCheckCommand struct {
+	commandGeneric
+}
+
+func NewActorEffectEvent(actorID, effectID, zoneID uuid.UUID, actorName, source, name, attribute string, delta int) *ActorEffectEvent {
+	return &ActorEffectEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorEffect,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		EffectID:  effectID,
+		ActorName: actorName,
+		Source:    source,
+		Name:      name,
+		Attribute: attribute,
+		Delta:     delta,
+	}
+}
+
+// ActorEffectEvent records one of an Actor's attributes changing by Delta,
+// either by a tick of the status effect EffectID, or immediately (with a nil
+// EffectID) because of something it consumed. Name is the status effect's,
+// or the kind of immediate effect.
+type ActorEffectEvent struct {
+	*eventGeneric
+	ActorID, EffectID uuid.UUID
+	ActorName         string
+	Source            string
+	Name              string
+	Attribute         string
+	Delta             int
+}
+
+func NewActorEffectStartEvent(actorID, zoneID uuid.UUID, actorName string, effect ActiveEffect) *ActorEffectStartEvent {
+	return &ActorEffectStartEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorEffectStart,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		ActorName: actorName,
+		Effect:    effect,
+	}
+}
+
+type ActorEffectStartEvent struct {
+	*eventGeneric
+	ActorID   uuid.UUID
+	ActorName string
+	Effect    ActiveEffect
+}
+
+func NewActorEffectEndEvent(actorID, zoneID uuid.UUID, actorName string, effect ActiveEffect, reason string) *ActorEffectEndEvent {
+	return &ActorEffectEndEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeActorEffectEnd,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: true,
+		},
+		ActorID:   actorID,
+		EffectID:  effect.ID,
+		ActorName: actorName,
+		Name:      effect.Effect.Name,
+		Source:    effect.Effect.Source,
+		Reason:    reason,
+	}
+}
+
+// ActorEffectEndEvent records a status effect on an Actor ending, for one of
+// the EffectEndReason* reasons.
+type ActorEffectEndEvent struct {
+	*eventGeneric
+	ActorID, EffectID uuid.UUID
+	ActorName         string
+	Name              string
+	Source            string
+	Reason            string
+}
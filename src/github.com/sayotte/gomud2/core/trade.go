@@ -0,0 +1,651 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// Two Actors can trade by each putting Objects and coins on offer and then
+// both accepting. Whatever an Actor has on offer is locked in its inventory
+// until the trade is over, and changing either side's offer withdraws both
+// acceptances. Once both have accepted, everything changes hands in a single
+// batch of events.
+//
+// Like combat engagements, trades live only as long as the Zone's in-memory
+// state; they're called off if either Actor leaves, dies or cancels.
+
+const (
+	TradeCancelReasonCancel   = "cancel"
+	TradeCancelReasonDeparted = "departed"
+	TradeCancelReasonDeath    = "death"
+)
+
+var (
+	ErrTradeWithSelf            = errors.New("Actor cannot trade with itself")
+	ErrAlreadyTrading           = errors.New("Actor is already trading with someone else")
+	ErrTradePartnerBusy         = errors.New("other Actor is already trading with someone else")
+	ErrNotTrading               = errors.New("Actor is not trading with anyone")
+	ErrTradePartnerGone         = errors.New("other Actor is no longer here to trade")
+	ErrTradePartnerNotJoined    = errors.New("other Actor has not joined the trade")
+	ErrTradeObjectNotHeld       = errors.New("Actor is not holding that Object")
+	ErrTradeOfferCurrency       = errors.New("coins must be offered by amount")
+	ErrTradePartnerCannotAfford = errors.New("other Actor cannot afford its offer")
+	ErrTradePartnerNoRoom       = errors.New("other Actor has no room for the trade")
+	ErrObjectLockedInTrade      = errors.New("Object is on offer in a trade")
+	errTradeOfferNegativeCoins  = errors.New("cannot offer a negative number of coins")
+)
+
+type actorTrade struct {
+	partner  *Actor
+	objects  ObjectList
+	coins    int
+	accepted bool
+}
+
+//////// Actor methods
+
+// TradingWith returns the Actor this Actor has a trade open with, or nil.
+func (a *Actor) TradingWith() *Actor {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	if a.trade == nil {
+		return nil
+	}
+	return a.trade.partner
+}
+
+// TradeOffer returns the Objects and coins the Actor has on offer in its
+// current trade.
+func (a *Actor) TradeOffer() (ObjectList, int) {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	if a.trade == nil {
+		return nil, 0
+	}
+	return a.trade.objects.Copy(), a.trade.coins
+}
+
+// TradeAccepted reports whether the Actor has accepted its current trade.
+func (a *Actor) TradeAccepted() bool {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	return a.trade != nil && a.trade.accepted
+}
+
+func (a *Actor) setTrade(t *actorTrade) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	a.trade = t
+}
+
+func (a *Actor) setTradeAccepted(accepted bool) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	if a.trade == nil {
+		return
+	}
+	updated := *a.trade
+	updated.accepted = accepted
+	a.trade = &updated
+}
+
+// OfferTrade opens a trade with the partner if need be, and replaces this
+// Actor's side of it with the given Objects and coins.
+func (a *Actor) OfferTrade(partner *Actor, objects ObjectList, coins int) error {
+	_, err := a.syncRequestToZone(newTradeOfferCommand(a, partner, objects, coins))
+	return err
+}
+
+// AcceptTrade accepts the Actor's current trade. Once both sides have
+// accepted, the trade goes through.
+func (a *Actor) AcceptTrade() error {
+	_, err := a.syncRequestToZone(newTradeAcceptCommand(a))
+	return err
+}
+
+// CancelTrade calls off the Actor's current trade, or any trade someone else
+// has opened with it.
+func (a *Actor) CancelTrade() error {
+	_, err := a.syncRequestToZone(newTradeCancelCommand(a))
+	return err
+}
+
+//////// Object methods
+
+// lockedInTrade reports whether the Object, or a container it's inside, is on
+// offer in a trade, and so can't be moved until the trade is over.
+func (o *Object) lockedInTrade() bool {
+	holder, ok := o.holder().(*Actor)
+	if !ok {
+		return false
+	}
+	offered, _ := holder.TradeOffer()
+	var c Container = o
+	for {
+		obj, ok := c.(*Object)
+		if !ok {
+			return false
+		}
+		if _, err := offered.IndexOf(obj); err == nil {
+			return true
+		}
+		c = obj.container
+	}
+}
+
+// takesOfferedCoins reports whether moving the Object to the given Container
+// would take away coins its holder has offered in a trade, by carrying them
+// out of the holder's inventory.
+func (o *Object) takesOfferedCoins(to Container) bool {
+	holder, ok := o.holder().(*Actor)
+	if !ok {
+		return false
+	}
+	if toObj, ok := to.(*Object); ok {
+		to = toObj.holder()
+	}
+	if to == Container(holder) {
+		return false
+	}
+	_, offered := holder.TradeOffer()
+	if offered == 0 {
+		return false
+	}
+	var leaving int
+	for _, stack := range holder.coinStacks() {
+		for c := Container(stack); c != nil; {
+			obj, ok := c.(*Object)
+			if !ok {
+				break
+			}
+			if obj == o {
+				leaving += stack.Coins()
+				break
+			}
+			c = obj.container
+		}
+	}
+	return holder.Coins()-leaving < offered
+}
+
+//////// Zone-side processing
+
+func (z *Zone) processTradeOfferCommand(c Command) ([]Event, error) {
+	cmd := c.(*tradeOfferCommand)
+
+	for _, actor := range []*Actor{cmd.actor, cmd.partner} {
+		_, found := z.actorsById[actor.ID()]
+		if !found || actor.Zone() != z {
+			return nil, errors.New("Actor not in Zone")
+		}
+	}
+	if cmd.actor == cmd.partner {
+		return nil, ErrTradeWithSelf
+	}
+	if cmd.actor.IsGhost() || cmd.partner.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+	if cmd.actor.Location() != cmd.partner.Location() {
+		return nil, ErrTradePartnerGone
+	}
+	if current := cmd.actor.TradingWith(); current != nil && current != cmd.partner {
+		return nil, ErrAlreadyTrading
+	}
+	if other := cmd.partner.TradingWith(); other != nil && other != cmd.actor {
+		return nil, ErrTradePartnerBusy
+	}
+
+	var objects ObjectList
+	for _, obj := range cmd.objects {
+		if _, found := z.objectsById[obj.ID()]; !found {
+			return nil, fmt.Errorf("unknown Object %q", obj.ID())
+		}
+		if obj.IsCurrency() {
+			return nil, ErrTradeOfferCurrency
+		}
+		if obj.Container() != cmd.actor {
+			return nil, ErrTradeObjectNotHeld
+		}
+		if _, err := objects.IndexOf(obj); err == nil {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+	if cmd.coins < 0 {
+		return nil, errTradeOfferNegativeCoins
+	}
+	if cmd.coins > cmd.actor.Coins() {
+		return nil, ErrCannotAfford
+	}
+
+	return z.sequenceAndApplyEvents([]Event{
+		NewTradeOfferEvent(cmd.actor.ID(), cmd.partner.ID(), z.id, cmd.actor.Name(), cmd.partner.Name(), objects, cmd.coins),
+	})
+}
+
+func (z *Zone) processTradeAcceptCommand(c Command) ([]Event, error) {
+	cmd := c.(*tradeAcceptCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	partner := cmd.actor.TradingWith()
+	if partner == nil {
+		return nil, ErrNotTrading
+	}
+	if z.actorsById[partner.ID()] != partner || partner.Location() != cmd.actor.Location() {
+		return nil, ErrTradePartnerGone
+	}
+	if partner.TradingWith() != cmd.actor {
+		return nil, ErrTradePartnerNotJoined
+	}
+	if cmd.actor.TradeAccepted() {
+		return nil, nil
+	}
+
+	acceptEv := NewTradeAcceptEvent(cmd.actor.ID(), partner.ID(), z.id, cmd.actor.Name(), partner.Name())
+	if !partner.TradeAccepted() {
+		return z.sequenceAndApplyEvents([]Event{acceptEv})
+	}
+	return z.tradeCommit(acceptEv, cmd.actor, partner)
+}
+
+// tradeCommit checks that both sides of a trade can still be honored, and
+// then applies the final acceptance along with everything changing hands, all
+// in one go so that the trade can't be left half done.
+func (z *Zone) tradeCommit(acceptEv Event, a, b *Actor) ([]Event, error) {
+	aObjs, aCoins := a.TradeOffer()
+	bObjs, bCoins := b.TradeOffer()
+
+	// offered Objects can't be moved, but they might have been destroyed
+	for _, side := range []struct {
+		actor   *Actor
+		objects ObjectList
+	}{{a, aObjs}, {b, bObjs}} {
+		for _, obj := range side.objects {
+			if z.objectsById[obj.ID()] != obj || obj.Container() != side.actor {
+				return nil, ErrTradeObjectNotHeld
+			}
+		}
+	}
+	if a.Coins() < aCoins {
+		return nil, ErrCannotAfford
+	}
+	if b.Coins() < bCoins {
+		return nil, ErrTradePartnerCannotAfford
+	}
+
+	aDebit := z.coinDebitEvents(a, aCoins)
+	bDebit := z.coinDebitEvents(b, bCoins)
+	aPlaces, aCoinSub, err := z.tradePlacements(a, bObjs, bCoins, aObjs, aDebit)
+	if err != nil {
+		return nil, err
+	}
+	bPlaces, bCoinSub, err := z.tradePlacements(b, aObjs, aCoins, bObjs, bDebit)
+	if err != nil {
+		return nil, ErrTradePartnerNoRoom
+	}
+
+	events := []Event{acceptEv}
+	events = append(events, aDebit...)
+	events = append(events, bDebit...)
+	for i, obj := range aObjs {
+		events = append(events, z.tradeMoveEvent(obj, a, b, bPlaces[i]))
+		events = append(events, z.ownershipEventsForTransfer(obj, a, b, OwnershipReasonTraded)...)
+	}
+	for i, obj := range bObjs {
+		events = append(events, z.tradeMoveEvent(obj, b, a, aPlaces[i]))
+		events = append(events, z.ownershipEventsForTransfer(obj, b, a, OwnershipReasonTraded)...)
+	}
+	events = append(events, z.tradeCreditEvents(b, aCoins, bCoinSub, bObjs, bDebit)...)
+	events = append(events, z.tradeCreditEvents(a, bCoins, aCoinSub, aObjs, aDebit)...)
+	events = append(events, NewTradeCompleteEvent(a.ID(), b.ID(), z.id, a.Name(), b.Name()))
+	return z.sequenceAndApplyEvents(events)
+}
+
+// tradeCreditEvents returns the events paying the recipient the given coins,
+// as coinCreditEvents does but worked out ahead of the recipient's own
+// payment (its debit) and the Objects it's giving away, so that the coins
+// aren't added to a stack which is on its way out.
+func (z *Zone) tradeCreditEvents(recipient *Actor, amount int, subcontainer string, outgoing ObjectList, debit []Event) []Event {
+	if amount <= 0 {
+		return nil
+	}
+	for _, obj := range recipient.inventory.Objects() {
+		if _, err := outgoing.IndexOf(obj); err == nil || !obj.IsCurrency() {
+			continue
+		}
+		coins := obj.Coins()
+		removed := false
+		for _, e := range debit {
+			switch typedE := e.(type) {
+			case *ObjectRemoveFromZoneEvent:
+				removed = removed || uuid.Equal(typedE.ObjectID, obj.ID())
+			case *ObjectCoinsEvent:
+				if uuid.Equal(typedE.ObjectID, obj.ID()) {
+					coins = typedE.Coins
+				}
+			}
+		}
+		if removed {
+			continue
+		}
+		return []Event{NewObjectCoinsEvent(obj.ID(), z.id, coins+amount)}
+	}
+	return []Event{NewCoinStackAddToZoneEvent(amount, uuid.Nil, recipient.ID(), uuid.Nil, z.id, subcontainer)}
+}
+
+// tradePlacements works out where in the recipient's inventory each of the
+// incoming Objects will go, once its outgoing Objects (and any coin stacks its
+// own payment uses up) have left. If the recipient is being paid and will
+// have no stack to add the coins to, the subcontainer for a new stack is
+// returned too.
+func (z *Zone) tradePlacements(recipient *Actor, incoming ObjectList, coins int, outgoing ObjectList, debit []Event) ([]string, string, error) {
+	outgoing = outgoing.Copy()
+	for _, e := range debit {
+		if removeEv, ok := e.(*ObjectRemoveFromZoneEvent); ok {
+			outgoing = append(outgoing, z.objectsById[removeEv.ObjectID])
+		}
+	}
+
+	incoming = incoming.Copy()
+	var needStack bool
+	if coins > 0 {
+		needStack = true
+		for _, obj := range recipient.inventory.Objects() {
+			if _, err := outgoing.IndexOf(obj); err != nil && obj.IsCurrency() {
+				needStack = false
+				break
+			}
+		}
+		if needStack {
+			incoming = append(incoming, NewCoinStack(coins, nil, z))
+		}
+	}
+
+	places, err := recipient.inventory.placementsFor(incoming, outgoing)
+	if err != nil {
+		return nil, "", err
+	}
+	var coinSub string
+	if needStack {
+		coinSub = places[len(places)-1]
+		places = places[:len(places)-1]
+	}
+	return places, coinSub, nil
+}
+
+func (z *Zone) tradeMoveEvent(obj *Object, from, to *Actor, toSubcontainer string) *ObjectMoveEvent {
+	e := NewObjectMoveEvent(obj.ID(), from.ID(), z.id)
+	e.FromActorContainerID = from.ID()
+	e.ToActorContainerID = to.ID()
+	e.ToSubcontainer = toSubcontainer
+	return e
+}
+
+func (z *Zone) processTradeCancelCommand(c Command) ([]Event, error) {
+	cmd := c.(*tradeCancelCommand)
+
+	_, found := z.actorsById[cmd.actor.ID()]
+	if !found || cmd.actor.Zone() != z {
+		return nil, errors.New("Actor not in Zone")
+	}
+	events := z.tradeCancelEventsFor(cmd.actor, TradeCancelReasonCancel)
+	if len(events) == 0 {
+		return nil, ErrNotTrading
+	}
+	return z.sequenceAndApplyEvents(events)
+}
+
+// tradeCancelEventsFor returns events calling off the Actor's trade, and any
+// trade someone else has opened with it.
+func (z *Zone) tradeCancelEventsFor(actor *Actor, reason string) []Event {
+	var outEvents []Event
+	partner := actor.TradingWith()
+	if partner != nil {
+		outEvents = append(outEvents, NewTradeCancelEvent(
+			actor.ID(),
+			partner.ID(),
+			z.id,
+			actor.Name(),
+			partner.Name(),
+			reason,
+		))
+	}
+	for _, other := range z.actorsById {
+		if other == partner || other.TradingWith() != actor {
+			continue
+		}
+		outEvents = append(outEvents, NewTradeCancelEvent(
+			other.ID(),
+			actor.ID(),
+			z.id,
+			other.Name(),
+			actor.Name(),
+			reason,
+		))
+	}
+	return outEvents
+}
+
+// tradeObserversFor returns the observers of both parties to a trade; no one
+// else is privy to the haggling.
+func (z *Zone) tradeObserversFor(actorIDs ...uuid.UUID) ObserverList {
+	var oList ObserverList
+	for _, id := range actorIDs {
+		if actor, found := z.actorsById[id]; found {
+			oList = append(oList, actor.Observers()...)
+		}
+	}
+	return oList.Dedupe()
+}
+
+func (z *Zone) applyTradeOfferEvent(e *TradeOfferEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	partner, found := z.actorsById[e.PartnerID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.PartnerID)
+	}
+	var objects ObjectList
+	for _, id := range e.ObjectIDs {
+		obj, found := z.objectsById[id]
+		if !found {
+			return nil, fmt.Errorf("unknown Object %q", id)
+		}
+		objects = append(objects, obj)
+	}
+	actor.setTrade(&actorTrade{partner: partner, objects: objects, coins: e.Coins})
+	// any change to the deal has to be accepted afresh
+	if partner.TradingWith() == actor {
+		partner.setTradeAccepted(false)
+	}
+	return z.tradeObserversFor(e.ActorID, e.PartnerID), nil
+}
+
+func (z *Zone) applyTradeAcceptEvent(e *TradeAcceptEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	actor.setTradeAccepted(true)
+	return z.tradeObserversFor(e.ActorID, e.PartnerID), nil
+}
+
+func (z *Zone) applyTradeCancelEvent(e *TradeCancelEvent) (ObserverList, error) {
+	actor, found := z.actorsById[e.ActorID]
+	if !found {
+		return nil, fmt.Errorf("unknown Actor %q", e.ActorID)
+	}
+	actor.setTrade(nil)
+	// the partner may already have left the Zone
+	if partner, found := z.actorsById[e.PartnerID]; found && partner.TradingWith() == actor {
+		partner.setTrade(nil)
+	}
+	return z.tradeObserversFor(e.ActorID, e.PartnerID), nil
+}
+
+func (z *Zone) applyTradeCompleteEvent(e *TradeCompleteEvent) (ObserverList, error) {
+	for _, id := range []uuid.UUID{e.ActorID, e.PartnerID} {
+		actor, found := z.actorsById[id]
+		if !found {
+			return nil, fmt.Errorf("unknown Actor %q", id)
+		}
+		actor.setTrade(nil)
+	}
+	return z.tradeObserversFor(e.ActorID, e.PartnerID), nil
+}
+
+///////////////////////////// Commands and Events /////////////////////////////
+
+func newTradeOfferCommand(actor, partner *Actor, objects ObjectList, coins int) *tradeOfferCommand {
+	return &tradeOfferCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeTradeOffer},
+		actor:          actor,
+		partner:        partner,
+		objects:        objects,
+		coins:          coins,
+	}
+}
+
+type tradeOfferCommand struct {
+	commandGeneric
+	actor, partner *Actor
+	objects        ObjectList
+	coins          int
+}
+
+func newTradeAcceptCommand(actor *Actor) *tradeAcceptCommand {
+	return &tradeAcceptCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeTradeAccept},
+		actor:          actor,
+	}
+}
+
+type tradeAcceptCommand struct {
+	commandGeneric
+	actor *Actor
+}
+
+func newTradeCancelCommand(actor *Actor) *tradeCancelCommand {
+	return &tradeCancelCommand{
+		commandGeneric: commandGeneric{commandType: CommandTypeTradeCancel},
+		actor:          actor,
+	}
+}
+
+type tradeCancelCommand struct {
+	commandGeneric
+	actor *Actor
+}
+
+func NewTradeOfferEvent(actorID, partnerID, zoneID uuid.UUID, actorName, partnerName string, objects ObjectList, coins int) *TradeOfferEvent {
+	e := &TradeOfferEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeTradeOffer,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: false,
+		},
+		ActorID:     actorID,
+		PartnerID:   partnerID,
+		ActorName:   actorName,
+		PartnerName: partnerName,
+		Coins:       coins,
+	}
+	for _, obj := range objects {
+		e.ObjectIDs = append(e.ObjectIDs, obj.ID())
+		e.ObjectNames = append(e.ObjectNames, obj.Name())
+	}
+	return e
+}
+
+// TradeOfferEvent records an Actor opening a trade, or changing its side of
+// one; ObjectIDs and Coins are everything it now has on offer.
+type TradeOfferEvent struct {
+	*eventGeneric
+	ActorID, PartnerID     uuid.UUID
+	ActorName, PartnerName string
+	ObjectIDs              []uuid.UUID
+	ObjectNames            []string
+	Coins                  int
+}
+
+func NewTradeAcceptEvent(actorID, partnerID, zoneID uuid.UUID, actorName, partnerName string) *TradeAcceptEvent {
+	return &TradeAcceptEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeTradeAccept,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: false,
+		},
+		ActorID:     actorID,
+		PartnerID:   partnerID,
+		ActorName:   actorName,
+		PartnerName: partnerName,
+	}
+}
+
+type TradeAcceptEvent struct {
+	*eventGeneric
+	ActorID, PartnerID     uuid.UUID
+	ActorName, PartnerName string
+}
+
+func NewTradeCancelEvent(actorID, partnerID, zoneID uuid.UUID, actorName, partnerName, reason string) *TradeCancelEvent {
+	return &TradeCancelEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeTradeCancel,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: false,
+		},
+		ActorID:     actorID,
+		PartnerID:   partnerID,
+		ActorName:   actorName,
+		PartnerName: partnerName,
+		Reason:      reason,
+	}
+}
+
+// TradeCancelEvent records a trade being called off, for the reason given by
+// one of the TradeCancelReason* constants.
+type TradeCancelEvent struct {
+	*eventGeneric
+	ActorID, PartnerID     uuid.UUID
+	ActorName, PartnerName string
+	Reason                 string
+}
+
+func NewTradeCompleteEvent(actorID, partnerID, zoneID uuid.UUID, actorName, partnerName string) *TradeCompleteEvent {
+	return &TradeCompleteEvent{
+		eventGeneric: &eventGeneric{
+			EventTypeNum:      EventTypeTradeComplete,
+			TimeStamp:         time.Now(),
+			VersionNum:        1,
+			AggregateID:       zoneID,
+			ShouldPersistBool: false,
+		},
+		ActorID:     actorID,
+		PartnerID:   partnerID,
+		ActorName:   actorName,
+		PartnerName: partnerName,
+	}
+}
+
+// TradeCompleteEvent follows the events which carry out a trade; ActorID is
+// the Actor whose acceptance closed the deal.
+type TradeCompleteEvent struct {
+	*eventGeneric
+	ActorID, PartnerID     uuid.UUID
+	ActorName, PartnerName string
+}
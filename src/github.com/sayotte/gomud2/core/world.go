@@ -40,6 +40,7 @@ type World struct {
 
 	zones       []*Zone
 	zonesByID   map[uuid.UUID]*Zone
+	pantheon    *Pantheon
 	started     bool
 	stopChan    chan struct{}
 	stopWG      *sync.WaitGroup
@@ -122,6 +123,32 @@ func (w *World) LoadZone(zoneTag string) error {
 	return nil
 }
 
+// LoadPantheon creates a Pantheon with the given ID, replays all events in the
+// datastore associated with that ID into it, and makes it the World's
+// Pantheon. This should be called before any Zones are loaded.
+func (w *World) LoadPantheon(pantheonID uuid.UUID) error {
+	p := NewPantheon(pantheonID, nil)
+
+	eChan, err := w.DataStore.RetrieveAllEventsForZone(pantheonID)
+	if err != nil {
+		return err
+	}
+	err = p.ReplayEvents(eChan)
+	if err != nil {
+		return err
+	}
+
+	p.setPersister(w.DataStore)
+	p.StartCommandProcessing()
+
+	w.pantheon = p
+	return nil
+}
+
+func (w *World) Pantheon() *Pantheon {
+	return w.pantheon
+}
+
 func (w *World) start() error {
 	if w.started {
 		return errors.New("World already started")
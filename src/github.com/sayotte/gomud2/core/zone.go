@@ -30,6 +30,7 @@ func NewZone(id uuid.UUID, nickname string, persister EventPersister) *Zone {
 		locationsById: make(map[uuid.UUID]*Location),
 		exitsById:     make(map[uuid.UUID]*Exit),
 		objectsById:   make(map[uuid.UUID]*Object),
+		crimesById:    make(map[uuid.UUID]*Crime),
 		persister:     persister,
 	}
 }
@@ -45,6 +46,12 @@ type Zone struct {
 	exitsById       map[uuid.UUID]*Exit
 	objectsById     map[uuid.UUID]*Object
 
+	// the law and the crime record are read outside the command-processing
+	// goroutine, e.g. by wsapi sessions, so they're guarded by crimeLock
+	law        ZoneLaw
+	crimesById map[uuid.UUID]*Crime
+	crimeLock  sync.RWMutex
+
 	rando *rand.Rand
 
 	// This is the channel where the Zone picks up new events submitted by
@@ -287,6 +294,40 @@ func (z *Zone) StartCommandProcessing() {
 
 		}
 	}()
+	go z.periodicCommandLoop(combatRoundInterval, func() Command { return newCombatRoundCommand() })
+	go z.periodicCommandLoop(objectDecayCheckInterval, func() Command { return newObjectDecayCommand() })
+	go z.periodicCommandLoop(actorRespawnCheckInterval, func() Command { return newActorRespawnCommand() })
+	go z.periodicCommandLoop(hirelingContractCheckInterval, func() Command { return newHirelingContractCheckCommand() })
+	go z.periodicCommandLoop(effectCheckInterval, func() Command { return newActorEffectCheckCommand() })
+}
+
+// periodicCommandLoop submits a new Command to the Zone every interval, until
+// the Zone stops processing commands.
+func (z *Zone) periodicCommandLoop(interval time.Duration, newCommand func() Command) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-z.stopChan:
+			return
+		case <-ticker.C:
+		}
+
+		req := rpc.NewRequest(newCommand())
+		select {
+		case z.privateRequestChan <- req:
+		case <-z.stopChan:
+			return
+		}
+		select {
+		case res := <-req.ResponseChan:
+			if res.Err != nil {
+				fmt.Printf("CORE ERROR: zone %q periodic command: %s\n", z.Tag(), res.Err)
+			}
+		case <-z.stopChan:
+			return
+		}
+	}
 }
 
 func (z *Zone) StopCommandProcessing() {
@@ -339,6 +380,40 @@ func (z *Zone) processCommand(c Command) (interface{}, error) {
 		outEvents, err = z.processExitUpdateCommand(c)
 	case CommandTypeExitRemoveFromZone:
 		outEvents, err = z.processExitRemoveFromZoneCommand(c)
+	case CommandTypeExitSetDoor:
+		outEvents, err = z.processExitSetDoorCommand(c)
+	case CommandTypeActorDoor:
+		outEvents, err = z.processActorDoorCommand(c)
+	case CommandTypeActorContainerAction:
+		outEvents, err = z.processActorContainerActionCommand(c)
+	case CommandTypeShopTrade:
+		outEvents, err = z.processShopTradeCommand(c)
+	case CommandTypeActorSetShop:
+		outEvents, err = z.processActorSetShopCommand(c)
+	case CommandTypeActorSetHireTerms:
+		outEvents, err = z.processActorSetHireTermsCommand(c)
+	case CommandTypeActorHire:
+		outEvents, err = z.processActorHireCommand(c)
+	case CommandTypeActorFire:
+		outEvents, err = z.processActorFireCommand(c)
+	case CommandTypeActorOrder:
+		outEvents, err = z.processActorOrderCommand(c)
+	case CommandTypeHirelingContractCheck:
+		outEvents, err = z.processHirelingContractCheckCommand(c)
+	case CommandTypeActorFactionMembership:
+		outEvents, err = z.processActorFactionMembershipCommand(c)
+	case CommandTypeZoneSetLaw:
+		outEvents, err = z.processZoneSetLawCommand(c)
+	case CommandTypeCrimeReport:
+		outEvents, err = z.processCrimeReportCommand(c)
+	case CommandTypeCrimeResolve:
+		outEvents, err = z.processCrimeResolveCommand(c)
+	case CommandTypeTradeOffer:
+		outEvents, err = z.processTradeOfferCommand(c)
+	case CommandTypeTradeAccept:
+		outEvents, err = z.processTradeAcceptCommand(c)
+	case CommandTypeTradeCancel:
+		outEvents, err = z.processTradeCancelCommand(c)
 	case CommandTypeObjectAddToZone:
 		out, outEvents, err = z.processObjectAddToZoneCommand(c)
 	case CommandTypeObjectMove:
@@ -353,20 +428,70 @@ func (z *Zone) processCommand(c Command) (interface{}, error) {
 		outEvents, err = z.processZoneSetDefaultLocationCommand(c)
 	case CommandTypeCombatMelee:
 		outEvents, err = z.processCombatMeleeCommand(c)
+	case CommandTypeActorActionDelay:
+		outEvents, err = z.processActorActionDelayCommand(c)
+	case CommandTypeCombatEngage:
+		outEvents, err = z.processCombatEngageCommand(c)
+	case CommandTypeCombatDisengage:
+		outEvents, err = z.processCombatDisengageCommand(c)
+	case CommandTypeCombatFlee:
+		out, outEvents, err = z.processCombatFleeCommand(c)
+	case CommandTypeCombatRound:
+		outEvents, err = z.processCombatRoundCommand(c)
+	case CommandTypeObjectDecay:
+		outEvents, err = z.processObjectDecayCommand(c)
+	case CommandTypeActorRespawn:
+		outEvents, err = z.processActorRespawnCommand(c)
+	case CommandTypeActorSetBindLocation:
+		outEvents, err = z.processActorSetBindLocationCommand(c)
+	case CommandTypeSorceryInvoke:
+		out, outEvents, err = z.processSorceryInvokeCommand(c)
+	case CommandTypeActorReadScroll:
+		outEvents, err = z.processActorReadScrollCommand(c)
+	case CommandTypeActorScribe:
+		out, outEvents, err = z.processActorScribeCommand(c)
+	case CommandTypeActorDedicate:
+		outEvents, err = z.processActorDedicateCommand(c)
+	case CommandTypeActorSacrifice:
+		outEvents, err = z.processActorSacrificeCommand(c)
+	case CommandTypeActorPray:
+		outEvents, err = z.processActorPrayCommand(c)
+	case CommandTypeActorQuest:
+		outEvents, err = z.processActorQuestCommand(c)
+	case CommandTypeActorConsume:
+		outEvents, err = z.processActorConsumeCommand(c)
+	case CommandTypeActorEffectCheck:
+		outEvents, err = z.processActorEffectCheckCommand(c)
+	case CommandTypeActorCraft:
+		out, outEvents, err = z.processActorCraftCommand(c)
+	case CommandTypeObjectPrototypeUpdate:
+		outEvents, err = z.processObjectPrototypeUpdateCommand(c)
 	default:
 		err = fmt.Errorf("unrecognized Command type %d", c.CommandType())
 	}
-	if err != nil {
-		return nil, err
+
+	// whatever just happened may have advanced someone's quests
+	if err == nil {
+		var questEvents []Event
+		questEvents, err = z.questEventsFor(outEvents)
+		outEvents = append(outEvents, questEvents...)
 	}
 
+	// any events returned have already been applied, even if something went
+	// wrong after them, so they must be persisted regardless
 	for _, e := range outEvents {
 		if z.persister != nil && e.ShouldPersist() {
-			err = z.persister.PersistEvent(e)
+			persistErr := z.persister.PersistEvent(e)
+			if err == nil {
+				err = persistErr
+			}
 		}
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return out, err
+	return out, nil
 }
 
 func (z *Zone) processActorAddToZoneCommand(c Command) (interface{}, []Event, error) {
@@ -400,25 +525,27 @@ func (z *Zone) processActorMoveCommand(c Command) ([]Event, error) {
 	if !ok {
 		return nil, fmt.Errorf("unknown to-location %q", e.ToLocationId)
 	}
-	exitExists := false
+	var via *Exit
 	for _, exit := range from.OutExits() {
 		if exit.Destination() == to {
-			exitExists = true
+			via = exit
 			break
 		}
 	}
-	if !exitExists {
+	if via == nil {
 		return nil, fmt.Errorf("no exit to that destination from location %q", from.ID())
 	}
-	_, ok = z.actorsById[e.ActorId]
+	if via.IsClosed() {
+		return nil, ErrExitClosed
+	}
+	actor, ok := z.actorsById[e.ActorId]
 	if !ok {
 		return nil, fmt.Errorf("unknown Actor %q", e.ActorId)
 	}
 
-	e.SetSequenceNumber(z.nextSequenceId)
-	z.nextSequenceId = e.SequenceNumber() + 1
-	_, err := z.applyEvent(e)
-	return []Event{e}, err
+	events := z.tradeCancelEventsFor(actor, TradeCancelReasonDeparted)
+	events = append(events, e)
+	return z.sequenceAndApplyEvents(append(events, z.exertEventsFor(actor)...))
 }
 
 func (z *Zone) processActorAdminRelocateCommand(c Command) ([]Event, error) {
@@ -443,15 +570,13 @@ func (z *Zone) processActorAdminRelocateCommand(c Command) ([]Event, error) {
 func (z *Zone) processActorRemoveCommand(c Command) ([]Event, error) {
 	cmd := c.(actorRemoveFromZoneCommand)
 	e := cmd.wrappedEvent
-	_, found := z.actorsById[e.ActorID]
+	actor, found := z.actorsById[e.ActorID]
 	if !found {
 		return nil, fmt.Errorf("Actor %q not found in Zone", e.ActorID)
 	}
 
-	e.SetSequenceNumber(z.nextSequenceId)
-	z.nextSequenceId = e.SequenceNumber() + 1
-	_, err := z.applyEvent(e)
-	return []Event{e}, err
+	events := append(z.tradeCancelEventsFor(actor, TradeCancelReasonDeparted), e)
+	return z.sequenceAndApplyEvents(events)
 }
 
 func (z *Zone) processActorMigrateInCommand(c Command) (interface{}, []Event, error) {
@@ -471,6 +596,19 @@ func (z *Zone) processActorMigrateInCommand(c Command) (interface{}, []Event, er
 		cmd.actor.Skills(),
 		cmd.actor.Inventory().Constraints(),
 	)
+	actorEv.GhostUntil = cmd.actor.GhostUntil()
+	actorEv.BindLocationID = cmd.actor.BindLocationID()
+	actorEv.KnownReactions = cmd.actor.KnownReactions()
+	actorEv.DeityID = cmd.actor.DeityID()
+	actorEv.MysticismAsOf = cmd.actor.MysticismAsOf()
+	actorEv.Shop = cmd.actor.Shop()
+	actorEv.HireTerms = cmd.actor.HireTerms()
+	actorEv.HirelingContract = cmd.actor.HirelingContract()
+	actorEv.Factions = cmd.actor.Factions()
+	actorEv.Reputation = cmd.actor.reputationSnapshot()
+	actorEv.Quests = cmd.actor.questsSnapshot()
+	actorEv.Progression = cmd.actor.progressionSnapshot()
+	actorEv.ActiveEffects = cmd.actor.activeEffectsSnapshot()
 	actorEv.SetSequenceNumber(z.nextSequenceId)
 	z.nextSequenceId = actorEv.SequenceNumber() + 1
 	out, err := z.applyEvent(actorEv)
@@ -511,6 +649,14 @@ func (z *Zone) processActorMigrateInCommand(c Command) (interface{}, []Event, er
 			subContainer,
 			objContTuple.obj.Attributes(),
 		)
+		objEv.DecayAt = objContTuple.obj.decayAt
+		objEv.LootRightsActorIDs = objContTuple.obj.LootRightsActorIDs()
+		objEv.LootRightsUntil = objContTuple.obj.lootRightsUntil
+		objEv.CorpseOfID = objContTuple.obj.corpseOfID
+		objEv.Closed = objContTuple.obj.IsClosed()
+		objEv.Locked = objContTuple.obj.IsLocked()
+		objEv.Ownership = objContTuple.obj.Ownership()
+		objEv.PrototypeID = objContTuple.obj.PrototypeID()
 		objEv.SetSequenceNumber(z.nextSequenceId)
 		z.nextSequenceId = objEv.SequenceNumber() + 1
 		_, err := z.applyEvent(objEv)
@@ -526,7 +672,10 @@ func (z *Zone) processActorMigrateInCommand(c Command) (interface{}, []Event, er
 func (z *Zone) processActorMigrateOutCommand(c Command) ([]Event, error) {
 	cmd := c.(*actorMigrateOutCommand)
 
-	var outEvents []Event
+	outEvents, err := z.sequenceAndApplyEvents(z.tradeCancelEventsFor(cmd.actor, TradeCancelReasonDeparted))
+	if err != nil {
+		return nil, err
+	}
 
 	for _, objContTuple := range getObjectContainerTuplesRecursive(cmd.actor) {
 		objEv := NewObjectMigrateOutEvent(
@@ -553,7 +702,7 @@ func (z *Zone) processActorMigrateOutCommand(c Command) ([]Event, error) {
 	)
 	actorEv.SetSequenceNumber(z.nextSequenceId)
 	z.nextSequenceId = actorEv.SequenceNumber() + 1
-	_, err := z.applyEvent(actorEv)
+	_, err = z.applyEvent(actorEv)
 	if err != nil {
 		return nil, err
 	}
@@ -569,8 +718,11 @@ func (z *Zone) processActorDeathCommand(c Command) ([]Event, error) {
 	if !found || cmd.actor.Zone() != z {
 		return nil, errors.New("Actor not in Zone")
 	}
+	if cmd.actor.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
 
-	outEvents := doActorDeath(cmd.actor, z)
+	outEvents := doActorDeath(cmd.actor, nil, z)
 	for _, event := range outEvents {
 		event.SetSequenceNumber(z.nextSequenceId)
 		z.nextSequenceId = event.SequenceNumber() + 1
@@ -700,6 +852,9 @@ func (z *Zone) processExitAddToZoneCommand(c Command) (interface{}, []Event, err
 	if !ok {
 		return nil, nil, fmt.Errorf("unknown source location %q", e.SourceLocationId)
 	}
+	if !IsValidDirection(e.Direction) {
+		return nil, nil, fmt.Errorf("invalid direction %q", e.Direction)
+	}
 	for _, existingExit := range srcLoc.OutExits() {
 		if existingExit.Direction() == e.Direction {
 			return nil, nil, fmt.Errorf("Exit in direction %q already exists from Location", e.Direction)
@@ -732,6 +887,9 @@ func (z *Zone) processExitUpdateCommand(c Command) ([]Event, error) {
 	if !ok {
 		return nil, fmt.Errorf("unknown source Location %q", e.SourceLocationId)
 	}
+	if !IsValidDirection(e.Direction) {
+		return nil, fmt.Errorf("invalid direction %q", e.Direction)
+	}
 	if uuid.Equal(e.DestLocationId, uuid.Nil) {
 		return nil, errors.New("destination Location ID cannot be nil")
 	}
@@ -846,7 +1004,53 @@ func (z *Zone) processObjectMoveCommand(c Command) ([]Event, error) {
 		return nil, errors.New("cannot move an Object directly between Containers in different Locations")
 	}
 
-	if len(cmd.fromContainer.Objects()) >= cmd.fromContainer.Capacity() {
+	if _, ok := cmd.toContainer.(*Actor); ok && !cmd.obj.IsPortable() {
+		return nil, ErrObjectNotPortable
+	}
+
+	if toActor, ok := cmd.toContainer.(*Actor); ok && toActor.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
+
+	// catch this before the move is applied, rather than leaving the Object
+	// half-moved by ActorInventory.addObject
+	if toActor, ok := cmd.toContainer.(*Actor); ok {
+		inv := toActor.Inventory()
+		if !inv.counts(cmd.obj) && inv.Weight()+cmd.obj.Weight() > inv.CarryLimit() {
+			return nil, ErrInventoryTooHeavy
+		}
+		if inv.checkAddObject(cmd.obj, InventoryContainerHands) != nil {
+			return nil, ErrInventoryNoRoom
+		}
+	}
+
+	// Objects on offer in a trade stay put until the trade is over
+	if cmd.obj.lockedInTrade() {
+		return nil, ErrObjectLockedInTrade
+	}
+	if toObj, ok := cmd.toContainer.(*Object); ok && toObj.lockedInTrade() {
+		return nil, ErrObjectLockedInTrade
+	}
+	if cmd.obj.takesOfferedCoins(cmd.toContainer) {
+		return nil, ErrObjectLockedInTrade
+	}
+
+	if fromObj, ok := cmd.fromContainer.(*Object); ok && !fromObj.CanBeLootedBy(cmd.actor) {
+		return nil, ErrObjectLootRightsReserved
+	}
+
+	// nothing goes into or comes out of a closed container
+	if sealedOff(cmd.fromContainer) || sealedOff(cmd.toContainer) {
+		return nil, ErrContainerClosed
+	}
+
+	if toObj, ok := cmd.toContainer.(*Object); ok {
+		if err := toObj.checkFits(cmd.obj); err != nil {
+			return nil, err
+		}
+	}
+
+	if toLoc, ok := cmd.toContainer.(*Location); ok && len(toLoc.Objects()) >= toLoc.Capacity() {
 		return nil, errors.New("would overflow container")
 	}
 
@@ -916,10 +1120,41 @@ func (z *Zone) processObjectMoveCommand(c Command) ([]Event, error) {
 		}
 	}
 
+	// work out who owns what before it moves, while it's still clear where
+	// it came from
+	var ownershipEvents []Event
+	if toActor, ok := cmd.toContainer.(*Actor); ok && cmd.actor != nil {
+		switch {
+		case toActor == cmd.actor:
+			ownershipEvents = z.ownershipEventsForTake(cmd.obj, cmd.fromContainer, cmd.actor)
+		case cmd.fromContainer == Container(cmd.actor):
+			ownershipEvents = z.ownershipEventsForTransfer(cmd.obj, cmd.actor, toActor, OwnershipReasonGiven)
+		}
+	}
+
 	e.SetSequenceNumber(z.nextSequenceId)
 	z.nextSequenceId = e.SequenceNumber() + 1
 	_, err := z.applyEvent(e)
-	return []Event{e}, err
+	if err != nil {
+		return nil, err
+	}
+	outEvents := []Event{e}
+
+	owned, err := z.sequenceAndApplyEvents(ownershipEvents)
+	if err != nil {
+		return nil, err
+	}
+	outEvents = append(outEvents, owned...)
+
+	// stacks of coins merge with any stack already where they're put
+	if cmd.obj.IsCurrency() {
+		merged, err := z.sequenceAndApplyEvents(z.coinMergeEvents(cmd.obj, cmd.toContainer))
+		if err != nil {
+			return nil, err
+		}
+		outEvents = append(outEvents, merged...)
+	}
+	return outEvents, nil
 }
 
 func (z *Zone) processObjectMoveSubcontainerCommand(c Command) ([]Event, error) {
@@ -1008,6 +1243,9 @@ func (z *Zone) processZoneSetDefaultLocationCommand(c Command) ([]Event, error)
 
 func (z *Zone) processCombatMeleeCommand(c Command) ([]Event, error) {
 	typed := c.(*combatMeleeCommand)
+	if typed.attacker.IsGhost() || typed.target.IsGhost() {
+		return nil, ErrActorIsGhost
+	}
 	outEvents, err := typed.Do()
 	if err != nil {
 		return nil, err
@@ -1021,7 +1259,29 @@ func (z *Zone) processCombatMeleeCommand(c Command) ([]Event, error) {
 			return nil, err
 		}
 	}
-	return outEvents, err
+
+	// an attack starts a fight, if there isn't one going already
+	engageEvents, err := z.sequenceAndApplyEvents(z.engageEventsFor(typed.attacker, typed.target))
+	if err != nil {
+		return nil, err
+	}
+	return append(outEvents, engageEvents...), nil
+}
+
+// sequenceAndApplyEvents assigns sequence numbers to the given Events and
+// applies them in order, stopping at the first error.
+func (z *Zone) sequenceAndApplyEvents(events []Event) ([]Event, error) {
+	for i, e := range events {
+		e.SetSequenceNumber(z.nextSequenceId)
+		z.nextSequenceId = e.SequenceNumber() + 1
+		_, err := z.applyEvent(e)
+		if err != nil {
+			// those already applied have changed the Zone, so they're
+			// returned to be persisted all the same
+			return events[:i], err
+		}
+	}
+	return events, nil
 }
 
 //////// Event processing
@@ -1097,6 +1357,105 @@ func (z *Zone) applyEvent(e Event) (interface{}, error) {
 	case EventTypeExitRemoveFromZone:
 		typedEvent := e.(*ExitRemoveFromZoneEvent)
 		err = z.applyExitRemoveFromZoneEvent(typedEvent)
+	case EventTypeExitSetDoor:
+		typedEvent := e.(*ExitSetDoorEvent)
+		err = z.applyExitSetDoorEvent(typedEvent)
+	case EventTypeExitDoor:
+		typedEvent := e.(*ExitDoorEvent)
+		oList, err = z.applyExitDoorEvent(typedEvent)
+	case EventTypeObjectContainerAction:
+		typedEvent := e.(*ObjectContainerActionEvent)
+		oList, err = z.applyObjectContainerActionEvent(typedEvent)
+	case EventTypeObjectCoins:
+		typedEvent := e.(*ObjectCoinsEvent)
+		oList, err = z.applyObjectCoinsEvent(typedEvent)
+	case EventTypeShopTrade:
+		typedEvent := e.(*ShopTradeEvent)
+		oList, err = z.applyShopTradeEvent(typedEvent)
+	case EventTypeActorSetShop:
+		typedEvent := e.(*ActorSetShopEvent)
+		err = z.applyActorSetShopEvent(typedEvent)
+	case EventTypeActorSetHireTerms:
+		typedEvent := e.(*ActorSetHireTermsEvent)
+		err = z.applyActorSetHireTermsEvent(typedEvent)
+	case EventTypeActorHire:
+		typedEvent := e.(*ActorHireEvent)
+		oList, err = z.applyActorHireEvent(typedEvent)
+	case EventTypeActorRelease:
+		typedEvent := e.(*ActorReleaseEvent)
+		oList, err = z.applyActorReleaseEvent(typedEvent)
+	case EventTypeActorRelationship:
+		typedEvent := e.(*ActorRelationshipEvent)
+		oList, err = z.applyActorRelationshipEvent(typedEvent)
+	case EventTypeActorOrder:
+		typedEvent := e.(*ActorOrderEvent)
+		oList, err = z.applyActorOrderEvent(typedEvent)
+	case EventTypeActorJoinFaction:
+		typedEvent := e.(*ActorJoinFactionEvent)
+		oList, err = z.applyActorJoinFactionEvent(typedEvent)
+	case EventTypeActorLeaveFaction:
+		typedEvent := e.(*ActorLeaveFactionEvent)
+		oList, err = z.applyActorLeaveFactionEvent(typedEvent)
+	case EventTypeActorReputation:
+		typedEvent := e.(*ActorReputationEvent)
+		oList, err = z.applyActorReputationEvent(typedEvent)
+	case EventTypeZoneSetLaw:
+		typedEvent := e.(*ZoneSetLawEvent)
+		err = z.applyZoneSetLawEvent(typedEvent)
+	case EventTypeCrimeCommit:
+		typedEvent := e.(*CrimeCommitEvent)
+		oList, err = z.applyCrimeCommitEvent(typedEvent)
+	case EventTypeCrimeReport:
+		typedEvent := e.(*CrimeReportEvent)
+		oList, err = z.applyCrimeReportEvent(typedEvent)
+	case EventTypeCrimeResolve:
+		typedEvent := e.(*CrimeResolveEvent)
+		oList, err = z.applyCrimeResolveEvent(typedEvent)
+	case EventTypeObjectOwnership:
+		typedEvent := e.(*ObjectOwnershipEvent)
+		oList, err = z.applyObjectOwnershipEvent(typedEvent)
+	case EventTypeActorQuestAccept:
+		typedEvent := e.(*ActorQuestAcceptEvent)
+		oList, err = z.applyActorQuestAcceptEvent(typedEvent)
+	case EventTypeActorQuestProgress:
+		typedEvent := e.(*ActorQuestProgressEvent)
+		oList, err = z.applyActorQuestProgressEvent(typedEvent)
+	case EventTypeActorQuestComplete:
+		typedEvent := e.(*ActorQuestCompleteEvent)
+		oList, err = z.applyActorQuestCompleteEvent(typedEvent)
+	case EventTypeActorQuestAbandon:
+		typedEvent := e.(*ActorQuestAbandonEvent)
+		oList, err = z.applyActorQuestAbandonEvent(typedEvent)
+	case EventTypeActorConsume:
+		typedEvent := e.(*ActorConsumeEvent)
+		oList, err = z.applyActorConsumeEvent(typedEvent)
+	case EventTypeActorEffect:
+		typedEvent := e.(*ActorEffectEvent)
+		oList, err = z.applyActorEffectEvent(typedEvent)
+	case EventTypeActorEffectStart:
+		typedEvent := e.(*ActorEffectStartEvent)
+		oList, err = z.applyActorEffectStartEvent(typedEvent)
+	case EventTypeActorEffectEnd:
+		typedEvent := e.(*ActorEffectEndEvent)
+		oList, err = z.applyActorEffectEndEvent(typedEvent)
+	case EventTypeActorCraft:
+		typedEvent := e.(*ActorCraftEvent)
+		oList, err = z.applyActorCraftEvent(typedEvent)
+	case EventTypeObjectPrototypeUpdate:
+		typedEvent := e.(*ObjectPrototypeUpdateEvent)
+		oList, err = z.applyObjectPrototypeUpdateEvent(typedEvent)
+	case EventTypeTradeOffer:
+		typedEvent := e.(*TradeOfferEvent)
+		oList, err = z.applyTradeOfferEvent(typedEvent)
+	case EventTypeTradeAccept:
+		typedEvent := e.(*TradeAcceptEvent)
+		oList, err = z.applyTradeAcceptEvent(typedEvent)
+	case EventTypeTradeCancel:
+		typedEvent := e.(*TradeCancelEvent)
+		oList, err = z.applyTradeCancelEvent(typedEvent)
+	case EventTypeTradeComplete:
+		typedEvent := e.(*TradeCompleteEvent)
+		oList, err = z.applyTradeCompleteEvent(typedEvent)
 	case EventTypeObjectAddToZone:
 		typedEvent := e.(*ObjectAddToZoneEvent)
 		out, oList, err = z.applyObjectAddToZoneEvent(typedEvent)
@@ -1127,6 +1486,51 @@ func (z *Zone) applyEvent(e Event) (interface{}, error) {
 	case EventTypeCombatMeleeDamage:
 		typedEvent := e.(*CombatMeleeDamageEvent)
 		oList, err = z.applyCombatMeleeDamageEvent(typedEvent)
+	case EventTypeActorActionDelay:
+		typedEvent := e.(*ActorActionDelayEvent)
+		oList, err = z.applyActorActionDelayEvent(typedEvent)
+	case EventTypeCombatEngage:
+		typedEvent := e.(*CombatEngageEvent)
+		oList, err = z.applyCombatEngageEvent(typedEvent)
+	case EventTypeCombatDisengage:
+		typedEvent := e.(*CombatDisengageEvent)
+		oList, err = z.applyCombatDisengageEvent(typedEvent)
+	case EventTypeCombatFlee:
+		typedEvent := e.(*CombatFleeEvent)
+		oList, err = z.applyCombatFleeEvent(typedEvent)
+	case EventTypeActorBecomeGhost:
+		typedEvent := e.(*ActorBecomeGhostEvent)
+		oList, err = z.applyActorBecomeGhostEvent(typedEvent)
+	case EventTypeActorRespawn:
+		typedEvent := e.(*ActorRespawnEvent)
+		oList, err = z.applyActorRespawnEvent(typedEvent)
+	case EventTypeActorSetBindLocation:
+		typedEvent := e.(*ActorSetBindLocationEvent)
+		oList, err = z.applyActorSetBindLocationEvent(typedEvent)
+	case EventTypeSorceryInvoke:
+		typedEvent := e.(*SorceryInvokeEvent)
+		oList, err = z.applySorceryInvokeEvent(typedEvent)
+	case EventTypeActorLearnReaction:
+		typedEvent := e.(*ActorLearnReactionEvent)
+		oList, err = z.applyActorLearnReactionEvent(typedEvent)
+	case EventTypeActorLearnTechnique:
+		typedEvent := e.(*ActorLearnTechniqueEvent)
+		oList, err = z.applyActorLearnTechniqueEvent(typedEvent)
+	case EventTypeActorScribe:
+		typedEvent := e.(*ActorScribeEvent)
+		oList, err = z.applyActorScribeEvent(typedEvent)
+	case EventTypeActorExert:
+		typedEvent := e.(*ActorExertEvent)
+		oList, err = z.applyActorExertEvent(typedEvent)
+	case EventTypeActorDedicate:
+		typedEvent := e.(*ActorDedicateEvent)
+		oList, err = z.applyActorDedicateEvent(typedEvent)
+	case EventTypeActorSacrifice:
+		typedEvent := e.(*ActorSacrificeEvent)
+		oList, err = z.applyActorSacrificeEvent(typedEvent)
+	case EventTypeActorPray:
+		typedEvent := e.(*ActorPrayEvent)
+		oList, err = z.applyActorPrayEvent(typedEvent)
 
 	default:
 		err = fmt.Errorf("unknown Event type %T", e)
@@ -1160,6 +1564,19 @@ func (z *Zone) applyActorAddToZoneEvent(e *ActorAddToZoneEvent) (*Actor, Observe
 		e.Skills,
 		e.InventoryConstraints,
 	)
+	actor.ghostUntil = e.GhostUntil
+	actor.bindLocationID = e.BindLocationID
+	actor.knownReactions = e.KnownReactions
+	actor.deityID = e.DeityID
+	actor.mysticismAsOf = e.MysticismAsOf
+	actor.shop = e.Shop
+	actor.hireTerms = e.HireTerms
+	actor.contract = e.HirelingContract
+	actor.factions = e.Factions
+	actor.reputation = e.Reputation
+	actor.quests = e.Quests
+	actor.progression = e.Progression
+	actor.activeEffects = e.ActiveEffects
 
 	newLoc.addActor(actor)
 	actor.setLocation(newLoc)
@@ -1257,6 +1674,19 @@ func (z *Zone) applyActorMigrateInEvent(e *ActorMigrateInEvent) (*Actor, Observe
 		e.Skills,
 		e.InventoryConstraints,
 	)
+	actor.ghostUntil = e.GhostUntil
+	actor.bindLocationID = e.BindLocationID
+	actor.knownReactions = e.KnownReactions
+	actor.deityID = e.DeityID
+	actor.mysticismAsOf = e.MysticismAsOf
+	actor.shop = e.Shop
+	actor.hireTerms = e.HireTerms
+	actor.contract = e.HirelingContract
+	actor.factions = e.Factions
+	actor.reputation = e.Reputation
+	actor.quests = e.Quests
+	actor.progression = e.Progression
+	actor.activeEffects = e.ActiveEffects
 
 	var oList ObserverList
 	if newLoc != nil {
@@ -1384,6 +1814,7 @@ func (z *Zone) applyLocationAddToZoneEvent(e *LocationAddToZoneEvent) (*Location
 		e.ShortDesc,
 		e.Desc,
 	)
+	loc.setTags(e.Tags)
 	z.locationsById[e.LocationID] = loc
 	return loc, nil
 }
@@ -1396,6 +1827,7 @@ func (z *Zone) applyLocationUpdateEvent(e *LocationUpdateEvent) error {
 
 	loc.setShortDescription(e.ShortDesc)
 	loc.setDescription(e.Desc)
+	loc.setTags(e.Tags)
 	return nil
 }
 
@@ -1430,6 +1862,7 @@ func (z *Zone) applyExitAddToZoneEvent(e *ExitAddToZoneEvent) (*Exit, error) {
 		e.DestZoneID,
 		destLocID,
 	)
+	exit.setDoor(e.HasDoor, e.Closed, e.Locked, e.KeyID, e.KeyKeyword)
 	err := srcLoc.addOutExit(exit)
 	if err != nil {
 		return nil, err
@@ -1536,6 +1969,14 @@ func (z *Zone) applyObjectAddToZoneEvent(e *ObjectAddToZoneEvent) (*Object, Obse
 		z,
 		e.Attributes,
 	)
+	obj.decayAt = e.DecayAt
+	obj.lootRightsActorIDs = e.LootRightsActorIDs
+	obj.lootRightsUntil = e.LootRightsUntil
+	obj.corpseOfID = e.CorpseOfID
+	obj.closed = e.Closed
+	obj.locked = e.Locked
+	obj.ownership = e.Ownership.copy()
+	obj.prototypeID = e.PrototypeID
 	if containerFound {
 		err := container.addObject(obj, e.Subcontainer)
 		if err != nil {
@@ -1703,6 +2144,12 @@ func (z *Zone) applyObjectMigrateInEvent(e *ObjectMigrateInEvent) error {
 		z,
 		e.Attributes,
 	)
+	obj.decayAt = e.DecayAt
+	obj.lootRightsActorIDs = e.LootRightsActorIDs
+	obj.lootRightsUntil = e.LootRightsUntil
+	obj.corpseOfID = e.CorpseOfID
+	obj.ownership = e.Ownership.copy()
+	obj.prototypeID = e.PrototypeID
 	if container != nil {
 		err := container.addObject(obj, e.Subcontainer)
 		if err != nil {
@@ -1714,6 +2161,10 @@ func (z *Zone) applyObjectMigrateInEvent(e *ObjectMigrateInEvent) error {
 
 	obj.setContainer(container)
 	z.objectsById[obj.ID()] = obj
+	// a container's contents follow it in by later events; they're placed
+	// directly rather than moved, so it can already be shut and locked
+	obj.closed = e.Closed
+	obj.locked = e.Locked
 
 	return nil
 }
@@ -1766,6 +2217,7 @@ func (z *Zone) snapshot(sequenceNum uint64) []Event {
 	for _, obj := range orderedObjs {
 		snapEvents = append(snapEvents, obj.snapshot(sequenceNum))
 	}
+	snapEvents = append(snapEvents, z.crimeSnapshot(sequenceNum)...)
 
 	return snapEvents
 }
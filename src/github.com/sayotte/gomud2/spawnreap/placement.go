@@ -0,0 +1,130 @@
+package spawnreap
+
+import (
+	"fmt"
+
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// allowedLocations returns the Locations in the Zone where the spec's Actors
+// may spawn.
+func allowedLocations(spec SpawnSpecification, zone *core.Zone) []*core.Location {
+	zoneLocs := zone.Locations()
+	if len(spec.LocationIDs) == 0 && len(spec.LocationTags) == 0 {
+		return zoneLocs
+	}
+
+	var allowed []*core.Location
+	for _, loc := range zoneLocs {
+		if locationAllowed(spec, loc) {
+			allowed = append(allowed, loc)
+		}
+	}
+	return allowed
+}
+
+func locationAllowed(spec SpawnSpecification, loc *core.Location) bool {
+	for _, id := range spec.LocationIDs {
+		if uuid.Equal(id, loc.ID()) {
+			return true
+		}
+	}
+	for _, tag := range spec.LocationTags {
+		if loc.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// chooseSpawnLocation picks one of the candidate Locations to spawn in.
+func (s *Service) chooseSpawnLocation(candidates []*core.Location) *core.Location {
+	// first try to find a Location with no Actors, to help with immersion
+	// note that this includes non-player Actors; this will result in
+	// different specs' spawns being distributed around the Zone
+	for _, loc := range candidates {
+		if len(loc.Actors()) == 0 {
+			return loc
+		}
+	}
+	// failing any empty Locations, just spawn them in a random Location
+	return candidates[s.rando.Intn(len(candidates))]
+}
+
+// leash ties a spawned Actor to the Location it spawned in.
+type leash struct {
+	homeID uuid.UUID
+	radius int
+}
+
+func (s *Service) leashActor(zone *core.Zone, actor *core.Actor, home *core.Location, radius int) {
+	leashes, found := s.zoneToActorLeashMap[zone.ID()]
+	if !found {
+		leashes = make(map[uuid.UUID]leash)
+		s.zoneToActorLeashMap[zone.ID()] = leashes
+	}
+	leashes[actor.ID()] = leash{homeID: home.ID(), radius: radius}
+}
+
+// leashZone returns spawned Actors which have strayed too far back to where
+// they spawned. Like reaping, this is only done when nobody is around to see
+// them vanish.
+func (s *Service) leashZone(zone *core.Zone) {
+	leashes := s.zoneToActorLeashMap[zone.ID()]
+	for actorID, l := range leashes {
+		actor := zone.ActorByID(actorID)
+		if actor == nil {
+			// dead, or gone to another Zone
+			delete(leashes, actorID)
+			continue
+		}
+		home := zone.LocationByID(l.homeID)
+		if home == nil {
+			delete(leashes, actorID)
+			continue
+		}
+		loc := actor.Location()
+		if withinExits(home, loc, l.radius) || len(loc.Actors()) > 1 {
+			continue
+		}
+		err := actor.AdminRelocate(home)
+		if err != nil {
+			fmt.Printf("SpawnReap ERROR: Actor.AdminRelocate(%s): %s\n", actor.ID(), err)
+		}
+	}
+}
+
+// withinExits reports whether the target Location can be reached from the
+// origin by following no more than the given number of exits, without
+// leaving the origin's Zone.
+func withinExits(origin, target *core.Location, radius int) bool {
+	seen := map[*core.Location]bool{origin: true}
+	frontier := []*core.Location{origin}
+	for distance := 0; ; distance++ {
+		for _, loc := range frontier {
+			if loc == target {
+				return true
+			}
+		}
+		if distance == radius {
+			return false
+		}
+		var next []*core.Location
+		for _, loc := range frontier {
+			for _, exit := range loc.OutExits() {
+				dest := exit.Destination()
+				if dest == nil || seen[dest] {
+					continue
+				}
+				seen[dest] = true
+				next = append(next, dest)
+			}
+		}
+		if len(next) == 0 {
+			return false
+		}
+		frontier = next
+	}
+}
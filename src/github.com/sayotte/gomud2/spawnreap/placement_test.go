@@ -0,0 +1,62 @@
+package spawnreap
+
+import (
+	"testing"
+
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+func TestWithinExits(t *testing.T) {
+	// a row of Locations joined east-west: 0 - 1 - 2 - 3, plus a Location
+	// only reachable one-way from 3, and one not reachable at all
+	zone := core.NewZone(uuid.Nil, "test", nil)
+	zone.StartCommandProcessing()
+	defer zone.StopCommandProcessing()
+
+	var locs []*core.Location
+	for i := 0; i < 6; i++ {
+		loc, err := zone.AddLocation(core.NewLocation(uuid.Nil, zone, "a room", "an empty room"))
+		if err != nil {
+			t.Fatalf("Zone.AddLocation(): %s", err)
+		}
+		locs = append(locs, loc)
+	}
+	addExit := func(direction string, from, to *core.Location) {
+		_, err := zone.AddExit(core.NewExit(uuid.Nil, "", direction, from, to, zone, uuid.Nil, uuid.Nil))
+		if err != nil {
+			t.Fatalf("Zone.AddExit(): %s", err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		addExit(core.ExitDirectionEast, locs[i], locs[i+1])
+		addExit(core.ExitDirectionWest, locs[i+1], locs[i])
+	}
+	addExit(core.ExitDirectionDown, locs[3], locs[4])
+
+	testCases := map[string]struct {
+		origin, target int
+		radius         int
+		expected       bool
+	}{
+		"same Location, zero radius":     {origin: 0, target: 0, radius: 0, expected: true},
+		"neighbour, zero radius":         {origin: 0, target: 1, radius: 0, expected: false},
+		"neighbour, radius one":          {origin: 0, target: 1, radius: 1, expected: true},
+		"two away, radius one":           {origin: 0, target: 2, radius: 1, expected: false},
+		"three away, radius three":       {origin: 0, target: 3, radius: 3, expected: true},
+		"back the other way":             {origin: 3, target: 1, radius: 2, expected: true},
+		"through a one-way exit":         {origin: 2, target: 4, radius: 2, expected: true},
+		"against a one-way exit":         {origin: 4, target: 3, radius: 5, expected: false},
+		"unreachable, however far":       {origin: 0, target: 5, radius: 10, expected: false},
+		"radius beyond the whole layout": {origin: 0, target: 3, radius: 10, expected: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := withinExits(locs[tc.origin], locs[tc.target], tc.radius)
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
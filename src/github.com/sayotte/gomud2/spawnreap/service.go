@@ -14,9 +14,10 @@ import (
 )
 
 const (
-	DefaultConfigFile  = "spawnsCfg.yaml"
-	DefaultTickLengthS = 5
-	DefaultReapTicks   = 60 // 5 minutes @ 5-second ticks
+	DefaultConfigFile       = "spawnsCfg.yaml"
+	DefaultObjectConfigFile = "objectSpawnsCfg.yaml"
+	DefaultTickLengthS      = 5
+	DefaultReapTicks        = 60 // 5 minutes @ 5-second ticks
 )
 
 type Service struct {
@@ -29,10 +30,13 @@ type Service struct {
 	ReapTicks int
 	// Full path to config file for Actor spawns
 	ConfigFile string
-	cfgdb      *spawnConfigDatabase
+	// Full path to config file for Object spawns
+	ObjectConfigFile string
+	cfgdb            *spawnConfigDatabase
 
 	zoneToLocToObjectAgeMap      map[uuid.UUID]map[uuid.UUID]map[uuid.UUID]int
 	actorToAIBrainSpawnCountsMap map[uuid.UUID]int
+	zoneToActorLeashMap          map[uuid.UUID]map[uuid.UUID]leash
 
 	rando *rand.Rand
 
@@ -49,7 +53,13 @@ func (s *Service) Start() error {
 	if s.ConfigFile == "" {
 		s.ConfigFile = DefaultConfigFile
 	}
-	s.cfgdb = &spawnConfigDatabase{filename: s.ConfigFile}
+	if s.ObjectConfigFile == "" {
+		s.ObjectConfigFile = DefaultObjectConfigFile
+	}
+	s.cfgdb = &spawnConfigDatabase{
+		filename:       s.ConfigFile,
+		objectFilename: s.ObjectConfigFile,
+	}
 	err := s.cfgdb.load()
 	if err != nil {
 		return err
@@ -59,6 +69,7 @@ func (s *Service) Start() error {
 
 	s.zoneToLocToObjectAgeMap = make(map[uuid.UUID]map[uuid.UUID]map[uuid.UUID]int)
 	s.actorToAIBrainSpawnCountsMap = make(map[uuid.UUID]int)
+	s.zoneToActorLeashMap = make(map[uuid.UUID]map[uuid.UUID]leash)
 
 	if s.TickLengthS == 0 {
 		s.TickLengthS = DefaultTickLengthS
@@ -111,6 +122,8 @@ func (s *Service) handleTick() {
 	for _, zone := range s.World.Zones() {
 		s.reapZone(zone)
 		s.spawnZone(zone)
+		s.leashZone(zone)
+		s.spawnObjectsInZone(zone)
 		s.brainZone(zone)
 	}
 }
@@ -120,6 +133,20 @@ func (s *Service) reapZone(zone *core.Zone) {
 	if !found {
 		zoneMap = make(map[uuid.UUID]map[uuid.UUID]int)
 	}
+	// Objects still lying where they were spawned are left alone; reaping
+	// them would only see them spawned again. Only as many as the spawner
+	// would put there are spared, though, so that copies dropped by players
+	// don't pile up.
+	spawnedAt := make(map[uuid.UUID]map[uuid.UUID]int)
+	for _, spec := range s.cfgdb.getObjectEntryForZone(zone) {
+		if !uuid.Equal(spec.ContainerID, uuid.Nil) {
+			continue
+		}
+		if spawnedAt[spec.LocationID] == nil {
+			spawnedAt[spec.LocationID] = make(map[uuid.UUID]int)
+		}
+		spawnedAt[spec.LocationID][spec.PrototypeID] += spec.MaxCount
+	}
 	for _, loc := range zone.Locations() {
 		// only reap objects when Actors aren't around to see it
 		var doReap bool
@@ -133,7 +160,12 @@ func (s *Service) reapZone(zone *core.Zone) {
 		}
 		seenObjects := make(map[uuid.UUID]bool)
 		// increment the tick-age of all objects in the map
+		spared := spawnedAt[loc.ID()]
 		for _, object := range loc.Objects() {
+			if spared[object.PrototypeID()] > 0 {
+				spared[object.PrototypeID()]--
+				continue
+			}
 			seenObjects[object.ID()] = true
 			locMap[object.ID()]++
 			if doReap && locMap[object.ID()] > s.ReapTicks {
@@ -167,19 +199,37 @@ func (s *Service) PutSpawnConfigForZone(specList []SpawnSpecification, zone *cor
 	return s.cfgdb.putEntryForZone(specList, zone)
 }
 
+func (s *Service) GetObjectSpawnConfigForZone(zone *core.Zone) []ObjectSpawnSpecification {
+	return s.cfgdb.getObjectEntryForZone(zone)
+}
+
+func (s *Service) PutObjectSpawnConfigForZone(specList []ObjectSpawnSpecification, zone *core.Zone) error {
+	return s.cfgdb.putObjectEntryForZone(specList, zone)
+}
+
 func (s *Service) spawnZone(zone *core.Zone) {
 	specList := s.cfgdb.getEntryForZone(zone)
 
 	zoneActors := zone.Actors()
+	hour := time.Now().Hour()
 	for _, spec := range specList {
 		// determine if we should spawn anything at all
+		if spec.ActiveHours != nil && !spec.ActiveHours.contains(hour) {
+			continue
+		}
 		var currentCount int
 		for _, actor := range zoneActors {
 			if actor.Name() == spec.ActorProto.Name {
 				currentCount++
 			}
 		}
-		if currentCount >= spec.MaxCount {
+		groupSize := spec.groupSize()
+		if currentCount+groupSize > spec.MaxCount {
+			continue
+		}
+		candidates := allowedLocations(spec, zone)
+		if len(candidates) == 0 {
+			fmt.Printf("SpawnReap ERROR: no Locations in Zone %q allowed for %q\n", zone.Tag(), spec.ActorProto.Name)
 			continue
 		}
 		diceRoll := s.rando.Float64()
@@ -187,46 +237,110 @@ func (s *Service) spawnZone(zone *core.Zone) {
 			continue
 		}
 
-		// determine how many we should spawn
-		maxSpawnThisTick := spec.MaxCount - currentCount
-		spawnThisTick := s.rando.Intn(maxSpawnThisTick)
-		if spawnThisTick == 0 {
-			spawnThisTick = 1
+		// determine how many groups we should spawn
+		maxGroupsThisTick := (spec.MaxCount - currentCount) / groupSize
+		if spec.MaxSpawnAtOneTime > 0 {
+			maxGroupsAtOneTime := spec.MaxSpawnAtOneTime / groupSize
+			if maxGroupsAtOneTime < 1 {
+				maxGroupsAtOneTime = 1
+			}
+			if maxGroupsThisTick > maxGroupsAtOneTime {
+				maxGroupsThisTick = maxGroupsAtOneTime
+			}
 		}
+		groupsThisTick := 1 + s.rando.Intn(maxGroupsThisTick)
 
-		// find a Location to spawn in
-		var targetLoc *core.Location
-		zoneLocs := zone.Locations()
-		for _, loc := range zoneLocs {
-			// first try to find a Location with no Actors, to help with immersion
-			// note that this includes non-player Actors; this will result in
-			// different specs' spawns being distributed around the Zone
-			if len(loc.Actors()) == 0 {
-				targetLoc = loc
-				break
+		// spawn each group together, in a Location of its own if possible
+		for g := 0; g < groupsThisTick; g++ {
+			targetLoc := s.chooseSpawnLocation(candidates)
+			for i := 0; i < groupSize; i++ {
+				actor, err := zone.AddActor(spec.ActorProto.ToActor(targetLoc))
+				if err != nil {
+					fmt.Printf("SpawnReap ERROR: zone.AddActor(...): %s\n", err)
+					continue
+				}
+				err = spec.ActorProto.Equip(actor)
+				if err != nil {
+					fmt.Printf("SpawnReap ERROR: ActorPrototype.Equip(%s): %s\n", actor.ID(), err)
+				}
+				if spec.LeashRadius > 0 {
+					s.leashActor(zone, actor, targetLoc, spec.LeashRadius)
+				}
+			}
+		}
+	}
+}
+
+func (s *Service) spawnObjectsInZone(zone *core.Zone) {
+	for _, spec := range s.cfgdb.getObjectEntryForZone(zone) {
+		proto, found := core.ObjectPrototypeByID(spec.PrototypeID)
+		if !found {
+			fmt.Printf("SpawnReap ERROR: no such Object prototype %q\n", spec.PrototypeID)
+			continue
+		}
+
+		// find where we're spawning, and how many are already there
+		var loc *core.Location
+		var cont core.Container
+		if uuid.Equal(spec.ContainerID, uuid.Nil) {
+			loc = zone.LocationByID(spec.LocationID)
+			if loc == nil {
+				fmt.Printf("SpawnReap ERROR: no such Location %q in Zone %q\n", spec.LocationID, zone.Tag())
+				continue
+			}
+			cont = loc
+		} else {
+			contObj := zone.ObjectByID(spec.ContainerID)
+			if contObj == nil {
+				// it may have been destroyed, or carried off to another Zone
+				continue
 			}
+			loc = contObj.Location()
+			cont = contObj
 		}
-		if targetLoc == nil {
-			// failing any empty Locations, just spawn them in a random Location
-			if len(zoneLocs) == 1 {
-				// special case-- if the zone has only one location, spawn there:
-				targetLoc = zoneLocs[0]
-			} else {
-				idx := s.rando.Intn(len(zoneLocs) - 1)
-				targetLoc = zoneLocs[idx]
+		var currentCount int
+		for _, obj := range cont.Objects() {
+			if uuid.Equal(obj.PrototypeID(), spec.PrototypeID) {
+				currentCount++
 			}
 		}
+		if currentCount >= spec.MaxCount {
+			continue
+		}
+		diceRoll := s.rando.Float64()
+		if diceRoll >= spec.SpawnChancePerTick {
+			continue
+		}
 
-		// spawn
+		spawnThisTick := spec.MaxCount - currentCount
+		if spec.MaxSpawnAtOneTime > 0 && spawnThisTick > spec.MaxSpawnAtOneTime {
+			spawnThisTick = spec.MaxSpawnAtOneTime
+		}
 		for i := 0; i < spawnThisTick; i++ {
-			_, err := zone.AddActor(spec.ActorProto.ToActor(targetLoc))
+			if !hasRoomFor(cont, proto) {
+				break
+			}
+			_, err := zone.AddObject(proto.ToObject(cont, zone), loc)
 			if err != nil {
-				fmt.Printf("SpawnReap ERROR: zone.AddActor(...): %s\n", err)
+				fmt.Printf("SpawnReap ERROR: zone.AddObject(...): %s\n", err)
+				break
 			}
 		}
 	}
 }
 
+// hasRoomFor reports whether an Object made from the prototype would fit in
+// the given Location or container Object.
+func hasRoomFor(cont core.Container, proto core.ObjectPrototype) bool {
+	switch typed := cont.(type) {
+	case *core.Location:
+		return len(typed.Objects()) < typed.Capacity()
+	case *core.Object:
+		return typed.SlotsUsed()+proto.Attributes.InventorySlots <= typed.Capacity()
+	}
+	return false
+}
+
 func (s *Service) brainZone(zone *core.Zone) {
 	zoneActors := zone.Actors()
 	for _, actor := range zoneActors {
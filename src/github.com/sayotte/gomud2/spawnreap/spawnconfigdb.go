@@ -15,7 +15,11 @@ import (
 type spawnConfigDatabase struct {
 	filename            string
 	zoneToSpawnSpecsMap map[uuid.UUID][]SpawnSpecification
-	rwlock              *sync.RWMutex
+	// Object spawns are kept in a file of their own, so that files written
+	// before there were any are still readable
+	objectFilename            string
+	zoneToObjectSpawnSpecsMap map[uuid.UUID][]ObjectSpawnSpecification
+	rwlock                    *sync.RWMutex
 }
 
 func (scdb *spawnConfigDatabase) load() error {
@@ -24,17 +28,35 @@ func (scdb *spawnConfigDatabase) load() error {
 	defer scdb.rwlock.Unlock()
 
 	scdb.zoneToSpawnSpecsMap = make(map[uuid.UUID][]SpawnSpecification)
+	scdb.zoneToObjectSpawnSpecsMap = make(map[uuid.UUID][]ObjectSpawnSpecification)
 
-	if _, err := os.Stat(scdb.filename); os.IsNotExist(err) {
+	err := loadYAMLFile(scdb.filename, scdb.zoneToSpawnSpecsMap)
+	if err != nil {
+		return err
+	}
+	for zoneID, specList := range scdb.zoneToSpawnSpecsMap {
+		err = validateSpecs(specList)
+		if err != nil {
+			return fmt.Errorf("Zone %q: %s", zoneID, err)
+		}
+	}
+	if scdb.objectFilename == "" {
+		return nil
+	}
+	return loadYAMLFile(scdb.objectFilename, scdb.zoneToObjectSpawnSpecsMap)
+}
+
+func loadYAMLFile(filename string, into interface{}) error {
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
 		return nil
 	}
 
-	dbBytes, err := ioutil.ReadFile(scdb.filename)
+	dbBytes, err := ioutil.ReadFile(filename)
 	if err != nil {
-		return fmt.Errorf("ioutil.ReadFile(%q): %s", scdb.filename, err)
+		return fmt.Errorf("ioutil.ReadFile(%q): %s", filename, err)
 	}
 
-	err = yaml.Unmarshal(dbBytes, scdb.zoneToSpawnSpecsMap)
+	err = yaml.Unmarshal(dbBytes, into)
 	if err != nil {
 		return fmt.Errorf("yaml.Unmarshal(): %s", err)
 	}
@@ -42,14 +64,32 @@ func (scdb *spawnConfigDatabase) load() error {
 	return nil
 }
 
+func validateSpecs(specList []SpawnSpecification) error {
+	for i, spec := range specList {
+		err := spec.validate()
+		if err != nil {
+			return fmt.Errorf("spec %d (%s): %s", i, spec.ActorProto.Name, err)
+		}
+	}
+	return nil
+}
+
 func (scdb *spawnConfigDatabase) save() error {
-	dbBytes, err := yaml.Marshal(scdb.zoneToSpawnSpecsMap)
+	return saveYAMLFile(scdb.filename, scdb.zoneToSpawnSpecsMap)
+}
+
+func (scdb *spawnConfigDatabase) saveObjects() error {
+	return saveYAMLFile(scdb.objectFilename, scdb.zoneToObjectSpawnSpecsMap)
+}
+
+func saveYAMLFile(filename string, from interface{}) error {
+	dbBytes, err := yaml.Marshal(from)
 	if err != nil {
 		return fmt.Errorf("yaml.Marshal(): %s", err)
 	}
-	err = ioutil.WriteFile(scdb.filename, dbBytes, 0644)
+	err = ioutil.WriteFile(filename, dbBytes, 0644)
 	if err != nil {
-		return fmt.Errorf("ioutil.WriteFile(%q): %s", scdb.filename, err)
+		return fmt.Errorf("ioutil.WriteFile(%q): %s", filename, err)
 	}
 	return nil
 }
@@ -61,8 +101,25 @@ func (scdb *spawnConfigDatabase) getEntryForZone(zone *core.Zone) []SpawnSpecifi
 }
 
 func (scdb *spawnConfigDatabase) putEntryForZone(specList []SpawnSpecification, zone *core.Zone) error {
+	err := validateSpecs(specList)
+	if err != nil {
+		return err
+	}
 	scdb.rwlock.Lock()
 	defer scdb.rwlock.Unlock()
 	scdb.zoneToSpawnSpecsMap[zone.ID()] = specList
 	return scdb.save()
 }
+
+func (scdb *spawnConfigDatabase) getObjectEntryForZone(zone *core.Zone) []ObjectSpawnSpecification {
+	scdb.rwlock.RLock()
+	defer scdb.rwlock.RUnlock()
+	return scdb.zoneToObjectSpawnSpecsMap[zone.ID()]
+}
+
+func (scdb *spawnConfigDatabase) putObjectEntryForZone(specList []ObjectSpawnSpecification, zone *core.Zone) error {
+	scdb.rwlock.Lock()
+	defer scdb.rwlock.Unlock()
+	scdb.zoneToObjectSpawnSpecsMap[zone.ID()] = specList
+	return scdb.saveObjects()
+}
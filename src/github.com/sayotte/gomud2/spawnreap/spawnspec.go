@@ -1,6 +1,8 @@
 package spawnreap
 
 import (
+	"fmt"
+
 	"github.com/satori/go.uuid"
 	"github.com/sayotte/gomud2/core"
 )
@@ -10,6 +12,65 @@ type SpawnSpecification struct {
 	MaxCount           int
 	MaxSpawnAtOneTime  int
 	SpawnChancePerTick float64
+
+	// Actors spawn only in the Locations listed by LocationIDs, and in those
+	// tagged with any of LocationTags, e.g. a "lair"; if both are empty they
+	// may spawn anywhere in the Zone.
+	LocationIDs  []uuid.UUID
+	LocationTags []string
+	// GroupSize is how many Actors spawn together as a pack, in the same
+	// Location; a pack only spawns if there's room for all of it under
+	// MaxCount. Zero means they spawn singly.
+	GroupSize int
+	// ActiveHours, if given, limits spawning to those hours of the day.
+	ActiveHours *HourWindow
+	// LeashRadius, if non-zero, is how many exits away from where it spawned
+	// an Actor may wander before it's returned there.
+	LeashRadius int
+}
+
+func (ss SpawnSpecification) validate() error {
+	if ss.ActiveHours != nil {
+		err := ss.ActiveHours.validate()
+		if err != nil {
+			return fmt.Errorf("ActiveHours: %s", err)
+		}
+	}
+	return nil
+}
+
+func (ss SpawnSpecification) groupSize() int {
+	if ss.GroupSize < 1 {
+		return 1
+	}
+	return ss.GroupSize
+}
+
+// HourWindow is a span of hours of the day (0-23, in the server's local
+// time), from the start of Start until the start of End. A window whose End
+// comes before its Start runs past midnight, e.g. 22-4 for the small hours.
+// A window must have a different Start and End; see validate.
+type HourWindow struct {
+	Start, End int
+}
+
+// validate rejects a window with an hour outside 0-23, or with the same Start
+// and End, which would be ambiguous between never and always.
+func (hw HourWindow) validate() error {
+	if hw.Start < 0 || hw.Start > 23 || hw.End < 0 || hw.End > 23 {
+		return fmt.Errorf("hours must be between 0 and 23, got %d-%d", hw.Start, hw.End)
+	}
+	if hw.Start == hw.End {
+		return fmt.Errorf("start and end must differ, got %d-%d", hw.Start, hw.End)
+	}
+	return nil
+}
+
+func (hw HourWindow) contains(hour int) bool {
+	if hw.Start <= hw.End {
+		return hour >= hw.Start && hour < hw.End
+	}
+	return hour >= hw.Start || hour < hw.End
 }
 
 type ActorPrototype struct {
@@ -18,6 +79,9 @@ type ActorPrototype struct {
 	Attributes           core.AttributeSet
 	Skills               core.Skillset
 	InventoryConstraints core.ActorInventoryConstraints
+	// Equipment lists the IDs of the core.ObjectPrototypes each spawned
+	// Actor starts out carrying.
+	Equipment []uuid.UUID
 }
 
 func (ap ActorPrototype) ToActor(loc *core.Location) *core.Actor {
@@ -32,3 +96,41 @@ func (ap ActorPrototype) ToActor(loc *core.Location) *core.Actor {
 		ap.InventoryConstraints,
 	)
 }
+
+// Equip gives a freshly spawned Actor its starting equipment, made from the
+// prototypes listed in Equipment.
+func (ap ActorPrototype) Equip(actor *core.Actor) error {
+	loc := actor.Location()
+	for _, protoID := range ap.Equipment {
+		proto, found := core.ObjectPrototypeByID(protoID)
+		if !found {
+			return fmt.Errorf("no such Object prototype %q", protoID)
+		}
+		obj, err := actor.Zone().AddObject(proto.ToObject(loc, actor.Zone()), loc)
+		if err != nil {
+			return fmt.Errorf("Zone.AddObject(): %s", err)
+		}
+		err = obj.Move(loc, actor, actor, core.ContainerDefaultSubcontainer)
+		if err != nil {
+			return fmt.Errorf("Object.Move(): %s", err)
+		}
+	}
+	return nil
+}
+
+// ObjectSpawnSpecification keeps a Location, or a container Object such as a
+// chest, stocked with Objects made from a prototype: each tick, if there are
+// fewer than MaxCount of them there, more are made with the given chance.
+// Objects taken away no longer count, so spawn points repopulate as players
+// help themselves.
+type ObjectSpawnSpecification struct {
+	// PrototypeID identifies the core.ObjectPrototype Objects are made from.
+	PrototypeID uuid.UUID
+	// Objects are spawned at the Location given by LocationID or, if
+	// ContainerID is given instead, inside that Object.
+	LocationID         uuid.UUID
+	ContainerID        uuid.UUID
+	MaxCount           int
+	MaxSpawnAtOneTime  int
+	SpawnChancePerTick float64
+}
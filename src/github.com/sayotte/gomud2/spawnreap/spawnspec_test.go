@@ -0,0 +1,60 @@
+package spawnreap
+
+import (
+	"testing"
+)
+
+func TestHourWindow_contains(t *testing.T) {
+	testCases := map[string]struct {
+		window   HourWindow
+		hour     int
+		expected bool
+	}{
+		"before a daytime window":        {window: HourWindow{Start: 8, End: 18}, hour: 7, expected: false},
+		"at the start of a window":       {window: HourWindow{Start: 8, End: 18}, hour: 8, expected: true},
+		"within a daytime window":        {window: HourWindow{Start: 8, End: 18}, hour: 12, expected: true},
+		"at the end of a window":         {window: HourWindow{Start: 8, End: 18}, hour: 18, expected: false},
+		"before midnight, wrapping":      {window: HourWindow{Start: 22, End: 4}, hour: 23, expected: true},
+		"at midnight, wrapping":          {window: HourWindow{Start: 22, End: 4}, hour: 0, expected: true},
+		"after midnight, wrapping":       {window: HourWindow{Start: 22, End: 4}, hour: 3, expected: true},
+		"at the end, wrapping":           {window: HourWindow{Start: 22, End: 4}, hour: 4, expected: false},
+		"midday outside a wrapping span": {window: HourWindow{Start: 22, End: 4}, hour: 12, expected: false},
+		"window ending at midnight":      {window: HourWindow{Start: 20, End: 0}, hour: 23, expected: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.window.contains(tc.hour)
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestHourWindow_validate(t *testing.T) {
+	testCases := map[string]struct {
+		window    HourWindow
+		expectErr bool
+	}{
+		"daytime window":       {window: HourWindow{Start: 8, End: 18}},
+		"wrapping window":      {window: HourWindow{Start: 22, End: 4}},
+		"start equals end":     {window: HourWindow{Start: 6, End: 6}, expectErr: true},
+		"zero-value window":    {window: HourWindow{}, expectErr: true},
+		"negative start":       {window: HourWindow{Start: -1, End: 4}, expectErr: true},
+		"end past the day":     {window: HourWindow{Start: 20, End: 24}, expectErr: true},
+		"nearly the whole day": {window: HourWindow{Start: 0, End: 23}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.window.validate()
+			if tc.expectErr && err == nil {
+				t.Error("expected an error, got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package store
 
 import (
+	"time"
+
 	"github.com/satori/go.uuid"
 	"github.com/sayotte/gomud2/core"
 )
@@ -77,6 +79,19 @@ type actorAddToZoneEvent struct {
 	Attributes                  core.AttributeSet
 	Skills                      core.Skillset
 	InventoryConstraints        core.ActorInventoryConstraints
+	GhostUntil                  time.Time
+	BindLocationID              uuid.UUID
+	KnownReactions              []string
+	DeityID                     uuid.UUID
+	MysticismAsOf               time.Time
+	Shop                        *core.ShopRules
+	HireTerms                   *core.HireTerms
+	HirelingContract            *core.HirelingContract
+	Factions                    []string
+	Reputation                  map[string]int
+	Quests                      map[string]core.QuestProgress
+	Progression                 []core.ProgressionEntry
+	ActiveEffects               []core.ActiveEffect
 }
 
 func (aatze *actorAddToZoneEvent) FromDomain(e core.Event) {
@@ -90,6 +105,19 @@ func (aatze *actorAddToZoneEvent) FromDomain(e core.Event) {
 		Attributes:           from.Attributes,
 		Skills:               from.Skills,
 		InventoryConstraints: from.InventoryConstraints,
+		GhostUntil:           from.GhostUntil,
+		BindLocationID:       from.BindLocationID,
+		KnownReactions:       from.KnownReactions,
+		DeityID:              from.DeityID,
+		MysticismAsOf:        from.MysticismAsOf,
+		Shop:                 from.Shop,
+		HireTerms:            from.HireTerms,
+		HirelingContract:     from.HirelingContract,
+		Factions:             from.Factions,
+		Reputation:           from.Reputation,
+		Quests:               from.Quests,
+		Progression:          from.Progression,
+		ActiveEffects:        from.ActiveEffects,
 	}
 }
 
@@ -104,6 +132,19 @@ func (aatze actorAddToZoneEvent) ToDomain() core.Event {
 		aatze.Skills,
 		aatze.InventoryConstraints,
 	)
+	e.GhostUntil = aatze.GhostUntil
+	e.BindLocationID = aatze.BindLocationID
+	e.KnownReactions = aatze.KnownReactions
+	e.DeityID = aatze.DeityID
+	e.MysticismAsOf = aatze.MysticismAsOf
+	e.Shop = aatze.Shop
+	e.HireTerms = aatze.HireTerms
+	e.HirelingContract = aatze.HirelingContract
+	e.Factions = aatze.Factions
+	e.Reputation = aatze.Reputation
+	e.Quests = aatze.Quests
+	e.Progression = aatze.Progression
+	e.ActiveEffects = aatze.ActiveEffects
 	e.SetSequenceNumber(aatze.header.SequenceNumber)
 	e.SetTimestamp(aatze.header.Timestamp)
 	return e
@@ -146,22 +187,28 @@ func (arfze *actorRemoveFromZoneEvent) SetHeader(h eventHeader) {
 }
 
 type actorDeathEvent struct {
-	header    eventHeader
-	ActorName string
-	ActorID   uuid.UUID
+	header     eventHeader
+	ActorName  string
+	ActorID    uuid.UUID
+	KillerID   uuid.UUID
+	KillerName string
 }
 
 func (ade *actorDeathEvent) FromDomain(e core.Event) {
 	from := e.(*core.ActorDeathEvent)
 	*ade = actorDeathEvent{
-		header:    eventHeaderFromDomainEvent(from),
-		ActorName: from.ActorName,
-		ActorID:   from.ActorID,
+		header:     eventHeaderFromDomainEvent(from),
+		ActorName:  from.ActorName,
+		ActorID:    from.ActorID,
+		KillerID:   from.KillerID,
+		KillerName: from.KillerName,
 	}
 }
 
 func (ade *actorDeathEvent) ToDomain() core.Event {
 	e := core.NewActorDeathEvent(ade.ActorName, ade.ActorID, ade.header.AggregateId)
+	e.KillerID = ade.KillerID
+	e.KillerName = ade.KillerName
 	e.SetSequenceNumber(ade.header.SequenceNumber)
 	e.SetTimestamp(ade.header.Timestamp)
 	return e
@@ -184,6 +231,19 @@ type actorMigrateInEvent struct {
 	Attributes            core.AttributeSet
 	Skills                core.Skillset
 	InventoryConstraints  core.ActorInventoryConstraints
+	GhostUntil            time.Time
+	BindLocationID        uuid.UUID
+	KnownReactions        []string
+	DeityID               uuid.UUID
+	MysticismAsOf         time.Time
+	Shop                  *core.ShopRules
+	HireTerms             *core.HireTerms
+	HirelingContract      *core.HirelingContract
+	Factions              []string
+	Reputation            map[string]int
+	Quests                map[string]core.QuestProgress
+	Progression           []core.ProgressionEntry
+	ActiveEffects         []core.ActiveEffect
 }
 
 func (amie *actorMigrateInEvent) FromDomain(e core.Event) {
@@ -199,6 +259,19 @@ func (amie *actorMigrateInEvent) FromDomain(e core.Event) {
 		Attributes:           from.Attributes,
 		Skills:               from.Skills,
 		InventoryConstraints: from.InventoryConstraints,
+		GhostUntil:           from.GhostUntil,
+		BindLocationID:       from.BindLocationID,
+		KnownReactions:       from.KnownReactions,
+		DeityID:              from.DeityID,
+		MysticismAsOf:        from.MysticismAsOf,
+		Shop:                 from.Shop,
+		HireTerms:            from.HireTerms,
+		HirelingContract:     from.HirelingContract,
+		Factions:             from.Factions,
+		Reputation:           from.Reputation,
+		Quests:               from.Quests,
+		Progression:          from.Progression,
+		ActiveEffects:        from.ActiveEffects,
 	}
 	return
 }
@@ -216,6 +289,19 @@ func (amie actorMigrateInEvent) ToDomain() core.Event {
 		amie.Skills,
 		amie.InventoryConstraints,
 	)
+	e.GhostUntil = amie.GhostUntil
+	e.BindLocationID = amie.BindLocationID
+	e.KnownReactions = amie.KnownReactions
+	e.DeityID = amie.DeityID
+	e.MysticismAsOf = amie.MysticismAsOf
+	e.Shop = amie.Shop
+	e.HireTerms = amie.HireTerms
+	e.HirelingContract = amie.HirelingContract
+	e.Factions = amie.Factions
+	e.Reputation = amie.Reputation
+	e.Quests = amie.Quests
+	e.Progression = amie.Progression
+	e.ActiveEffects = amie.ActiveEffects
 	e.SetSequenceNumber(amie.header.SequenceNumber)
 	e.SetTimestamp(amie.header.Timestamp)
 	return e
@@ -299,3 +385,108 @@ func (ase actorSpeakEvent) Header() eventHeader {
 func (ase *actorSpeakEvent) SetHeader(h eventHeader) {
 	ase.header = h
 }
+
+type actorBecomeGhostEvent struct {
+	header    eventHeader
+	ActorName string
+	ActorID   uuid.UUID
+	RespawnAt time.Time
+}
+
+func (abge *actorBecomeGhostEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorBecomeGhostEvent)
+	*abge = actorBecomeGhostEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorName: from.ActorName,
+		ActorID:   from.ActorID,
+		RespawnAt: from.RespawnAt,
+	}
+}
+
+func (abge actorBecomeGhostEvent) ToDomain() core.Event {
+	e := core.NewActorBecomeGhostEvent(abge.ActorName, abge.ActorID, abge.header.AggregateId, abge.RespawnAt)
+	e.SetSequenceNumber(abge.header.SequenceNumber)
+	e.SetTimestamp(abge.header.Timestamp)
+	return e
+}
+
+func (abge actorBecomeGhostEvent) Header() eventHeader {
+	return abge.header
+}
+
+func (abge *actorBecomeGhostEvent) SetHeader(h eventHeader) {
+	abge.header = h
+}
+
+type actorRespawnEvent struct {
+	header       eventHeader
+	ActorName    string
+	ActorID      uuid.UUID
+	ToLocationID uuid.UUID
+	Attributes   core.AttributeSet
+	Skills       core.Skillset
+}
+
+func (are *actorRespawnEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorRespawnEvent)
+	*are = actorRespawnEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		ActorName:    from.ActorName,
+		ActorID:      from.ActorID,
+		ToLocationID: from.ToLocationID,
+		Attributes:   from.Attributes,
+		Skills:       from.Skills,
+	}
+}
+
+func (are actorRespawnEvent) ToDomain() core.Event {
+	e := core.NewActorRespawnEvent(
+		are.ActorName,
+		are.ActorID,
+		are.ToLocationID,
+		are.header.AggregateId,
+		are.Attributes,
+		are.Skills,
+	)
+	e.SetSequenceNumber(are.header.SequenceNumber)
+	e.SetTimestamp(are.header.Timestamp)
+	return e
+}
+
+func (are actorRespawnEvent) Header() eventHeader {
+	return are.header
+}
+
+func (are *actorRespawnEvent) SetHeader(h eventHeader) {
+	are.header = h
+}
+
+type actorSetBindLocationEvent struct {
+	header     eventHeader
+	ActorID    uuid.UUID
+	LocationID uuid.UUID
+}
+
+func (asble *actorSetBindLocationEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorSetBindLocationEvent)
+	*asble = actorSetBindLocationEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		ActorID:    from.ActorID,
+		LocationID: from.LocationID,
+	}
+}
+
+func (asble actorSetBindLocationEvent) ToDomain() core.Event {
+	e := core.NewActorSetBindLocationEvent(asble.ActorID, asble.LocationID, asble.header.AggregateId)
+	e.SetSequenceNumber(asble.header.SequenceNumber)
+	e.SetTimestamp(asble.header.Timestamp)
+	return e
+}
+
+func (asble actorSetBindLocationEvent) Header() eventHeader {
+	return asble.header
+}
+
+func (asble *actorSetBindLocationEvent) SetHeader(h eventHeader) {
+	asble.header = h
+}
@@ -0,0 +1,174 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+var (
+	testAttributes = core.AttributeSet{
+		Strength: 10, StrengthCap: 20,
+		Fitness: 11, FitnessCap: 21,
+		Will: 12, WillCap: 22,
+		Faith: 13, Faithcap: 23,
+		Physical: 5, Stamina: 6, Focus: 7, Zeal: 8,
+		NaturalBiteMin: 1, NaturalBiteMax: 2,
+	}
+	testSkills = core.Skillset{
+		Slashing: 10, SlashingCap: 20,
+		Dodging: 5, DodgingCap: 15,
+		DodgingTechniques: 1, DodgingTechniquesCap: 2,
+	}
+)
+
+func TestActorAddToZoneEvent_roundtrip(t *testing.T) {
+	e := core.NewActorAddToZoneEvent(
+		"bob",
+		"crowd-averse-wanderer",
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+		testAttributes,
+		testSkills,
+		core.DefaultHumanInventoryConstraints,
+	)
+	e.GhostUntil = testTimestamp
+	e.BindLocationID = myuuid.NewId()
+	e.KnownReactions = []string{"fireball", "heal"}
+	e.DeityID = myuuid.NewId()
+	e.MysticismAsOf = testTimestamp
+	e.Shop = &core.ShopRules{SellRate: 1.5, BuyRate: 0.5, BuysKeywords: []string{"sword"}}
+	e.HireTerms = &core.HireTerms{Wage: 10, Term: time.Hour}
+	e.HirelingContract = &core.HirelingContract{
+		EmployerID:   myuuid.NewId(),
+		EmployerName: "alice",
+		HiredUntil:   testTimestamp,
+		Friends:      []uuid.UUID{myuuid.NewId()},
+		Enemies:      []uuid.UUID{myuuid.NewId()},
+		Order:        core.HirelingOrder{Order: core.HirelingOrderStay, LocationID: myuuid.NewId()},
+	}
+	e.Factions = []string{"guards", "merchants"}
+	e.Reputation = map[string]int{"guards": 10, "thieves": -20}
+	e.Quests = map[string]core.QuestProgress{
+		"rat problem": {
+			Name:        "rat problem",
+			Progress:    []int{3, 1},
+			Completed:   true,
+			AcceptedAt:  testTimestamp,
+			CompletedAt: testTimestamp,
+		},
+	}
+	e.Progression = []core.ProgressionEntry{
+		{
+			At:     testTimestamp,
+			Reason: core.ProgressionReasonReading,
+			Trait:  "Sorcery",
+			From:   10,
+			To:     12.5,
+			Detail: "fireball",
+		},
+		{
+			At:     testTimestamp,
+			Reason: core.ProgressionReasonDeath,
+			Trait:  "Slashing",
+			From:   20,
+			To:     18,
+		},
+	}
+	e.ActiveEffects = []core.ActiveEffect{testActiveEffect()}
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
+
+func TestActorMigrateInEvent_roundtrip(t *testing.T) {
+	e := core.NewActorMigrateInEvent(
+		"bob",
+		"crowd-averse-wanderer",
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+		testAttributes,
+		testSkills,
+		core.DefaultHumanInventoryConstraints,
+	)
+	e.GhostUntil = testTimestamp
+	e.BindLocationID = myuuid.NewId()
+	e.KnownReactions = []string{"fireball", "heal"}
+	e.DeityID = myuuid.NewId()
+	e.MysticismAsOf = testTimestamp
+	e.Shop = &core.ShopRules{SellRate: 1.5, BuyRate: 0.5, BuysKeywords: []string{"sword"}}
+	e.HireTerms = &core.HireTerms{Wage: 10, Term: time.Hour}
+	e.HirelingContract = &core.HirelingContract{
+		EmployerID:   myuuid.NewId(),
+		EmployerName: "alice",
+		HiredUntil:   testTimestamp,
+		Friends:      []uuid.UUID{myuuid.NewId()},
+		Enemies:      []uuid.UUID{myuuid.NewId()},
+		Order:        core.HirelingOrder{Order: core.HirelingOrderStay, LocationID: myuuid.NewId()},
+	}
+	e.Factions = []string{"guards", "merchants"}
+	e.Reputation = map[string]int{"guards": 10, "thieves": -20}
+	e.Quests = map[string]core.QuestProgress{
+		"rat problem": {
+			Name:        "rat problem",
+			Progress:    []int{3, 1},
+			Completed:   true,
+			AcceptedAt:  testTimestamp,
+			CompletedAt: testTimestamp,
+		},
+	}
+	e.Progression = []core.ProgressionEntry{
+		{
+			At:     testTimestamp,
+			Reason: core.ProgressionReasonReading,
+			Trait:  "Sorcery",
+			From:   10,
+			To:     12.5,
+			Detail: "fireball",
+		},
+		{
+			At:     testTimestamp,
+			Reason: core.ProgressionReasonDeath,
+			Trait:  "Slashing",
+			From:   20,
+			To:     18,
+		},
+	}
+	e.ActiveEffects = []core.ActiveEffect{testActiveEffect()}
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
+
+func TestActorBecomeGhostEvent_roundtrip(t *testing.T) {
+	e := core.NewActorBecomeGhostEvent("bob", myuuid.NewId(), myuuid.NewId(), testTimestamp)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
+
+func TestActorRespawnEvent_roundtrip(t *testing.T) {
+	e := core.NewActorRespawnEvent("bob", myuuid.NewId(), myuuid.NewId(), myuuid.NewId(), testAttributes, testSkills)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
+
+func TestActorSetBindLocationEvent_roundtrip(t *testing.T) {
+	e := core.NewActorSetBindLocationEvent(myuuid.NewId(), myuuid.NewId(), myuuid.NewId())
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
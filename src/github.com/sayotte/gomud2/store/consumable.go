@@ -0,0 +1,50 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type actorConsumeEvent struct {
+	header                eventHeader
+	ActorID, ObjectID     uuid.UUID
+	ActorName, ObjectName string
+	Method                string
+	UsesLeft              int
+}
+
+func (ace actorConsumeEvent) ToDomain() core.Event {
+	e := core.NewActorConsumeEvent(
+		ace.ActorID,
+		ace.ObjectID,
+		ace.header.AggregateId,
+		ace.ActorName,
+		ace.ObjectName,
+		ace.Method,
+		ace.UsesLeft,
+	)
+	e.SetSequenceNumber(ace.header.SequenceNumber)
+	e.SetTimestamp(ace.header.Timestamp)
+	return e
+}
+
+func (ace *actorConsumeEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorConsumeEvent)
+	*ace = actorConsumeEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		ActorID:    from.ActorID,
+		ObjectID:   from.ObjectID,
+		ActorName:  from.ActorName,
+		ObjectName: from.ObjectName,
+		Method:     from.Method,
+		UsesLeft:   from.UsesLeft,
+	}
+}
+
+func (ace actorConsumeEvent) Header() eventHeader {
+	return ace.header
+}
+
+func (ace *actorConsumeEvent) SetHeader(h eventHeader) {
+	ace.header = h
+}
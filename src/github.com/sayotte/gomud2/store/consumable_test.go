@@ -0,0 +1,76 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestConsumableEvents_roundtrip(t *testing.T) {
+	testCases := map[string]core.Event{
+		"ActorConsumeEvent": core.NewActorConsumeEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			"a healing potion",
+			core.ConsumeMethodDrink,
+			2,
+		),
+		"ObjectAddToZoneEvent": core.NewObjectAddToZoneEvent(
+			"a healing potion",
+			"a small vial of something red",
+			[]string{"potion", "healing"},
+			5,
+			myuuid.NewId(),
+			myuuid.NewId(),
+			uuid.Nil,
+			uuid.Nil,
+			myuuid.NewId(),
+			core.ContainerDefaultSubcontainer,
+			core.ObjectAttributes{
+				Weight:         0.5,
+				InventorySlots: 1,
+				Value:          25,
+				ConsumeMethod:  core.ConsumeMethodDrink,
+				Uses:           3,
+				Effects: []core.ConsumableEffect{
+					{
+						Type:      core.ConsumableEffectRestore,
+						Attribute: core.EffectAttributePhysical,
+						Magnitude: 20,
+					},
+					{
+						Type:      core.ConsumableEffectBuff,
+						Attribute: core.EffectAttributeStamina,
+						Magnitude: 10,
+						Duration:  time.Minute,
+					},
+					{
+						Type: core.ConsumableEffectStatus,
+						Status: &core.StatusEffect{
+							Name:          "invigorated",
+							Stacking:      core.StatusEffectStackingRefresh,
+							Attributes:    core.AttributeSet{Focus: 5},
+							TickAttribute: core.EffectAttributeFocus,
+							TickDelta:     1,
+							TickInterval:  time.Second * 10,
+							Duration:      time.Minute,
+						},
+					},
+				},
+			},
+		),
+	}
+
+	for name, e := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e.SetSequenceNumber(97)
+			e.SetTimestamp(testTimestamp)
+			assertRoundtrip(t, e)
+		})
+	}
+}
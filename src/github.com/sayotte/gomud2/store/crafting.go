@@ -0,0 +1,51 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type actorCraftEvent struct {
+	header            eventHeader
+	ActorID           uuid.UUID
+	ActorName, Recipe string
+	Success           bool
+	Skill             string
+	SkillValue        float64
+}
+
+func (ace *actorCraftEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorCraftEvent)
+	*ace = actorCraftEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		ActorID:    from.ActorID,
+		ActorName:  from.ActorName,
+		Recipe:     from.Recipe,
+		Success:    from.Success,
+		Skill:      from.Skill,
+		SkillValue: from.SkillValue,
+	}
+}
+
+func (ace actorCraftEvent) ToDomain() core.Event {
+	e := core.NewActorCraftEvent(
+		ace.ActorID,
+		ace.header.AggregateId,
+		ace.ActorName,
+		ace.Recipe,
+		ace.Success,
+		ace.Skill,
+		ace.SkillValue,
+	)
+	e.SetSequenceNumber(ace.header.SequenceNumber)
+	e.SetTimestamp(ace.header.Timestamp)
+	return e
+}
+
+func (ace actorCraftEvent) Header() eventHeader {
+	return ace.header
+}
+
+func (ace *actorCraftEvent) SetHeader(h eventHeader) {
+	ace.header = h
+}
@@ -0,0 +1,24 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestActorCraftEvent_roundtrip(t *testing.T) {
+	e := core.NewActorCraftEvent(
+		myuuid.NewId(),
+		myuuid.NewId(),
+		"bob",
+		"iron sword",
+		true,
+		core.SkillCrafting,
+		32.5,
+	)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
@@ -0,0 +1,164 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+type zoneSetLawEvent struct {
+	header eventHeader
+	Law    core.ZoneLaw
+}
+
+func (zsle zoneSetLawEvent) ToDomain() core.Event {
+	e := core.NewZoneSetLawEvent(zsle.Law, zsle.header.AggregateId)
+	e.SetSequenceNumber(zsle.header.SequenceNumber)
+	e.SetTimestamp(zsle.header.Timestamp)
+	return e
+}
+
+func (zsle *zoneSetLawEvent) FromDomain(e core.Event) {
+	from := e.(*core.ZoneSetLawEvent)
+	*zsle = zoneSetLawEvent{
+		header: eventHeaderFromDomainEvent(from),
+		Law:    from.Law,
+	}
+}
+
+func (zsle zoneSetLawEvent) Header() eventHeader {
+	return zsle.header
+}
+
+func (zsle *zoneSetLawEvent) SetHeader(h eventHeader) {
+	zsle.header = h
+}
+
+type crimeCommitEvent struct {
+	header eventHeader
+	Crime  core.Crime
+}
+
+func (cce crimeCommitEvent) ToDomain() core.Event {
+	e := core.NewCrimeCommitEvent(cce.Crime, cce.header.AggregateId)
+	e.SetSequenceNumber(cce.header.SequenceNumber)
+	e.SetTimestamp(cce.header.Timestamp)
+	return e
+}
+
+func (cce *crimeCommitEvent) FromDomain(e core.Event) {
+	from := e.(*core.CrimeCommitEvent)
+	*cce = crimeCommitEvent{
+		header: eventHeaderFromDomainEvent(from),
+		Crime:  from.Crime,
+	}
+}
+
+func (cce crimeCommitEvent) Header() eventHeader {
+	return cce.header
+}
+
+func (cce *crimeCommitEvent) SetHeader(h eventHeader) {
+	cce.header = h
+}
+
+type crimeReportEvent struct {
+	header       eventHeader
+	CrimeID      uuid.UUID
+	ReporterID   uuid.UUID
+	ListenerID   uuid.UUID
+	ReporterName string
+	ListenerName string
+	CrimeType    string
+	OffenderName string
+}
+
+func (cre crimeReportEvent) ToDomain() core.Event {
+	e := core.NewCrimeReportEvent(
+		cre.CrimeID,
+		cre.ReporterID,
+		cre.ListenerID,
+		cre.header.AggregateId,
+		cre.ReporterName,
+		cre.ListenerName,
+		cre.CrimeType,
+		cre.OffenderName,
+	)
+	e.SetSequenceNumber(cre.header.SequenceNumber)
+	e.SetTimestamp(cre.header.Timestamp)
+	return e
+}
+
+func (cre *crimeReportEvent) FromDomain(e core.Event) {
+	from := e.(*core.CrimeReportEvent)
+	*cre = crimeReportEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		CrimeID:      from.CrimeID,
+		ReporterID:   from.ReporterID,
+		ListenerID:   from.ListenerID,
+		ReporterName: from.ReporterName,
+		ListenerName: from.ListenerName,
+		CrimeType:    from.CrimeType,
+		OffenderName: from.OffenderName,
+	}
+}
+
+func (cre crimeReportEvent) Header() eventHeader {
+	return cre.header
+}
+
+func (cre *crimeReportEvent) SetHeader(h eventHeader) {
+	cre.header = h
+}
+
+type crimeResolveEvent struct {
+	header       eventHeader
+	CrimeID      uuid.UUID
+	OffenderID   uuid.UUID
+	EnforcerID   uuid.UUID
+	OffenderName string
+	EnforcerName string
+	CrimeType    string
+	Resolution   string
+	Fine         int
+}
+
+func (cre crimeResolveEvent) ToDomain() core.Event {
+	e := core.NewCrimeResolveEvent(
+		cre.CrimeID,
+		cre.OffenderID,
+		cre.EnforcerID,
+		cre.header.AggregateId,
+		cre.OffenderName,
+		cre.EnforcerName,
+		cre.CrimeType,
+		cre.Resolution,
+		cre.Fine,
+	)
+	e.SetSequenceNumber(cre.header.SequenceNumber)
+	e.SetTimestamp(cre.header.Timestamp)
+	return e
+}
+
+func (cre *crimeResolveEvent) FromDomain(e core.Event) {
+	from := e.(*core.CrimeResolveEvent)
+	*cre = crimeResolveEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		CrimeID:      from.CrimeID,
+		OffenderID:   from.OffenderID,
+		EnforcerID:   from.EnforcerID,
+		OffenderName: from.OffenderName,
+		EnforcerName: from.EnforcerName,
+		CrimeType:    from.CrimeType,
+		Resolution:   from.Resolution,
+		Fine:         from.Fine,
+	}
+}
+
+func (cre crimeResolveEvent) Header() eventHeader {
+	return cre.header
+}
+
+func (cre *crimeResolveEvent) SetHeader(h eventHeader) {
+	cre.header = h
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestCrimeEvents_roundtrip(t *testing.T) {
+	testCases := map[string]core.Event{
+		"ZoneSetLawEvent": core.NewZoneSetLawEvent(
+			core.ZoneLaw{
+				Lawful:            true,
+				JailLocationID:    myuuid.NewId(),
+				ProtectedFactions: []string{"merchants"},
+				EnforcerFactions:  []string{"guards"},
+			},
+			myuuid.NewId(),
+		),
+		"CrimeCommitEvent": core.NewCrimeCommitEvent(
+			core.Crime{
+				ID:           myuuid.NewId(),
+				Type:         core.CrimeTypeTheft,
+				Severity:     2,
+				OffenderID:   myuuid.NewId(),
+				OffenderName: "bob",
+				VictimID:     myuuid.NewId(),
+				VictimName:   "a merchant",
+				ObjectID:     myuuid.NewId(),
+				LocationID:   myuuid.NewId(),
+				CommittedAt:  testTimestamp,
+				KnownBy:      []uuid.UUID{myuuid.NewId(), myuuid.NewId()},
+				Resolution:   core.CrimeResolutionFined,
+				ResolvedAt:   testTimestamp,
+				ResolvedByID: myuuid.NewId(),
+				Fine:         15,
+			},
+			myuuid.NewId(),
+		),
+		"CrimeReportEvent": core.NewCrimeReportEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"a merchant",
+			"a guard",
+			core.CrimeTypeTheft,
+			"bob",
+		),
+		"CrimeResolveEvent": core.NewCrimeResolveEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			"a guard",
+			core.CrimeTypeTheft,
+			core.CrimeResolutionFined,
+			15,
+		),
+	}
+
+	for name, e := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e.SetSequenceNumber(97)
+			e.SetTimestamp(testTimestamp)
+			assertRoundtrip(t, e)
+		})
+	}
+}
@@ -39,6 +39,12 @@ func writeEvent(e core.Event, outStream io.Writer, useCompression bool) error {
 		frommer = &actorMigrateOutEvent{}
 	case core.EventTypeActorSpeak:
 		frommer = &actorSpeakEvent{}
+	case core.EventTypeActorBecomeGhost:
+		frommer = &actorBecomeGhostEvent{}
+	case core.EventTypeActorRespawn:
+		frommer = &actorRespawnEvent{}
+	case core.EventTypeActorSetBindLocation:
+		frommer = &actorSetBindLocationEvent{}
 	case core.EventTypeLocationAddToZone:
 		frommer = &locationAddToZoneEvent{}
 	case core.EventTypeLocationRemoveFromZone:
@@ -69,6 +75,88 @@ func writeEvent(e core.Event, outStream io.Writer, useCompression bool) error {
 		frommer = &zoneSetDefaultLocationEvent{}
 	case core.EventTypeCombatMeleeDamage:
 		frommer = &combatMeleeDamageEvent{}
+	case core.EventTypeSorceryInvoke:
+		frommer = &sorceryInvokeEvent{}
+	case core.EventTypeActorLearnReaction:
+		frommer = &actorLearnReactionEvent{}
+	case core.EventTypeActorLearnTechnique:
+		frommer = &actorLearnTechniqueEvent{}
+	case core.EventTypeActorScribe:
+		frommer = &actorScribeEvent{}
+	case core.EventTypeActorExert:
+		frommer = &actorExertEvent{}
+	case core.EventTypeExitSetDoor:
+		frommer = &exitSetDoorEvent{}
+	case core.EventTypeExitDoor:
+		frommer = &exitDoorEvent{}
+	case core.EventTypeObjectContainerAction:
+		frommer = &objectContainerActionEvent{}
+	case core.EventTypeObjectCoins:
+		frommer = &objectCoinsEvent{}
+	case core.EventTypeShopTrade:
+		frommer = &shopTradeEvent{}
+	case core.EventTypeActorSetShop:
+		frommer = &actorSetShopEvent{}
+	case core.EventTypeActorSetHireTerms:
+		frommer = &actorSetHireTermsEvent{}
+	case core.EventTypeActorHire:
+		frommer = &actorHireEvent{}
+	case core.EventTypeActorRelease:
+		frommer = &actorReleaseEvent{}
+	case core.EventTypeActorRelationship:
+		frommer = &actorRelationshipEvent{}
+	case core.EventTypeActorOrder:
+		frommer = &actorOrderEvent{}
+	case core.EventTypeActorJoinFaction:
+		frommer = &actorJoinFactionEvent{}
+	case core.EventTypeActorLeaveFaction:
+		frommer = &actorLeaveFactionEvent{}
+	case core.EventTypeActorReputation:
+		frommer = &actorReputationEvent{}
+	case core.EventTypeZoneSetLaw:
+		frommer = &zoneSetLawEvent{}
+	case core.EventTypeCrimeCommit:
+		frommer = &crimeCommitEvent{}
+	case core.EventTypeCrimeReport:
+		frommer = &crimeReportEvent{}
+	case core.EventTypeCrimeResolve:
+		frommer = &crimeResolveEvent{}
+	case core.EventTypeObjectOwnership:
+		frommer = &objectOwnershipEvent{}
+	case core.EventTypeActorQuestAccept:
+		frommer = &actorQuestAcceptEvent{}
+	case core.EventTypeActorQuestProgress:
+		frommer = &actorQuestProgressEvent{}
+	case core.EventTypeActorQuestComplete:
+		frommer = &actorQuestCompleteEvent{}
+	case core.EventTypeActorQuestAbandon:
+		frommer = &actorQuestAbandonEvent{}
+	case core.EventTypeActorConsume:
+		frommer = &actorConsumeEvent{}
+	case core.EventTypeActorEffect:
+		frommer = &actorEffectEvent{}
+	case core.EventTypeActorEffectStart:
+		frommer = &actorEffectStartEvent{}
+	case core.EventTypeActorEffectEnd:
+		frommer = &actorEffectEndEvent{}
+	case core.EventTypeActorCraft:
+		frommer = &actorCraftEvent{}
+	case core.EventTypeObjectPrototypeUpdate:
+		frommer = &objectPrototypeUpdateEvent{}
+	case core.EventTypeDeityAdd:
+		frommer = &deityAddEvent{}
+	case core.EventTypeDeitySacrifice:
+		frommer = &deitySacrificeEvent{}
+	case core.EventTypeDeityPrayerAnswered:
+		frommer = &deityPrayerAnsweredEvent{}
+	case core.EventTypeDeityReversal:
+		frommer = &deityReversalEvent{}
+	case core.EventTypeActorDedicate:
+		frommer = &actorDedicateEvent{}
+	case core.EventTypeActorSacrifice:
+		frommer = &actorSacrificeEvent{}
+	case core.EventTypeActorPray:
+		frommer = &actorPrayEvent{}
 	default:
 		return fmt.Errorf("unhandled event type %T", e)
 	}
@@ -156,6 +244,12 @@ func readEvent(inStream io.Reader) (core.Event, error) {
 		toEr = &actorMigrateOutEvent{}
 	case core.EventTypeActorSpeak:
 		toEr = &actorSpeakEvent{}
+	case core.EventTypeActorBecomeGhost:
+		toEr = &actorBecomeGhostEvent{}
+	case core.EventTypeActorRespawn:
+		toEr = &actorRespawnEvent{}
+	case core.EventTypeActorSetBindLocation:
+		toEr = &actorSetBindLocationEvent{}
 	case core.EventTypeLocationAddToZone:
 		toEr = &locationAddToZoneEvent{}
 	case core.EventTypeLocationRemoveFromZone:
@@ -186,6 +280,88 @@ func readEvent(inStream io.Reader) (core.Event, error) {
 		toEr = &zoneSetDefaultLocationEvent{}
 	case core.EventTypeCombatMeleeDamage:
 		toEr = &combatMeleeDamageEvent{}
+	case core.EventTypeSorceryInvoke:
+		toEr = &sorceryInvokeEvent{}
+	case core.EventTypeActorLearnReaction:
+		toEr = &actorLearnReactionEvent{}
+	case core.EventTypeActorLearnTechnique:
+		toEr = &actorLearnTechniqueEvent{}
+	case core.EventTypeActorScribe:
+		toEr = &actorScribeEvent{}
+	case core.EventTypeActorExert:
+		toEr = &actorExertEvent{}
+	case core.EventTypeExitSetDoor:
+		toEr = &exitSetDoorEvent{}
+	case core.EventTypeExitDoor:
+		toEr = &exitDoorEvent{}
+	case core.EventTypeObjectContainerAction:
+		toEr = &objectContainerActionEvent{}
+	case core.EventTypeObjectCoins:
+		toEr = &objectCoinsEvent{}
+	case core.EventTypeShopTrade:
+		toEr = &shopTradeEvent{}
+	case core.EventTypeActorSetShop:
+		toEr = &actorSetShopEvent{}
+	case core.EventTypeActorSetHireTerms:
+		toEr = &actorSetHireTermsEvent{}
+	case core.EventTypeActorHire:
+		toEr = &actorHireEvent{}
+	case core.EventTypeActorRelease:
+		toEr = &actorReleaseEvent{}
+	case core.EventTypeActorRelationship:
+		toEr = &actorRelationshipEvent{}
+	case core.EventTypeActorOrder:
+		toEr = &actorOrderEvent{}
+	case core.EventTypeActorJoinFaction:
+		toEr = &actorJoinFactionEvent{}
+	case core.EventTypeActorLeaveFaction:
+		toEr = &actorLeaveFactionEvent{}
+	case core.EventTypeActorReputation:
+		toEr = &actorReputationEvent{}
+	case core.EventTypeZoneSetLaw:
+		toEr = &zoneSetLawEvent{}
+	case core.EventTypeCrimeCommit:
+		toEr = &crimeCommitEvent{}
+	case core.EventTypeCrimeReport:
+		toEr = &crimeReportEvent{}
+	case core.EventTypeCrimeResolve:
+		toEr = &crimeResolveEvent{}
+	case core.EventTypeObjectOwnership:
+		toEr = &objectOwnershipEvent{}
+	case core.EventTypeActorQuestAccept:
+		toEr = &actorQuestAcceptEvent{}
+	case core.EventTypeActorQuestProgress:
+		toEr = &actorQuestProgressEvent{}
+	case core.EventTypeActorQuestComplete:
+		toEr = &actorQuestCompleteEvent{}
+	case core.EventTypeActorQuestAbandon:
+		toEr = &actorQuestAbandonEvent{}
+	case core.EventTypeActorConsume:
+		toEr = &actorConsumeEvent{}
+	case core.EventTypeActorEffect:
+		toEr = &actorEffectEvent{}
+	case core.EventTypeActorEffectStart:
+		toEr = &actorEffectStartEvent{}
+	case core.EventTypeActorEffectEnd:
+		toEr = &actorEffectEndEvent{}
+	case core.EventTypeActorCraft:
+		toEr = &actorCraftEvent{}
+	case core.EventTypeObjectPrototypeUpdate:
+		toEr = &objectPrototypeUpdateEvent{}
+	case core.EventTypeDeityAdd:
+		toEr = &deityAddEvent{}
+	case core.EventTypeDeitySacrifice:
+		toEr = &deitySacrificeEvent{}
+	case core.EventTypeDeityPrayerAnswered:
+		toEr = &deityPrayerAnsweredEvent{}
+	case core.EventTypeDeityReversal:
+		toEr = &deityReversalEvent{}
+	case core.EventTypeActorDedicate:
+		toEr = &actorDedicateEvent{}
+	case core.EventTypeActorSacrifice:
+		toEr = &actorSacrificeEvent{}
+	case core.EventTypeActorPray:
+		toEr = &actorPrayEvent{}
 	}
 	err = json.Unmarshal(buf, toEr)
 	if err != nil {
@@ -0,0 +1,34 @@
+package store
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sayotte/gomud2/core"
+)
+
+// testTimestamp is used for every time in round-trip fixtures; it's in UTC,
+// and has no monotonic clock reading, so it survives serialization intact.
+var testTimestamp = time.Date(2019, time.June, 1, 12, 30, 0, 0, time.UTC)
+
+// assertRoundtrip checks that the Event comes back unchanged from being
+// written to, and read from, an Event store, with and without compression.
+func assertRoundtrip(t *testing.T, in core.Event) {
+	t.Helper()
+	for _, useCompression := range []bool{false, true} {
+		buf := &bytes.Buffer{}
+		err := writeEvent(in, buf, useCompression)
+		if err != nil {
+			t.Fatalf("unexpected error writing %T: %s", in, err)
+		}
+		out, err := readEvent(buf)
+		if err != nil {
+			t.Fatalf("unexpected error reading %T: %s", in, err)
+		}
+		if !reflect.DeepEqual(in, out) {
+			t.Errorf("%T changed by round-trip (compression: %t):\n%+v\n!=\n%+v", in, useCompression, in, out)
+		}
+	}
+}
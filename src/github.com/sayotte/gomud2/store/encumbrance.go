@@ -0,0 +1,43 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type actorExertEvent struct {
+	header      eventHeader
+	ActorID     uuid.UUID
+	ActorName   string
+	StaminaCost int
+}
+
+func (aee *actorExertEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorExertEvent)
+	*aee = actorExertEvent{
+		header:      eventHeaderFromDomainEvent(from),
+		ActorID:     from.ActorID,
+		ActorName:   from.ActorName,
+		StaminaCost: from.StaminaCost,
+	}
+}
+
+func (aee actorExertEvent) ToDomain() core.Event {
+	e := core.NewActorExertEvent(
+		aee.ActorID,
+		aee.header.AggregateId,
+		aee.ActorName,
+		aee.StaminaCost,
+	)
+	e.SetSequenceNumber(aee.header.SequenceNumber)
+	e.SetTimestamp(aee.header.Timestamp)
+	return e
+}
+
+func (aee actorExertEvent) Header() eventHeader {
+	return aee.header
+}
+
+func (aee *actorExertEvent) SetHeader(h eventHeader) {
+	aee.header = h
+}
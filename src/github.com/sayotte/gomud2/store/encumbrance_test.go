@@ -0,0 +1,16 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestActorExertEvent_roundtrip(t *testing.T) {
+	e := core.NewActorExertEvent(myuuid.NewId(), myuuid.NewId(), "bob", 3)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
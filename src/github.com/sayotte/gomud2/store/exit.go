@@ -9,6 +9,9 @@ type exitAddToZoneEvent struct {
 	header                                               eventHeader
 	Description, Direction                               string
 	ExitID, SourceLocationID, DestLocationID, DestZoneID uuid.UUID
+	HasDoor, Closed, Locked                              bool
+	KeyID                                                uuid.UUID
+	KeyKeyword                                           string
 }
 
 func (eatze exitAddToZoneEvent) ToDomain() core.Event {
@@ -21,6 +24,11 @@ func (eatze exitAddToZoneEvent) ToDomain() core.Event {
 		eatze.header.AggregateId,
 		eatze.DestZoneID,
 	)
+	e.HasDoor = eatze.HasDoor
+	e.Closed = eatze.Closed
+	e.Locked = eatze.Locked
+	e.KeyID = eatze.KeyID
+	e.KeyKeyword = eatze.KeyKeyword
 	e.SetSequenceNumber(eatze.header.SequenceNumber)
 	e.SetTimestamp(eatze.header.Timestamp)
 	return e
@@ -36,6 +44,11 @@ func (eatze *exitAddToZoneEvent) FromDomain(e core.Event) {
 		SourceLocationID: from.SourceLocationId,
 		DestLocationID:   from.DestLocationId,
 		DestZoneID:       from.DestZoneID,
+		HasDoor:          from.HasDoor,
+		Closed:           from.Closed,
+		Locked:           from.Locked,
+		KeyID:            from.KeyID,
+		KeyKeyword:       from.KeyKeyword,
 	}
 }
 
@@ -116,3 +129,88 @@ func (erfz exitRemoveFromZoneEvent) Header() eventHeader {
 func (erfz *exitRemoveFromZoneEvent) SetHeader(h eventHeader) {
 	erfz.header = h
 }
+
+type exitSetDoorEvent struct {
+	header                  eventHeader
+	ExitID                  uuid.UUID
+	HasDoor, Closed, Locked bool
+	KeyID                   uuid.UUID
+	KeyKeyword              string
+}
+
+func (esde exitSetDoorEvent) ToDomain() core.Event {
+	e := core.NewExitSetDoorEvent(
+		esde.ExitID,
+		esde.header.AggregateId,
+		esde.HasDoor,
+		esde.Closed,
+		esde.Locked,
+		esde.KeyID,
+		esde.KeyKeyword,
+	)
+	e.SetSequenceNumber(esde.header.SequenceNumber)
+	e.SetTimestamp(esde.header.Timestamp)
+	return e
+}
+
+func (esde *exitSetDoorEvent) FromDomain(e core.Event) {
+	from := e.(*core.ExitSetDoorEvent)
+	*esde = exitSetDoorEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		ExitID:     from.ExitID,
+		HasDoor:    from.HasDoor,
+		Closed:     from.Closed,
+		Locked:     from.Locked,
+		KeyID:      from.KeyID,
+		KeyKeyword: from.KeyKeyword,
+	}
+}
+
+func (esde exitSetDoorEvent) Header() eventHeader {
+	return esde.header
+}
+
+func (esde *exitSetDoorEvent) SetHeader(h eventHeader) {
+	esde.header = h
+}
+
+type exitDoorEvent struct {
+	header            eventHeader
+	Action, Direction string
+	ExitID, ActorID   uuid.UUID
+	ActorName         string
+}
+
+func (ede exitDoorEvent) ToDomain() core.Event {
+	e := core.NewExitDoorEvent(
+		ede.Action,
+		ede.Direction,
+		ede.ExitID,
+		ede.ActorID,
+		ede.header.AggregateId,
+		ede.ActorName,
+	)
+	e.SetSequenceNumber(ede.header.SequenceNumber)
+	e.SetTimestamp(ede.header.Timestamp)
+	return e
+}
+
+func (ede *exitDoorEvent) FromDomain(e core.Event) {
+	from := e.(*core.ExitDoorEvent)
+	*ede = exitDoorEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		Action:    from.Action,
+		Direction: from.Direction,
+		ExitID:    from.ExitID,
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+	}
+}
+
+func (ede exitDoorEvent) Header() eventHeader {
+	return ede.header
+}
+
+func (ede *exitDoorEvent) SetHeader(h eventHeader) {
+	ede.header = h
+}
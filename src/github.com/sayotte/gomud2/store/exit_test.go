@@ -9,7 +9,7 @@ import (
 )
 
 func TestExitAddToZoneEvent_roundtrip(t *testing.T) {
-	cmpDomainLeatze := func(left, right core.ExitAddToZoneEvent) (bool, string) {
+	cmpDomainLeatze := func(left, right *core.ExitAddToZoneEvent) (bool, string) {
 		if left.Type() != right.Type() {
 			return false, fmt.Sprintf("Type(): %d != %d", left.Type(), right.Type())
 		}
@@ -44,6 +44,21 @@ func TestExitAddToZoneEvent_roundtrip(t *testing.T) {
 		if !uuid.Equal(left.DestLocationId, right.DestLocationId) {
 			return false, fmt.Sprintf("DestLocationID: %q != %q", left.DestLocationId, right.DestLocationId)
 		}
+		if left.HasDoor != right.HasDoor {
+			return false, fmt.Sprintf("HasDoor: %t != %t", left.HasDoor, right.HasDoor)
+		}
+		if left.Closed != right.Closed {
+			return false, fmt.Sprintf("Closed: %t != %t", left.Closed, right.Closed)
+		}
+		if left.Locked != right.Locked {
+			return false, fmt.Sprintf("Locked: %t != %t", left.Locked, right.Locked)
+		}
+		if !uuid.Equal(left.KeyID, right.KeyID) {
+			return false, fmt.Sprintf("KeyID: %q != %q", left.KeyID, right.KeyID)
+		}
+		if left.KeyKeyword != right.KeyKeyword {
+			return false, fmt.Sprintf("KeyKeyword: %q != %q", left.KeyKeyword, right.KeyKeyword)
+		}
 
 		return true, ""
 	}
@@ -57,14 +72,95 @@ func TestExitAddToZoneEvent_roundtrip(t *testing.T) {
 		myuuid.NewId(),
 		myuuid.NewId(),
 	)
+	inEvent.HasDoor = true
+	inEvent.Closed = true
+	inEvent.Locked = true
+	inEvent.KeyID = myuuid.NewId()
+	inEvent.KeyKeyword = "brass"
 	inEvent.SetSequenceNumber(97)
 
 	leatze := &exitAddToZoneEvent{}
 	leatze.FromDomain(inEvent)
 	outEvent := leatze.ToDomain()
 
-	same, why := cmpDomainLeatze(inEvent, outEvent.(core.ExitAddToZoneEvent))
+	same, why := cmpDomainLeatze(inEvent, outEvent.(*core.ExitAddToZoneEvent))
 	if !same {
 		t.Error(why)
 	}
 }
+
+func TestExitSetDoorEvent_roundtrip(t *testing.T) {
+	cmpDomainLeatze := func(left, right *core.ExitSetDoorEvent) (bool, string) {
+		if left.Type() != right.Type() {
+			return false, fmt.Sprintf("Type(): %d != %d", left.Type(), right.Type())
+		}
+		if left.Version() != right.Version() {
+			return false, fmt.Sprintf("Version(): %d != %d", left.Version(), right.Version())
+		}
+		if !uuid.Equal(left.AggregateId(), right.AggregateId()) {
+			return false, fmt.Sprintf("aggregateID: %q != %q", left.AggregateId(), right.AggregateId())
+		}
+		if left.SequenceNumber() != right.SequenceNumber() {
+			return false, fmt.Sprintf("SequenceNumber(): %d != %d", left.SequenceNumber(), right.SequenceNumber())
+		}
+		if left.ShouldPersist() != right.ShouldPersist() {
+			return false, fmt.Sprintf("ShouldPersist(): %t != %t", left.ShouldPersist(), right.ShouldPersist())
+		}
+
+		if !uuid.Equal(left.ExitID, right.ExitID) {
+			return false, fmt.Sprintf("ExitID: %q != %q", left.ExitID, right.ExitID)
+		}
+		if left.HasDoor != right.HasDoor {
+			return false, fmt.Sprintf("HasDoor: %t != %t", left.HasDoor, right.HasDoor)
+		}
+		if left.Closed != right.Closed {
+			return false, fmt.Sprintf("Closed: %t != %t", left.Closed, right.Closed)
+		}
+		if left.Locked != right.Locked {
+			return false, fmt.Sprintf("Locked: %t != %t", left.Locked, right.Locked)
+		}
+		if !uuid.Equal(left.KeyID, right.KeyID) {
+			return false, fmt.Sprintf("KeyID: %q != %q", left.KeyID, right.KeyID)
+		}
+		if left.KeyKeyword != right.KeyKeyword {
+			return false, fmt.Sprintf("KeyKeyword: %q != %q", left.KeyKeyword, right.KeyKeyword)
+		}
+
+		return true, ""
+	}
+
+	inEvent := core.NewExitSetDoorEvent(
+		myuuid.NewId(),
+		myuuid.NewId(),
+		true,
+		true,
+		true,
+		myuuid.NewId(),
+		"brass",
+	)
+	inEvent.SetSequenceNumber(97)
+
+	leatze := &exitSetDoorEvent{}
+	leatze.FromDomain(inEvent)
+	outEvent := leatze.ToDomain()
+
+	same, why := cmpDomainLeatze(inEvent, outEvent.(*core.ExitSetDoorEvent))
+	if !same {
+		t.Error(why)
+	}
+}
+
+func TestExitDoorEvent_roundtrip(t *testing.T) {
+	e := core.NewExitDoorEvent(
+		core.ExitDoorActionLock,
+		"north",
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+		"bob",
+	)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type actorJoinFactionEvent struct {
+	header    eventHeader
+	ActorID   uuid.UUID
+	ActorName string
+	Faction   string
+}
+
+func (ajfe actorJoinFactionEvent) ToDomain() core.Event {
+	e := core.NewActorJoinFactionEvent(ajfe.ActorID, ajfe.header.AggregateId, ajfe.ActorName, ajfe.Faction)
+	e.SetSequenceNumber(ajfe.header.SequenceNumber)
+	e.SetTimestamp(ajfe.header.Timestamp)
+	return e
+}
+
+func (ajfe *actorJoinFactionEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorJoinFactionEvent)
+	*ajfe = actorJoinFactionEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		Faction:   from.Faction,
+	}
+}
+
+func (ajfe actorJoinFactionEvent) Header() eventHeader {
+	return ajfe.header
+}
+
+func (ajfe *actorJoinFactionEvent) SetHeader(h eventHeader) {
+	ajfe.header = h
+}
+
+type actorLeaveFactionEvent struct {
+	header    eventHeader
+	ActorID   uuid.UUID
+	ActorName string
+	Faction   string
+}
+
+func (alfe actorLeaveFactionEvent) ToDomain() core.Event {
+	e := core.NewActorLeaveFactionEvent(alfe.ActorID, alfe.header.AggregateId, alfe.ActorName, alfe.Faction)
+	e.SetSequenceNumber(alfe.header.SequenceNumber)
+	e.SetTimestamp(alfe.header.Timestamp)
+	return e
+}
+
+func (alfe *actorLeaveFactionEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorLeaveFactionEvent)
+	*alfe = actorLeaveFactionEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		Faction:   from.Faction,
+	}
+}
+
+func (alfe actorLeaveFactionEvent) Header() eventHeader {
+	return alfe.header
+}
+
+func (alfe *actorLeaveFactionEvent) SetHeader(h eventHeader) {
+	alfe.header = h
+}
+
+type actorReputationEvent struct {
+	header     eventHeader
+	ActorID    uuid.UUID
+	ActorName  string
+	Faction    string
+	Reason     string
+	Delta      int
+	Reputation int
+	Standing   string
+}
+
+func (are actorReputationEvent) ToDomain() core.Event {
+	e := core.NewActorReputationEvent(
+		are.ActorID,
+		are.header.AggregateId,
+		are.ActorName,
+		are.Faction,
+		are.Reason,
+		are.Delta,
+		are.Reputation,
+		are.Standing,
+	)
+	e.SetSequenceNumber(are.header.SequenceNumber)
+	e.SetTimestamp(are.header.Timestamp)
+	return e
+}
+
+func (are *actorReputationEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorReputationEvent)
+	*are = actorReputationEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		ActorID:    from.ActorID,
+		ActorName:  from.ActorName,
+		Faction:    from.Faction,
+		Reason:     from.Reason,
+		Delta:      from.Delta,
+		Reputation: from.Reputation,
+		Standing:   from.Standing,
+	}
+}
+
+func (are actorReputationEvent) Header() eventHeader {
+	return are.header
+}
+
+func (are *actorReputationEvent) SetHeader(h eventHeader) {
+	are.header = h
+}
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestFactionEvents_roundtrip(t *testing.T) {
+	testCases := map[string]core.Event{
+		"ActorJoinFactionEvent":  core.NewActorJoinFactionEvent(myuuid.NewId(), myuuid.NewId(), "bob", "guards"),
+		"ActorLeaveFactionEvent": core.NewActorLeaveFactionEvent(myuuid.NewId(), myuuid.NewId(), "bob", "guards"),
+		"ActorReputationEvent": core.NewActorReputationEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			"guards",
+			"killed a member",
+			-25,
+			-40,
+			"hostile",
+		),
+	}
+
+	for name, e := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e.SetSequenceNumber(97)
+			e.SetTimestamp(testTimestamp)
+			assertRoundtrip(t, e)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package store
+
+import (
+	"time"
+
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type actorSetHireTermsEvent struct {
+	header    eventHeader
+	ActorID   uuid.UUID
+	HireTerms *core.HireTerms
+}
+
+func (ashte actorSetHireTermsEvent) ToDomain() core.Event {
+	e := core.NewActorSetHireTermsEvent(ashte.ActorID, ashte.header.AggregateId, ashte.HireTerms)
+	e.SetSequenceNumber(ashte.header.SequenceNumber)
+	e.SetTimestamp(ashte.header.Timestamp)
+	return e
+}
+
+func (ashte *actorSetHireTermsEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorSetHireTermsEvent)
+	*ashte = actorSetHireTermsEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		HireTerms: from.HireTerms,
+	}
+}
+
+func (ashte actorSetHireTermsEvent) Header() eventHeader {
+	return ashte.header
+}
+
+func (ashte *actorSetHireTermsEvent) SetHeader(h eventHeader) {
+	ashte.header = h
+}
+
+type actorHireEvent struct {
+	header                  eventHeader
+	ActorID, EmployerID     uuid.UUID
+	ActorName, EmployerName string
+	Wage                    int
+	HiredUntil              time.Time
+}
+
+func (ahe actorHireEvent) ToDomain() core.Event {
+	e := core.NewActorHireEvent(
+		ahe.ActorID,
+		ahe.EmployerID,
+		ahe.header.AggregateId,
+		ahe.ActorName,
+		ahe.EmployerName,
+		ahe.Wage,
+		ahe.HiredUntil,
+	)
+	e.SetSequenceNumber(ahe.header.SequenceNumber)
+	e.SetTimestamp(ahe.header.Timestamp)
+	return e
+}
+
+func (ahe *actorHireEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorHireEvent)
+	*ahe = actorHireEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		ActorID:      from.ActorID,
+		EmployerID:   from.EmployerID,
+		ActorName:    from.ActorName,
+		EmployerName: from.EmployerName,
+		Wage:         from.Wage,
+		HiredUntil:   from.HiredUntil,
+	}
+}
+
+func (ahe actorHireEvent) Header() eventHeader {
+	return ahe.header
+}
+
+func (ahe *actorHireEvent) SetHeader(h eventHeader) {
+	ahe.header = h
+}
+
+type actorReleaseEvent struct {
+	header                  eventHeader
+	Reason                  string
+	ActorID, EmployerID     uuid.UUID
+	ActorName, EmployerName string
+}
+
+func (are actorReleaseEvent) ToDomain() core.Event {
+	e := core.NewActorReleaseEvent(
+		are.Reason,
+		are.ActorID,
+		are.EmployerID,
+		are.header.AggregateId,
+		are.ActorName,
+		are.EmployerName,
+	)
+	e.SetSequenceNumber(are.header.SequenceNumber)
+	e.SetTimestamp(are.header.Timestamp)
+	return e
+}
+
+func (are *actorReleaseEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorReleaseEvent)
+	*are = actorReleaseEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		Reason:       from.Reason,
+		ActorID:      from.ActorID,
+		EmployerID:   from.EmployerID,
+		ActorName:    from.ActorName,
+		EmployerName: from.EmployerName,
+	}
+}
+
+func (are actorReleaseEvent) Header() eventHeader {
+	return are.header
+}
+
+func (are *actorReleaseEvent) SetHeader(h eventHeader) {
+	are.header = h
+}
+
+type actorRelationshipEvent struct {
+	header           eventHeader
+	Relationship     string
+	ActorID, OtherID uuid.UUID
+	OtherName        string
+}
+
+func (are actorRelationshipEvent) ToDomain() core.Event {
+	e := core.NewActorRelationshipEvent(
+		are.Relationship,
+		are.ActorID,
+		are.OtherID,
+		are.header.AggregateId,
+		are.OtherName,
+	)
+	e.SetSequenceNumber(are.header.SequenceNumber)
+	e.SetTimestamp(are.header.Timestamp)
+	return e
+}
+
+func (are *actorRelationshipEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorRelationshipEvent)
+	*are = actorRelationshipEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		Relationship: from.Relationship,
+		ActorID:      from.ActorID,
+		OtherID:      from.OtherID,
+		OtherName:    from.OtherName,
+	}
+}
+
+func (are actorRelationshipEvent) Header() eventHeader {
+	return are.header
+}
+
+func (are *actorRelationshipEvent) SetHeader(h eventHeader) {
+	are.header = h
+}
+
+type actorOrderEvent struct {
+	header                  eventHeader
+	Order                   core.HirelingOrder
+	ActorID, EmployerID     uuid.UUID
+	ActorName, EmployerName string
+}
+
+func (aoe actorOrderEvent) ToDomain() core.Event {
+	e := core.NewActorOrderEvent(
+		aoe.Order,
+		aoe.ActorID,
+		aoe.EmployerID,
+		aoe.header.AggregateId,
+		aoe.ActorName,
+		aoe.EmployerName,
+	)
+	e.SetSequenceNumber(aoe.header.SequenceNumber)
+	e.SetTimestamp(aoe.header.Timestamp)
+	return e
+}
+
+func (aoe *actorOrderEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorOrderEvent)
+	*aoe = actorOrderEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		Order:        from.Order,
+		ActorID:      from.ActorID,
+		EmployerID:   from.EmployerID,
+		ActorName:    from.ActorName,
+		EmployerName: from.EmployerName,
+	}
+}
+
+func (aoe actorOrderEvent) Header() eventHeader {
+	return aoe.header
+}
+
+func (aoe *actorOrderEvent) SetHeader(h eventHeader) {
+	aoe.header = h
+}
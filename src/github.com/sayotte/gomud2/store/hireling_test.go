@@ -0,0 +1,65 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestHirelingEvents_roundtrip(t *testing.T) {
+	testCases := map[string]core.Event{
+		"ActorSetHireTermsEvent": core.NewActorSetHireTermsEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			&core.HireTerms{Wage: 10, Term: time.Hour},
+		),
+		"ActorSetHireTermsEvent, not for hire": core.NewActorSetHireTermsEvent(myuuid.NewId(), myuuid.NewId(), nil),
+		"ActorHireEvent": core.NewActorHireEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"a mercenary",
+			"bob",
+			10,
+			testTimestamp,
+		),
+		"ActorReleaseEvent": core.NewActorReleaseEvent(
+			core.HirelingReleaseReasonFired,
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"a mercenary",
+			"bob",
+		),
+		"ActorRelationshipEvent": core.NewActorRelationshipEvent(
+			core.HirelingRelationshipEnemy,
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"a rat",
+		),
+		"ActorOrderEvent": core.NewActorOrderEvent(
+			core.HirelingOrder{
+				Order:      core.HirelingOrderAttack,
+				TargetID:   myuuid.NewId(),
+				TargetName: "a rat",
+				LocationID: myuuid.NewId(),
+			},
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"a mercenary",
+			"bob",
+		),
+	}
+
+	for name, e := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e.SetSequenceNumber(97)
+			e.SetTimestamp(testTimestamp)
+			assertRoundtrip(t, e)
+		})
+	}
+}
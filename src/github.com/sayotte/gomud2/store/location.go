@@ -9,6 +9,7 @@ type locationAddToZoneEvent struct {
 	header          eventHeader
 	LocationID      uuid.UUID
 	ShortDesc, Desc string
+	Tags            []string
 }
 
 func (latze *locationAddToZoneEvent) FromDomain(e core.Event) {
@@ -18,6 +19,7 @@ func (latze *locationAddToZoneEvent) FromDomain(e core.Event) {
 		LocationID: from.LocationID,
 		ShortDesc:  from.ShortDesc,
 		Desc:       from.Desc,
+		Tags:       from.Tags,
 	}
 }
 
@@ -28,6 +30,7 @@ func (latze locationAddToZoneEvent) ToDomain() core.Event {
 		latze.LocationID,
 		latze.header.AggregateId,
 	)
+	e.Tags = latze.Tags
 	e.SetSequenceNumber(latze.header.SequenceNumber)
 	e.SetTimestamp(latze.header.Timestamp)
 	return e
@@ -73,6 +76,7 @@ type locationUpdateEvent struct {
 	header          eventHeader
 	LocationID      uuid.UUID
 	ShortDesc, Desc string
+	Tags            []string
 }
 
 func (lue *locationUpdateEvent) FromDomain(e core.Event) {
@@ -82,6 +86,7 @@ func (lue *locationUpdateEvent) FromDomain(e core.Event) {
 		LocationID: from.LocationID,
 		ShortDesc:  from.ShortDesc,
 		Desc:       from.Desc,
+		Tags:       from.Tags,
 	}
 }
 
@@ -92,6 +97,7 @@ func (lue locationUpdateEvent) ToDomain() core.Event {
 		lue.LocationID,
 		lue.header.AggregateId,
 	)
+	e.Tags = lue.Tags
 	e.SetSequenceNumber(lue.header.SequenceNumber)
 	e.SetTimestamp(lue.header.Timestamp)
 	return e
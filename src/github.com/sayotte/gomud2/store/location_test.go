@@ -0,0 +1,28 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestLocationEvents_roundtrip(t *testing.T) {
+	addEvent := core.NewLocationAddToZoneEvent("a lair", "a dank cave, reeking of wolf", myuuid.NewId(), myuuid.NewId())
+	addEvent.Tags = []string{"lair", "cave"}
+	updateEvent := core.NewLocationUpdateEvent("a lair", "a dank cave, reeking of wolf", myuuid.NewId(), myuuid.NewId())
+	updateEvent.Tags = []string{"lair"}
+
+	testCases := map[string]core.Event{
+		"LocationAddToZoneEvent": addEvent,
+		"LocationUpdateEvent":    updateEvent,
+	}
+
+	for name, e := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e.SetSequenceNumber(97)
+			e.SetTimestamp(testTimestamp)
+			assertRoundtrip(t, e)
+		})
+	}
+}
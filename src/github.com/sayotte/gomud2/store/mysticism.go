@@ -0,0 +1,297 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type deityAddEvent struct {
+	header            eventHeader
+	DeityID           uuid.UUID
+	Name, Description string
+	Strength          float64
+}
+
+func (dae *deityAddEvent) FromDomain(e core.Event) {
+	from := e.(*core.DeityAddEvent)
+	*dae = deityAddEvent{
+		header:      eventHeaderFromDomainEvent(from),
+		DeityID:     from.DeityID,
+		Name:        from.Name,
+		Description: from.Description,
+		Strength:    from.Strength,
+	}
+}
+
+func (dae deityAddEvent) ToDomain() core.Event {
+	e := core.NewDeityAddEvent(
+		dae.DeityID,
+		dae.header.AggregateId,
+		dae.Name,
+		dae.Description,
+		dae.Strength,
+	)
+	e.SetSequenceNumber(dae.header.SequenceNumber)
+	e.SetTimestamp(dae.header.Timestamp)
+	return e
+}
+
+func (dae deityAddEvent) Header() eventHeader {
+	return dae.header
+}
+
+func (dae *deityAddEvent) SetHeader(h eventHeader) {
+	dae.header = h
+}
+
+type deitySacrificeEvent struct {
+	header           eventHeader
+	DeityID, ActorID uuid.UUID
+	ActorName        string
+	Value            float64
+}
+
+func (dse *deitySacrificeEvent) FromDomain(e core.Event) {
+	from := e.(*core.DeitySacrificeEvent)
+	*dse = deitySacrificeEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		DeityID:   from.DeityID,
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		Value:     from.Value,
+	}
+}
+
+func (dse deitySacrificeEvent) ToDomain() core.Event {
+	e := core.NewDeitySacrificeEvent(
+		dse.DeityID,
+		dse.ActorID,
+		dse.header.AggregateId,
+		dse.ActorName,
+		dse.Value,
+	)
+	e.SetSequenceNumber(dse.header.SequenceNumber)
+	e.SetTimestamp(dse.header.Timestamp)
+	return e
+}
+
+func (dse deitySacrificeEvent) Header() eventHeader {
+	return dse.header
+}
+
+func (dse *deitySacrificeEvent) SetHeader(h eventHeader) {
+	dse.header = h
+}
+
+type deityPrayerAnsweredEvent struct {
+	header                eventHeader
+	DeityID, ActorID      uuid.UUID
+	ActorName, PrayerName string
+	StrengthCost          float64
+}
+
+func (dpae *deityPrayerAnsweredEvent) FromDomain(e core.Event) {
+	from := e.(*core.DeityPrayerAnsweredEvent)
+	*dpae = deityPrayerAnsweredEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		DeityID:      from.DeityID,
+		ActorID:      from.ActorID,
+		ActorName:    from.ActorName,
+		PrayerName:   from.PrayerName,
+		StrengthCost: from.StrengthCost,
+	}
+}
+
+func (dpae deityPrayerAnsweredEvent) ToDomain() core.Event {
+	e := core.NewDeityPrayerAnsweredEvent(
+		dpae.DeityID,
+		dpae.ActorID,
+		dpae.header.AggregateId,
+		dpae.ActorName,
+		dpae.PrayerName,
+		dpae.StrengthCost,
+	)
+	e.SetSequenceNumber(dpae.header.SequenceNumber)
+	e.SetTimestamp(dpae.header.Timestamp)
+	return e
+}
+
+func (dpae deityPrayerAnsweredEvent) Header() eventHeader {
+	return dpae.header
+}
+
+func (dpae *deityPrayerAnsweredEvent) SetHeader(h eventHeader) {
+	dpae.header = h
+}
+
+type deityReversalEvent struct {
+	header           eventHeader
+	DeityID, ActorID uuid.UUID
+	ActorName        string
+	StrengthDelta    float64
+}
+
+func (dre *deityReversalEvent) FromDomain(e core.Event) {
+	from := e.(*core.DeityReversalEvent)
+	*dre = deityReversalEvent{
+		header:        eventHeaderFromDomainEvent(from),
+		DeityID:       from.DeityID,
+		ActorID:       from.ActorID,
+		ActorName:     from.ActorName,
+		StrengthDelta: from.StrengthDelta,
+	}
+}
+
+func (dre deityReversalEvent) ToDomain() core.Event {
+	e := core.NewDeityReversalEvent(
+		dre.DeityID,
+		dre.ActorID,
+		dre.header.AggregateId,
+		dre.ActorName,
+		dre.StrengthDelta,
+	)
+	e.SetSequenceNumber(dre.header.SequenceNumber)
+	e.SetTimestamp(dre.header.Timestamp)
+	return e
+}
+
+func (dre deityReversalEvent) Header() eventHeader {
+	return dre.header
+}
+
+func (dre *deityReversalEvent) SetHeader(h eventHeader) {
+	dre.header = h
+}
+
+type actorDedicateEvent struct {
+	header               eventHeader
+	ActorID, DeityID     uuid.UUID
+	ActorName, DeityName string
+}
+
+func (ade *actorDedicateEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorDedicateEvent)
+	*ade = actorDedicateEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		DeityID:   from.DeityID,
+		ActorName: from.ActorName,
+		DeityName: from.DeityName,
+	}
+}
+
+func (ade actorDedicateEvent) ToDomain() core.Event {
+	e := core.NewActorDedicateEvent(
+		ade.ActorID,
+		ade.DeityID,
+		ade.header.AggregateId,
+		ade.ActorName,
+		ade.DeityName,
+	)
+	e.SetSequenceNumber(ade.header.SequenceNumber)
+	e.SetTimestamp(ade.header.Timestamp)
+	return e
+}
+
+func (ade actorDedicateEvent) Header() eventHeader {
+	return ade.header
+}
+
+func (ade *actorDedicateEvent) SetHeader(h eventHeader) {
+	ade.header = h
+}
+
+type actorSacrificeEvent struct {
+	header                     eventHeader
+	ActorID, ObjectID, DeityID uuid.UUID
+	ActorName, ObjectName      string
+	Value, Mysticism           float64
+}
+
+func (ase *actorSacrificeEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorSacrificeEvent)
+	*ase = actorSacrificeEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		ActorID:    from.ActorID,
+		ObjectID:   from.ObjectID,
+		DeityID:    from.DeityID,
+		ActorName:  from.ActorName,
+		ObjectName: from.ObjectName,
+		Value:      from.Value,
+		Mysticism:  from.Mysticism,
+	}
+}
+
+func (ase actorSacrificeEvent) ToDomain() core.Event {
+	e := core.NewActorSacrificeEvent(
+		ase.ActorID,
+		ase.ObjectID,
+		ase.DeityID,
+		ase.header.AggregateId,
+		ase.ActorName,
+		ase.ObjectName,
+		ase.Value,
+		ase.Mysticism,
+	)
+	e.SetSequenceNumber(ase.header.SequenceNumber)
+	e.SetTimestamp(ase.header.Timestamp)
+	return e
+}
+
+func (ase actorSacrificeEvent) Header() eventHeader {
+	return ase.header
+}
+
+func (ase *actorSacrificeEvent) SetHeader(h eventHeader) {
+	ase.header = h
+}
+
+type actorPrayEvent struct {
+	header                     eventHeader
+	PrayerName, Effect         string
+	ActorID, TargetID, DeityID uuid.UUID
+	ActorName, TargetName      string
+	ZealCost, Magnitude        int
+}
+
+func (ape *actorPrayEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorPrayEvent)
+	*ape = actorPrayEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		PrayerName: from.PrayerName,
+		Effect:     from.Effect,
+		ActorID:    from.ActorID,
+		TargetID:   from.TargetID,
+		DeityID:    from.DeityID,
+		ActorName:  from.ActorName,
+		TargetName: from.TargetName,
+		ZealCost:   from.ZealCost,
+		Magnitude:  from.Magnitude,
+	}
+}
+
+func (ape actorPrayEvent) ToDomain() core.Event {
+	e := core.NewActorPrayEvent(
+		ape.PrayerName,
+		ape.Effect,
+		ape.ActorID,
+		ape.TargetID,
+		ape.DeityID,
+		ape.header.AggregateId,
+		ape.ActorName,
+		ape.TargetName,
+		ape.ZealCost,
+		ape.Magnitude,
+	)
+	e.SetSequenceNumber(ape.header.SequenceNumber)
+	e.SetTimestamp(ape.header.Timestamp)
+	return e
+}
+
+func (ape actorPrayEvent) Header() eventHeader {
+	return ape.header
+}
+
+func (ape *actorPrayEvent) SetHeader(h eventHeader) {
+	ape.header = h
+}
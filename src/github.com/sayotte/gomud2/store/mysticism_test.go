@@ -0,0 +1,79 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestMysticismEvents_roundtrip(t *testing.T) {
+	testCases := map[string]core.Event{
+		"DeityAddEvent": core.NewDeityAddEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"Aurel",
+			"god of the sun",
+			50,
+		),
+		"DeitySacrificeEvent": core.NewDeitySacrificeEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			2.5,
+		),
+		"DeityPrayerAnsweredEvent": core.NewDeityPrayerAnsweredEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			"smite",
+			1.5,
+		),
+		"DeityReversalEvent": core.NewDeityReversalEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			-2.5,
+		),
+		"ActorDedicateEvent": core.NewActorDedicateEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			"Aurel",
+		),
+		"ActorSacrificeEvent": core.NewActorSacrificeEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			"a bone",
+			2.5,
+			12.5,
+		),
+		"ActorPrayEvent": core.NewActorPrayEvent(
+			"smite",
+			core.PrayerEffectDamage,
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			"a rat",
+			5,
+			8,
+		),
+	}
+
+	for name, e := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e.SetSequenceNumber(97)
+			e.SetTimestamp(testTimestamp)
+			assertRoundtrip(t, e)
+		})
+	}
+}
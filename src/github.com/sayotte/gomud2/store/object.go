@@ -1,6 +1,8 @@
 package store
 
 import (
+	"time"
+
 	"github.com/satori/go.uuid"
 	"github.com/sayotte/gomud2/core"
 )
@@ -14,6 +16,13 @@ type objectAddToZoneEvent struct {
 	Subcontainer                                             string
 	Capacity                                                 int
 	Attributes                                               core.ObjectAttributes
+	DecayAt                                                  time.Time
+	LootRightsActorIDs                                       []uuid.UUID
+	LootRightsUntil                                          time.Time
+	CorpseOfID                                               uuid.UUID
+	Closed, Locked                                           bool
+	Ownership                                                core.ObjectOwnership
+	PrototypeID                                              uuid.UUID
 }
 
 func (oatze *objectAddToZoneEvent) FromDomain(e core.Event) {
@@ -30,6 +39,14 @@ func (oatze *objectAddToZoneEvent) FromDomain(e core.Event) {
 		Subcontainer:        from.Subcontainer,
 		Capacity:            from.Capacity,
 		Attributes:          from.Attributes,
+		DecayAt:             from.DecayAt,
+		LootRightsActorIDs:  from.LootRightsActorIDs,
+		LootRightsUntil:     from.LootRightsUntil,
+		CorpseOfID:          from.CorpseOfID,
+		Closed:              from.Closed,
+		Locked:              from.Locked,
+		Ownership:           from.Ownership,
+		PrototypeID:         from.PrototypeID,
 	}
 }
 
@@ -47,6 +64,14 @@ func (oatze objectAddToZoneEvent) ToDomain() core.Event {
 		oatze.Subcontainer,
 		oatze.Attributes,
 	)
+	e.DecayAt = oatze.DecayAt
+	e.LootRightsActorIDs = oatze.LootRightsActorIDs
+	e.LootRightsUntil = oatze.LootRightsUntil
+	e.CorpseOfID = oatze.CorpseOfID
+	e.Closed = oatze.Closed
+	e.Locked = oatze.Locked
+	e.Ownership = oatze.Ownership
+	e.PrototypeID = oatze.PrototypeID
 	e.SetSequenceNumber(oatze.header.SequenceNumber)
 	e.SetTimestamp(oatze.header.Timestamp)
 	return e
@@ -230,6 +255,13 @@ type objectMigrateInEvent struct {
 	Subcontainer                                             string
 	Capacity                                                 int
 	Attributes                                               core.ObjectAttributes
+	DecayAt                                                  time.Time
+	LootRightsActorIDs                                       []uuid.UUID
+	LootRightsUntil                                          time.Time
+	CorpseOfID                                               uuid.UUID
+	Closed, Locked                                           bool
+	Ownership                                                core.ObjectOwnership
+	PrototypeID                                              uuid.UUID
 }
 
 func (omie *objectMigrateInEvent) FromDomain(e core.Event) {
@@ -247,6 +279,14 @@ func (omie *objectMigrateInEvent) FromDomain(e core.Event) {
 		Capacity:            from.Capacity,
 		Subcontainer:        from.Subcontainer,
 		Attributes:          from.Attributes,
+		DecayAt:             from.DecayAt,
+		LootRightsActorIDs:  from.LootRightsActorIDs,
+		LootRightsUntil:     from.LootRightsUntil,
+		CorpseOfID:          from.CorpseOfID,
+		Closed:              from.Closed,
+		Locked:              from.Locked,
+		Ownership:           from.Ownership,
+		PrototypeID:         from.PrototypeID,
 	}
 }
 
@@ -265,6 +305,14 @@ func (omie objectMigrateInEvent) ToDomain() core.Event {
 		omie.Subcontainer,
 		omie.Attributes,
 	)
+	e.DecayAt = omie.DecayAt
+	e.LootRightsActorIDs = omie.LootRightsActorIDs
+	e.LootRightsUntil = omie.LootRightsUntil
+	e.CorpseOfID = omie.CorpseOfID
+	e.Closed = omie.Closed
+	e.Locked = omie.Locked
+	e.Ownership = omie.Ownership
+	e.PrototypeID = omie.PrototypeID
 	e.SetSequenceNumber(omie.header.SequenceNumber)
 	e.SetTimestamp(omie.header.Timestamp)
 	return e
@@ -314,3 +362,89 @@ func (omoe objectMigrateOutEvent) Header() eventHeader {
 func (omoe *objectMigrateOutEvent) SetHeader(h eventHeader) {
 	omoe.header = h
 }
+
+type objectOwnershipEvent struct {
+	header     eventHeader
+	ObjectID   uuid.UUID
+	ObjectName string
+	Record     core.ProvenanceRecord
+}
+
+func (ooe *objectOwnershipEvent) FromDomain(e core.Event) {
+	from := e.(*core.ObjectOwnershipEvent)
+	*ooe = objectOwnershipEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		ObjectID:   from.ObjectID,
+		ObjectName: from.ObjectName,
+		Record:     from.Record,
+	}
+}
+
+func (ooe objectOwnershipEvent) ToDomain() core.Event {
+	e := core.NewObjectOwnershipEvent(
+		ooe.ObjectID,
+		ooe.header.AggregateId,
+		ooe.ObjectName,
+		ooe.Record,
+	)
+	e.SetSequenceNumber(ooe.header.SequenceNumber)
+	e.SetTimestamp(ooe.header.Timestamp)
+	return e
+}
+
+func (ooe objectOwnershipEvent) Header() eventHeader {
+	return ooe.header
+}
+
+func (ooe *objectOwnershipEvent) SetHeader(h eventHeader) {
+	ooe.header = h
+}
+
+type objectPrototypeUpdateEvent struct {
+	header                eventHeader
+	ObjectID, PrototypeID uuid.UUID
+	Name, Description     string
+	Keywords              []string
+	Capacity              int
+	Attributes            core.ObjectAttributes
+}
+
+func (opue *objectPrototypeUpdateEvent) FromDomain(e core.Event) {
+	from := e.(*core.ObjectPrototypeUpdateEvent)
+	*opue = objectPrototypeUpdateEvent{
+		header:      eventHeaderFromDomainEvent(from),
+		ObjectID:    from.ObjectID,
+		PrototypeID: from.PrototypeID,
+		Name:        from.Name,
+		Description: from.Description,
+		Keywords:    from.Keywords,
+		Capacity:    from.Capacity,
+		Attributes:  from.Attributes,
+	}
+}
+
+func (opue objectPrototypeUpdateEvent) ToDomain() core.Event {
+	e := core.NewObjectPrototypeUpdateEvent(
+		opue.ObjectID,
+		opue.header.AggregateId,
+		core.ObjectPrototype{
+			ID:          opue.PrototypeID,
+			Name:        opue.Name,
+			Description: opue.Description,
+			Keywords:    opue.Keywords,
+			Capacity:    opue.Capacity,
+			Attributes:  opue.Attributes,
+		},
+	)
+	e.SetSequenceNumber(opue.header.SequenceNumber)
+	e.SetTimestamp(opue.header.Timestamp)
+	return e
+}
+
+func (opue objectPrototypeUpdateEvent) Header() eventHeader {
+	return opue.header
+}
+
+func (opue *objectPrototypeUpdateEvent) SetHeader(h eventHeader) {
+	opue.header = h
+}
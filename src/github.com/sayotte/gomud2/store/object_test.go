@@ -0,0 +1,137 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func testOwnership() core.ObjectOwnership {
+	ownerID := myuuid.NewId()
+	return core.ObjectOwnership{
+		OwnerID:   ownerID,
+		OwnerName: "bob",
+		Stolen:    true,
+		Provenance: []core.ProvenanceRecord{
+			{
+				Reason:    core.OwnershipReasonPurchased,
+				OwnerID:   ownerID,
+				OwnerName: "bob",
+				ActorID:   ownerID,
+				ActorName: "bob",
+				At:        testTimestamp,
+			},
+			{
+				Reason:    core.OwnershipReasonStolen,
+				OwnerID:   ownerID,
+				OwnerName: "bob",
+				ActorID:   myuuid.NewId(),
+				ActorName: "alice",
+				At:        testTimestamp,
+			},
+		},
+	}
+}
+
+func TestObjectAddToZoneEvent_roundtrip(t *testing.T) {
+	e := core.NewObjectAddToZoneEvent(
+		"a corpse",
+		"the corpse of a rat",
+		[]string{"corpse", "rat"},
+		20,
+		myuuid.NewId(),
+		myuuid.NewId(),
+		uuid.Nil,
+		uuid.Nil,
+		myuuid.NewId(),
+		core.ContainerDefaultSubcontainer,
+		core.ObjectAttributes{Weight: 5, InventorySlots: 16, Closeable: true, KeyKeyword: "brass", Value: 10},
+	)
+	e.DecayAt = testTimestamp
+	e.LootRightsActorIDs = []uuid.UUID{myuuid.NewId(), myuuid.NewId()}
+	e.LootRightsUntil = testTimestamp
+	e.CorpseOfID = myuuid.NewId()
+	e.Closed = true
+	e.Locked = true
+	e.Ownership = testOwnership()
+	e.PrototypeID = myuuid.NewId()
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
+
+func TestObjectMigrateInEvent_roundtrip(t *testing.T) {
+	e := core.NewObjectMigrateInEvent(
+		"a corpse",
+		"the corpse of a rat",
+		[]string{"corpse", "rat"},
+		20,
+		myuuid.NewId(),
+		myuuid.NewId(),
+		uuid.Nil,
+		myuuid.NewId(),
+		uuid.Nil,
+		myuuid.NewId(),
+		core.InventoryContainerHands,
+		core.ObjectAttributes{Weight: 5, InventorySlots: 16, Closeable: true, KeyKeyword: "brass", Value: 10},
+	)
+	e.DecayAt = testTimestamp
+	e.LootRightsActorIDs = []uuid.UUID{myuuid.NewId(), myuuid.NewId()}
+	e.LootRightsUntil = testTimestamp
+	e.CorpseOfID = myuuid.NewId()
+	e.Closed = true
+	e.Locked = true
+	e.Ownership = testOwnership()
+	e.PrototypeID = myuuid.NewId()
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
+
+func TestObjectOwnershipEvent_roundtrip(t *testing.T) {
+	e := core.NewObjectOwnershipEvent(
+		myuuid.NewId(),
+		myuuid.NewId(),
+		"a sword",
+		core.ProvenanceRecord{
+			Reason:    core.OwnershipReasonGiven,
+			OwnerID:   myuuid.NewId(),
+			OwnerName: "bob",
+			ActorID:   myuuid.NewId(),
+			ActorName: "alice",
+			At:        testTimestamp,
+		},
+	)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
+
+func TestObjectPrototypeUpdateEvent_roundtrip(t *testing.T) {
+	e := core.NewObjectPrototypeUpdateEvent(
+		myuuid.NewId(),
+		myuuid.NewId(),
+		core.ObjectPrototype{
+			ID:          myuuid.NewId(),
+			Name:        "an iron sword",
+			Description: "a plain but serviceable blade",
+			Keywords:    []string{"sword", "iron"},
+			Attributes: core.ObjectAttributes{
+				SlashingDamageMin: 2,
+				SlashingDamageMax: 6,
+				Weight:            3,
+				InventorySlots:    2,
+				Value:             40,
+			},
+		},
+	)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
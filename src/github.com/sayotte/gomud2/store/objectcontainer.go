@@ -0,0 +1,47 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type objectContainerActionEvent struct {
+	header                eventHeader
+	Action                string
+	ObjectID, ActorID     uuid.UUID
+	ObjectName, ActorName string
+}
+
+func (ocae objectContainerActionEvent) ToDomain() core.Event {
+	e := core.NewObjectContainerActionEvent(
+		ocae.Action,
+		ocae.ObjectID,
+		ocae.ActorID,
+		ocae.header.AggregateId,
+		ocae.ObjectName,
+		ocae.ActorName,
+	)
+	e.SetSequenceNumber(ocae.header.SequenceNumber)
+	e.SetTimestamp(ocae.header.Timestamp)
+	return e
+}
+
+func (ocae *objectContainerActionEvent) FromDomain(e core.Event) {
+	from := e.(*core.ObjectContainerActionEvent)
+	*ocae = objectContainerActionEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		Action:     from.Action,
+		ObjectID:   from.ObjectID,
+		ActorID:    from.ActorID,
+		ObjectName: from.ObjectName,
+		ActorName:  from.ActorName,
+	}
+}
+
+func (ocae objectContainerActionEvent) Header() eventHeader {
+	return ocae.header
+}
+
+func (ocae *objectContainerActionEvent) SetHeader(h eventHeader) {
+	ocae.header = h
+}
@@ -0,0 +1,23 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestObjectContainerActionEvent_roundtrip(t *testing.T) {
+	e := core.NewObjectContainerActionEvent(
+		core.ExitDoorActionLock,
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+		"a chest",
+		"bob",
+	)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
@@ -0,0 +1,152 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type actorQuestAcceptEvent struct {
+	header     eventHeader
+	ActorID    uuid.UUID
+	ActorName  string
+	Quest      string
+	Objectives int
+}
+
+func (aqae actorQuestAcceptEvent) ToDomain() core.Event {
+	e := core.NewActorQuestAcceptEvent(aqae.ActorID, aqae.header.AggregateId, aqae.ActorName, aqae.Quest, aqae.Objectives)
+	e.SetSequenceNumber(aqae.header.SequenceNumber)
+	e.SetTimestamp(aqae.header.Timestamp)
+	return e
+}
+
+func (aqae *actorQuestAcceptEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorQuestAcceptEvent)
+	*aqae = actorQuestAcceptEvent{
+		header:     eventHeaderFromDomainEvent(from),
+		ActorID:    from.ActorID,
+		ActorName:  from.ActorName,
+		Quest:      from.Quest,
+		Objectives: from.Objectives,
+	}
+}
+
+func (aqae actorQuestAcceptEvent) Header() eventHeader {
+	return aqae.header
+}
+
+func (aqae *actorQuestAcceptEvent) SetHeader(h eventHeader) {
+	aqae.header = h
+}
+
+type actorQuestProgressEvent struct {
+	header    eventHeader
+	ActorID   uuid.UUID
+	ActorName string
+	Quest     string
+	Objective int
+	Progress  int
+	Required  int
+}
+
+func (aqpe actorQuestProgressEvent) ToDomain() core.Event {
+	e := core.NewActorQuestProgressEvent(
+		aqpe.ActorID,
+		aqpe.header.AggregateId,
+		aqpe.ActorName,
+		aqpe.Quest,
+		aqpe.Objective,
+		aqpe.Progress,
+		aqpe.Required,
+	)
+	e.SetSequenceNumber(aqpe.header.SequenceNumber)
+	e.SetTimestamp(aqpe.header.Timestamp)
+	return e
+}
+
+func (aqpe *actorQuestProgressEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorQuestProgressEvent)
+	*aqpe = actorQuestProgressEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		Quest:     from.Quest,
+		Objective: from.Objective,
+		Progress:  from.Progress,
+		Required:  from.Required,
+	}
+}
+
+func (aqpe actorQuestProgressEvent) Header() eventHeader {
+	return aqpe.header
+}
+
+func (aqpe *actorQuestProgressEvent) SetHeader(h eventHeader) {
+	aqpe.header = h
+}
+
+type actorQuestCompleteEvent struct {
+	header    eventHeader
+	ActorID   uuid.UUID
+	ActorName string
+	Quest     string
+	Coins     int
+}
+
+func (aqce actorQuestCompleteEvent) ToDomain() core.Event {
+	e := core.NewActorQuestCompleteEvent(aqce.ActorID, aqce.header.AggregateId, aqce.ActorName, aqce.Quest, aqce.Coins)
+	e.SetSequenceNumber(aqce.header.SequenceNumber)
+	e.SetTimestamp(aqce.header.Timestamp)
+	return e
+}
+
+func (aqce *actorQuestCompleteEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorQuestCompleteEvent)
+	*aqce = actorQuestCompleteEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		Quest:     from.Quest,
+		Coins:     from.Coins,
+	}
+}
+
+func (aqce actorQuestCompleteEvent) Header() eventHeader {
+	return aqce.header
+}
+
+func (aqce *actorQuestCompleteEvent) SetHeader(h eventHeader) {
+	aqce.header = h
+}
+
+type actorQuestAbandonEvent struct {
+	header    eventHeader
+	ActorID   uuid.UUID
+	ActorName string
+	Quest     string
+}
+
+func (aqae actorQuestAbandonEvent) ToDomain() core.Event {
+	e := core.NewActorQuestAbandonEvent(aqae.ActorID, aqae.header.AggregateId, aqae.ActorName, aqae.Quest)
+	e.SetSequenceNumber(aqae.header.SequenceNumber)
+	e.SetTimestamp(aqae.header.Timestamp)
+	return e
+}
+
+func (aqae *actorQuestAbandonEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorQuestAbandonEvent)
+	*aqae = actorQuestAbandonEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		Quest:     from.Quest,
+	}
+}
+
+func (aqae actorQuestAbandonEvent) Header() eventHeader {
+	return aqae.header
+}
+
+func (aqae *actorQuestAbandonEvent) SetHeader(h eventHeader) {
+	aqae.header = h
+}
@@ -0,0 +1,25 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestQuestEvents_roundtrip(t *testing.T) {
+	testCases := map[string]core.Event{
+		"ActorQuestAcceptEvent":   core.NewActorQuestAcceptEvent(myuuid.NewId(), myuuid.NewId(), "bob", "rat problem", 2),
+		"ActorQuestProgressEvent": core.NewActorQuestProgressEvent(myuuid.NewId(), myuuid.NewId(), "bob", "rat problem", 1, 2, 5),
+		"ActorQuestCompleteEvent": core.NewActorQuestCompleteEvent(myuuid.NewId(), myuuid.NewId(), "bob", "rat problem", 50),
+		"ActorQuestAbandonEvent":  core.NewActorQuestAbandonEvent(myuuid.NewId(), myuuid.NewId(), "bob", "rat problem"),
+	}
+
+	for name, e := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e.SetSequenceNumber(97)
+			e.SetTimestamp(testTimestamp)
+			assertRoundtrip(t, e)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type actorLearnTechniqueEvent struct {
+	header            eventHeader
+	Technique         string
+	Level             int
+	ActorName         string
+	ActorID, ScrollID uuid.UUID
+}
+
+func (alte *actorLearnTechniqueEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorLearnTechniqueEvent)
+	*alte = actorLearnTechniqueEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		Technique: from.Technique,
+		Level:     from.Level,
+		ActorName: from.ActorName,
+		ActorID:   from.ActorID,
+		ScrollID:  from.ScrollID,
+	}
+}
+
+func (alte actorLearnTechniqueEvent) ToDomain() core.Event {
+	e := core.NewActorLearnTechniqueEvent(
+		alte.Technique,
+		alte.Level,
+		alte.ActorName,
+		alte.ActorID,
+		alte.ScrollID,
+		alte.header.AggregateId,
+	)
+	e.SetSequenceNumber(alte.header.SequenceNumber)
+	e.SetTimestamp(alte.header.Timestamp)
+	return e
+}
+
+func (alte actorLearnTechniqueEvent) Header() eventHeader {
+	return alte.header
+}
+
+func (alte *actorLearnTechniqueEvent) SetHeader(h eventHeader) {
+	alte.header = h
+}
+
+type actorScribeEvent struct {
+	header                     eventHeader
+	ActorID, SourceID, BlankID uuid.UUID
+	ActorName, ScrollName      string
+	Success                    bool
+	Inscription                float64
+}
+
+func (ase *actorScribeEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorScribeEvent)
+	*ase = actorScribeEvent{
+		header:      eventHeaderFromDomainEvent(from),
+		ActorID:     from.ActorID,
+		SourceID:    from.SourceID,
+		BlankID:     from.BlankID,
+		ActorName:   from.ActorName,
+		ScrollName:  from.ScrollName,
+		Success:     from.Success,
+		Inscription: from.Inscription,
+	}
+}
+
+func (ase actorScribeEvent) ToDomain() core.Event {
+	e := core.NewActorScribeEvent(
+		ase.ActorID,
+		ase.SourceID,
+		ase.BlankID,
+		ase.header.AggregateId,
+		ase.ActorName,
+		ase.ScrollName,
+		ase.Success,
+		ase.Inscription,
+	)
+	e.SetSequenceNumber(ase.header.SequenceNumber)
+	e.SetTimestamp(ase.header.Timestamp)
+	return e
+}
+
+func (ase actorScribeEvent) Header() eventHeader {
+	return ase.header
+}
+
+func (ase *actorScribeEvent) SetHeader(h eventHeader) {
+	ase.header = h
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestActorLearnTechniqueEvent_roundtrip(t *testing.T) {
+	e := core.NewActorLearnTechniqueEvent(
+		core.TechniqueDodging,
+		2,
+		"bob",
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+	)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
+
+func TestActorScribeEvent_roundtrip(t *testing.T) {
+	e := core.NewActorScribeEvent(
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+		"bob",
+		"a scroll of fireball",
+		true,
+		12.5,
+	)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
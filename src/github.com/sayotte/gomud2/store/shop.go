@@ -0,0 +1,114 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type objectCoinsEvent struct {
+	header   eventHeader
+	ObjectID uuid.UUID
+	Coins    int
+}
+
+func (oce objectCoinsEvent) ToDomain() core.Event {
+	e := core.NewObjectCoinsEvent(oce.ObjectID, oce.header.AggregateId, oce.Coins)
+	e.SetSequenceNumber(oce.header.SequenceNumber)
+	e.SetTimestamp(oce.header.Timestamp)
+	return e
+}
+
+func (oce *objectCoinsEvent) FromDomain(e core.Event) {
+	from := e.(*core.ObjectCoinsEvent)
+	*oce = objectCoinsEvent{
+		header:   eventHeaderFromDomainEvent(from),
+		ObjectID: from.ObjectID,
+		Coins:    from.Coins,
+	}
+}
+
+func (oce objectCoinsEvent) Header() eventHeader {
+	return oce.header
+}
+
+func (oce *objectCoinsEvent) SetHeader(h eventHeader) {
+	oce.header = h
+}
+
+type shopTradeEvent struct {
+	header                                   eventHeader
+	Action                                   string
+	ShopkeeperID, CustomerID, ObjectID       uuid.UUID
+	ShopkeeperName, CustomerName, ObjectName string
+	Price                                    int
+}
+
+func (ste shopTradeEvent) ToDomain() core.Event {
+	e := core.NewShopTradeEvent(
+		ste.Action,
+		ste.ShopkeeperID,
+		ste.CustomerID,
+		ste.ObjectID,
+		ste.header.AggregateId,
+		ste.ShopkeeperName,
+		ste.CustomerName,
+		ste.ObjectName,
+		ste.Price,
+	)
+	e.SetSequenceNumber(ste.header.SequenceNumber)
+	e.SetTimestamp(ste.header.Timestamp)
+	return e
+}
+
+func (ste *shopTradeEvent) FromDomain(e core.Event) {
+	from := e.(*core.ShopTradeEvent)
+	*ste = shopTradeEvent{
+		header:         eventHeaderFromDomainEvent(from),
+		Action:         from.Action,
+		ShopkeeperID:   from.ShopkeeperID,
+		CustomerID:     from.CustomerID,
+		ObjectID:       from.ObjectID,
+		ShopkeeperName: from.ShopkeeperName,
+		CustomerName:   from.CustomerName,
+		ObjectName:     from.ObjectName,
+		Price:          from.Price,
+	}
+}
+
+func (ste shopTradeEvent) Header() eventHeader {
+	return ste.header
+}
+
+func (ste *shopTradeEvent) SetHeader(h eventHeader) {
+	ste.header = h
+}
+
+type actorSetShopEvent struct {
+	header  eventHeader
+	ActorID uuid.UUID
+	Shop    *core.ShopRules
+}
+
+func (asse actorSetShopEvent) ToDomain() core.Event {
+	e := core.NewActorSetShopEvent(asse.ActorID, asse.header.AggregateId, asse.Shop)
+	e.SetSequenceNumber(asse.header.SequenceNumber)
+	e.SetTimestamp(asse.header.Timestamp)
+	return e
+}
+
+func (asse *actorSetShopEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorSetShopEvent)
+	*asse = actorSetShopEvent{
+		header:  eventHeaderFromDomainEvent(from),
+		ActorID: from.ActorID,
+		Shop:    from.Shop,
+	}
+}
+
+func (asse actorSetShopEvent) Header() eventHeader {
+	return asse.header
+}
+
+func (asse *actorSetShopEvent) SetHeader(h eventHeader) {
+	asse.header = h
+}
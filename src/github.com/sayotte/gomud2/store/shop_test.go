@@ -0,0 +1,39 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestShopEvents_roundtrip(t *testing.T) {
+	testCases := map[string]core.Event{
+		"ObjectCoinsEvent": core.NewObjectCoinsEvent(myuuid.NewId(), myuuid.NewId(), 42),
+		"ShopTradeEvent": core.NewShopTradeEvent(
+			core.ShopTradeActionBuy,
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"a shopkeeper",
+			"bob",
+			"a sword",
+			30,
+		),
+		"ActorSetShopEvent": core.NewActorSetShopEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			&core.ShopRules{SellRate: 1.5, BuyRate: 0.5, BuysKeywords: []string{"sword", "shield"}},
+		),
+		"ActorSetShopEvent, closing shop": core.NewActorSetShopEvent(myuuid.NewId(), myuuid.NewId(), nil),
+	}
+
+	for name, e := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e.SetSequenceNumber(97)
+			e.SetTimestamp(testTimestamp)
+			assertRoundtrip(t, e)
+		})
+	}
+}
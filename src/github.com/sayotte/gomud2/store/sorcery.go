@@ -0,0 +1,95 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type sorceryInvokeEvent struct {
+	header                eventHeader
+	ReactionName, Effect  string
+	ActorID, TargetID     uuid.UUID
+	ActorName, TargetName string
+	Success               bool
+	FocusCost, Magnitude  int
+}
+
+func (sie *sorceryInvokeEvent) FromDomain(e core.Event) {
+	from := e.(*core.SorceryInvokeEvent)
+	*sie = sorceryInvokeEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		ReactionName: from.ReactionName,
+		Effect:       from.Effect,
+		ActorID:      from.ActorID,
+		TargetID:     from.TargetID,
+		ActorName:    from.ActorName,
+		TargetName:   from.TargetName,
+		Success:      from.Success,
+		FocusCost:    from.FocusCost,
+		Magnitude:    from.Magnitude,
+	}
+}
+
+func (sie sorceryInvokeEvent) ToDomain() core.Event {
+	e := core.NewSorceryInvokeEvent(
+		sie.ReactionName,
+		sie.Effect,
+		sie.ActorID,
+		sie.TargetID,
+		sie.header.AggregateId,
+		sie.ActorName,
+		sie.TargetName,
+		sie.Success,
+		sie.FocusCost,
+		sie.Magnitude,
+	)
+	e.SetSequenceNumber(sie.header.SequenceNumber)
+	e.SetTimestamp(sie.header.Timestamp)
+	return e
+}
+
+func (sie sorceryInvokeEvent) Header() eventHeader {
+	return sie.header
+}
+
+func (sie *sorceryInvokeEvent) SetHeader(h eventHeader) {
+	sie.header = h
+}
+
+type actorLearnReactionEvent struct {
+	header                  eventHeader
+	ReactionName, ActorName string
+	ActorID, ScrollID       uuid.UUID
+}
+
+func (alre *actorLearnReactionEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorLearnReactionEvent)
+	*alre = actorLearnReactionEvent{
+		header:       eventHeaderFromDomainEvent(from),
+		ReactionName: from.ReactionName,
+		ActorName:    from.ActorName,
+		ActorID:      from.ActorID,
+		ScrollID:     from.ScrollID,
+	}
+}
+
+func (alre actorLearnReactionEvent) ToDomain() core.Event {
+	e := core.NewActorLearnReactionEvent(
+		alre.ReactionName,
+		alre.ActorName,
+		alre.ActorID,
+		alre.ScrollID,
+		alre.header.AggregateId,
+	)
+	e.SetSequenceNumber(alre.header.SequenceNumber)
+	e.SetTimestamp(alre.header.Timestamp)
+	return e
+}
+
+func (alre actorLearnReactionEvent) Header() eventHeader {
+	return alre.header
+}
+
+func (alre *actorLearnReactionEvent) SetHeader(h eventHeader) {
+	alre.header = h
+}
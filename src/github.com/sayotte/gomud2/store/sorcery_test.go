@@ -0,0 +1,35 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func TestSorceryInvokeEvent_roundtrip(t *testing.T) {
+	e := core.NewSorceryInvokeEvent(
+		"fireball",
+		core.ReactionEffectDamage,
+		myuuid.NewId(),
+		myuuid.NewId(),
+		myuuid.NewId(),
+		"bob",
+		"a rat",
+		true,
+		5,
+		12,
+	)
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
+
+func TestActorLearnReactionEvent_roundtrip(t *testing.T) {
+	e := core.NewActorLearnReactionEvent("fireball", "bob", myuuid.NewId(), myuuid.NewId(), myuuid.NewId())
+	e.SetSequenceNumber(97)
+	e.SetTimestamp(testTimestamp)
+
+	assertRoundtrip(t, e)
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"github.com/satori/go.uuid"
+	"github.com/sayotte/gomud2/core"
+)
+
+type actorEffectEvent struct {
+	header            eventHeader
+	ActorID, EffectID uuid.UUID
+	ActorName         string
+	Source            string
+	Name              string
+	Attribute         string
+	Delta             int
+}
+
+func (aee actorEffectEvent) ToDomain() core.Event {
+	e := core.NewActorEffectEvent(
+		aee.ActorID,
+		aee.EffectID,
+		aee.header.AggregateId,
+		aee.ActorName,
+		aee.Source,
+		aee.Name,
+		aee.Attribute,
+		aee.Delta,
+	)
+	e.SetSequenceNumber(aee.header.SequenceNumber)
+	e.SetTimestamp(aee.header.Timestamp)
+	return e
+}
+
+func (aee *actorEffectEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorEffectEvent)
+	*aee = actorEffectEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		EffectID:  from.EffectID,
+		ActorName: from.ActorName,
+		Source:    from.Source,
+		Name:      from.Name,
+		Attribute: from.Attribute,
+		Delta:     from.Delta,
+	}
+}
+
+func (aee actorEffectEvent) Header() eventHeader {
+	return aee.header
+}
+
+func (aee *actorEffectEvent) SetHeader(h eventHeader) {
+	aee.header = h
+}
+
+type actorEffectStartEvent struct {
+	header    eventHeader
+	ActorID   uuid.UUID
+	ActorName string
+	Effect    core.ActiveEffect
+}
+
+func (aese actorEffectStartEvent) ToDomain() core.Event {
+	e := core.NewActorEffectStartEvent(aese.ActorID, aese.header.AggregateId, aese.ActorName, aese.Effect)
+	e.SetSequenceNumber(aese.header.SequenceNumber)
+	e.SetTimestamp(aese.header.Timestamp)
+	return e
+}
+
+func (aese *actorEffectStartEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorEffectStartEvent)
+	*aese = actorEffectStartEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		Effect:    from.Effect,
+	}
+}
+
+func (aese actorEffectStartEvent) Header() eventHeader {
+	return aese.header
+}
+
+func (aese *actorEffectStartEvent) SetHeader(h eventHeader) {
+	aese.header = h
+}
+
+type actorEffectEndEvent struct {
+	header            eventHeader
+	ActorID, EffectID uuid.UUID
+	ActorName         string
+	Name              string
+	Source            string
+	Reason            string
+}
+
+func (aeee actorEffectEndEvent) ToDomain() core.Event {
+	e := core.NewActorEffectEndEvent(
+		aeee.ActorID,
+		aeee.header.AggregateId,
+		aeee.ActorName,
+		core.ActiveEffect{
+			ID: aeee.EffectID,
+			Effect: core.StatusEffect{
+				Name:   aeee.Name,
+				Source: aeee.Source,
+			},
+		},
+		aeee.Reason,
+	)
+	e.SetSequenceNumber(aeee.header.SequenceNumber)
+	e.SetTimestamp(aeee.header.Timestamp)
+	return e
+}
+
+func (aeee *actorEffectEndEvent) FromDomain(e core.Event) {
+	from := e.(*core.ActorEffectEndEvent)
+	*aeee = actorEffectEndEvent{
+		header:    eventHeaderFromDomainEvent(from),
+		ActorID:   from.ActorID,
+		EffectID:  from.EffectID,
+		ActorName: from.ActorName,
+		Name:      from.Name,
+		Source:    from.Source,
+		Reason:    from.Reason,
+	}
+}
+
+func (aeee actorEffectEndEvent) Header() eventHeader {
+	return aeee.header
+}
+
+func (aeee *actorEffectEndEvent) SetHeader(h eventHeader) {
+	aeee.header = h
+}
@@ -0,0 +1,65 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sayotte/gomud2/core"
+	myuuid "github.com/sayotte/gomud2/uuid"
+)
+
+func testActiveEffect() core.ActiveEffect {
+	return core.ActiveEffect{
+		ID: myuuid.NewId(),
+		Effect: core.StatusEffect{
+			Name:          "poisoned",
+			Source:        "a green vial",
+			Stacking:      core.StatusEffectStackingStack,
+			MaxStacks:     3,
+			Attributes:    core.AttributeSet{Strength: -5},
+			Skills:        core.Skillset{Dodging: -10},
+			TickAttribute: core.EffectAttributePhysical,
+			TickDelta:     -2,
+			TickInterval:  time.Second * 5,
+			Duration:      time.Minute,
+		},
+		Start: testTimestamp,
+		Ticks: 4,
+	}
+}
+
+func TestStatusEffectEvents_roundtrip(t *testing.T) {
+	testCases := map[string]core.Event{
+		"ActorEffectEvent": core.NewActorEffectEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			"a green vial",
+			"poisoned",
+			core.EffectAttributePhysical,
+			-2,
+		),
+		"ActorEffectStartEvent": core.NewActorEffectStartEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			testActiveEffect(),
+		),
+		"ActorEffectEndEvent": core.NewActorEffectEndEvent(
+			myuuid.NewId(),
+			myuuid.NewId(),
+			"bob",
+			testActiveEffect(),
+			core.EffectEndReasonExpired,
+		),
+	}
+
+	for name, e := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e.SetSequenceNumber(97)
+			e.SetTimestamp(testTimestamp)
+			assertRoundtrip(t, e)
+		})
+	}
+}
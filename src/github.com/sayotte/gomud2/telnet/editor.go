@@ -25,6 +25,8 @@ const (
 	worldEditHandlerStateExitGetDescription
 	worldEditHandlerStateExitGetDirection
 	worldEditHandlerStateExitGetDestination
+	worldEditHandlerStateExitGetDoor
+	worldEditHandlerStateLocationGetTags
 )
 
 const (
@@ -34,12 +36,14 @@ const (
 const (
 	worldEditLocEditMenuItemShortDescription = "Change short description"
 	worldEditLocEditMenuItemLongDescription  = "Change long description"
+	worldEditLocEditMenuItemTags             = "Change tags"
 )
 
 const (
 	worldEditExitEditMenuItemDescription = "Change description"
 	worldEditExitEditMenuItemDirection   = "Change direction"
 	worldEditExitEditMenuItemDest        = "Change destination"
+	worldEditExitEditMenuItemDoor        = "Configure door"
 )
 
 type worldEditCommandHandler func(line string, terminalWidth, terminalHeight int) ([]byte, error)
@@ -85,6 +89,10 @@ func (weh *worldEditHandler) handleRxLine(line []byte, terminalWidth, terminalHe
 		return weh.handleGetExitDirectionState(line, terminalWidth, terminalHeight)
 	case worldEditHandlerStateExitGetDestination:
 		return weh.handleGetExitDestState(line, terminalWidth, terminalHeight)
+	case worldEditHandlerStateExitGetDoor:
+		return weh.handleGetExitDoorState(line, terminalWidth, terminalHeight)
+	case worldEditHandlerStateLocationGetTags:
+		return weh.handleGetLocationTagsState(line, terminalWidth, terminalHeight)
 	default:
 		return nil, weh, fmt.Errorf("worldEditHandler: unknown state %d", weh.state)
 	}
@@ -188,14 +196,12 @@ func (weh *worldEditHandler) gotoZoneEditState(terminalWidth int, zone *core.Zon
 	}
 
 	weh.cmdTrie = trie.New()
-	weh.cmdTrie.Add(core.ExitDirectionNorth, weh.getDirectionHandlerGeneric(core.ExitDirectionNorth))
-	weh.cmdTrie.Add("n", weh.getDirectionHandlerGeneric(core.ExitDirectionNorth))
-	weh.cmdTrie.Add(core.ExitDirectionSouth, weh.getDirectionHandlerGeneric(core.ExitDirectionSouth))
-	weh.cmdTrie.Add("s", weh.getDirectionHandlerGeneric(core.ExitDirectionSouth))
-	weh.cmdTrie.Add(core.ExitDirectionEast, weh.getDirectionHandlerGeneric(core.ExitDirectionEast))
-	weh.cmdTrie.Add("e", weh.getDirectionHandlerGeneric(core.ExitDirectionEast))
-	weh.cmdTrie.Add(core.ExitDirectionWest, weh.getDirectionHandlerGeneric(core.ExitDirectionWest))
-	weh.cmdTrie.Add("w", weh.getDirectionHandlerGeneric(core.ExitDirectionWest))
+	for _, direction := range orderedDirections {
+		weh.cmdTrie.Add(direction, weh.getDirectionHandlerGeneric(direction))
+	}
+	for abbreviation, direction := range core.DirectionAbbreviations {
+		weh.cmdTrie.Add(abbreviation, weh.getDirectionHandlerGeneric(direction))
+	}
 	weh.cmdTrie.Add("goto", weh.getGotoHandler())
 	weh.cmdTrie.Add("leave", weh.getLeaveHandler())
 	weh.cmdTrie.Add("look", weh.getLookHandler())
@@ -220,6 +226,12 @@ func (weh *worldEditHandler) handleZoneEditState(lineB []byte, terminalWidth, te
 		return nil, weh, nil
 	}
 
+	// custom exits, e.g. "climb rope", are taken by typing their direction
+	if exit := exitInDirection(weh.locUnderEdit, line); exit != nil && !core.ValidDirections[exit.Direction()] {
+		outBytes, err := weh.getDirectionHandlerGeneric(exit.Direction())("", terminalWidth, terminalHeight)
+		return outBytes, weh, err
+	}
+
 	firstTerm := strings.ToLower(strings.Split(line, " ")[0])
 	terms := weh.cmdTrie.PrefixSearch(firstTerm)
 	if len(terms) == 0 {
@@ -357,13 +369,25 @@ func (weh *worldEditHandler) getInspectHandler() worldEditCommandHandler {
 
 func (weh *worldEditHandler) getNewlocationHandler() worldEditCommandHandler {
 	return func(line string, terminalWidth, terminalHeight int) ([]byte, error) {
-		params := strings.Split(line, " ")
-		if len(params) == 0 {
-			return []byte("Usage: newlocation <direction>\n"), nil
+		// custom directions have no natural opposite, so the direction of the
+		// Exit leading back may be given after a colon
+		params := strings.SplitN(strings.ToLower(strings.TrimSpace(line)), ":", 2)
+		if params[0] == "" {
+			return []byte("Usage: newlocation <direction>[:<return direction>]\n"), nil
 		}
-		direction := strings.ToLower(params[0])
-		if !core.ValidDirections[direction] {
-			return []byte(fmt.Sprintf("Invalid direction %q, need one of: %s\n", direction, strings.Join(orderedDirections, ", "))), nil
+		direction := core.ExpandDirection(strings.TrimSpace(params[0]))
+		if !isValidExitDirection(direction) {
+			return []byte(fmt.Sprintf("Invalid direction %q, %s\n", direction, directionHelp)), nil
+		}
+		returnDirection := core.OppositeDirection(direction)
+		if len(params) == 2 {
+			returnDirection = core.ExpandDirection(strings.TrimSpace(params[1]))
+		}
+		if returnDirection == "" {
+			return []byte(fmt.Sprintf("No opposite to direction %q, use \"newlocation %s:<return direction>\".\n", direction, direction)), nil
+		}
+		if !isValidExitDirection(returnDirection) {
+			return []byte(fmt.Sprintf("Invalid return direction %q, %s\n", returnDirection, directionHelp)), nil
 		}
 
 		for _, exit := range weh.locUnderEdit.OutExits() {
@@ -398,7 +422,7 @@ func (weh *worldEditHandler) getNewlocationHandler() worldEditCommandHandler {
 		inExitPrim := core.NewExit(
 			uuid.Nil,
 			fmt.Sprintf("To %s", weh.locUnderEdit.ID()),
-			invertDirection(direction),
+			returnDirection,
 			newLoc,
 			weh.locUnderEdit,
 			weh.zoneUnderEdit,
@@ -424,6 +448,7 @@ func (weh *worldEditHandler) gotoEditLocationMenu() worldEditCommandHandler {
 		options := []string{
 			worldEditLocEditMenuItemShortDescription,
 			worldEditLocEditMenuItemLongDescription,
+			worldEditLocEditMenuItemTags,
 			menuItemCancel,
 		}
 		weh.currentMenu = &menu{
@@ -448,6 +473,9 @@ func (weh *worldEditHandler) handleEditLocationState(line []byte, terminalWidth,
 	case worldEditLocEditMenuItemLongDescription:
 		weh.state = worldEditHandlerStateLocationGetDesc
 		return []byte("Enter new description, followed by a newline <enter>\n"), weh, nil
+	case worldEditLocEditMenuItemTags:
+		weh.state = worldEditHandlerStateLocationGetTags
+		return []byte("Enter new tags separated by spaces, or nothing to clear them, followed by a newline <enter>\n"), weh, nil
 	case menuItemCancel:
 		fallthrough
 	default:
@@ -488,6 +516,19 @@ func (weh *worldEditHandler) handleGetLocationDescState(line []byte, terminalWid
 	return append([]byte("Done.\n"), menuBytes...), weh, nil
 }
 
+func (weh *worldEditHandler) handleGetLocationTagsState(line []byte, terminalWidth, terminalHeight int) ([]byte, handler, error) {
+	newTags := strings.Fields(strings.ToLower(string(line)))
+	err := weh.locUnderEdit.SetTags(newTags)
+	if err != nil {
+		fmt.Printf("ERROR: Location.SetTags(...): %s\n", err)
+		return nil, weh, errors.New("Whoops...")
+	}
+
+	gotoMenuFunc := weh.gotoEditLocationMenu()
+	menuBytes, _ := gotoMenuFunc(string(line), terminalWidth, terminalHeight)
+	return append([]byte("Done.\n"), menuBytes...), weh, nil
+}
+
 func (weh *worldEditHandler) getSetDefaultLocHandler() worldEditCommandHandler {
 	return func(line string, terminalWidth, terminalHeight int) ([]byte, error) {
 		params := strings.Split(line, " ")
@@ -544,17 +585,18 @@ func (weh *worldEditHandler) getRemLocationHandler() worldEditCommandHandler {
 
 func (weh *worldEditHandler) getNewExitHandler() worldEditCommandHandler {
 	return func(line string, terminalWidth, terminalHeight int) ([]byte, error) {
-		params := strings.Split(line, " ")
-		if len(params) != 2 || params[0] == "" || params[1] == "" {
+		// the direction may be several words long, the destination is last
+		params := strings.Fields(line)
+		if len(params) < 2 {
 			return []byte("Usage: newexit <direction> <zone ID>/<location ID>\n"), nil
 		}
-		locParams := strings.Split(params[1], "/")
+		locParams := strings.Split(params[len(params)-1], "/")
 		if len(locParams) != 2 {
 			return []byte("Usage: newexit <direction> <zone ID>/<location ID>\n"), nil
 		}
-		direction := strings.ToLower(params[0])
-		if !core.ValidDirections[direction] {
-			return []byte(fmt.Sprintf("Invalid direction %q, need one of: %s\n", direction, strings.Join(orderedDirections, ", "))), nil
+		direction := core.ExpandDirection(strings.ToLower(strings.Join(params[:len(params)-1], " ")))
+		if !isValidExitDirection(direction) {
+			return []byte(fmt.Sprintf("Invalid direction %q, %s\n", direction, directionHelp)), nil
 		}
 		zoneID, err := uuid.FromString(locParams[0])
 		if err != nil {
@@ -604,13 +646,12 @@ func (weh *worldEditHandler) getNewExitHandler() worldEditCommandHandler {
 
 func (weh *worldEditHandler) getEditExitHandler() worldEditCommandHandler {
 	return func(line string, terminalWidth, terminalHeight int) ([]byte, error) {
-		params := strings.Split(line, " ")
-		if len(params) == 0 {
+		direction := core.ExpandDirection(strings.ToLower(strings.TrimSpace(line)))
+		if direction == "" {
 			return []byte("Usage: editexit <direction>\n"), nil
 		}
-		direction := strings.ToLower(params[0])
-		if !core.ValidDirections[direction] {
-			return []byte(fmt.Sprintf("Invalid direction %q, need one of: %s\n", direction, strings.Join(orderedDirections, ", "))), nil
+		if !core.IsValidDirection(direction) {
+			return []byte(fmt.Sprintf("Invalid direction %q, %s\n", direction, directionHelp)), nil
 		}
 
 		var exit *core.Exit
@@ -639,6 +680,7 @@ func (weh *worldEditHandler) gotoEditExitState(exit *core.Exit, terminalWidth, t
 		worldEditExitEditMenuItemDescription,
 		worldEditExitEditMenuItemDirection,
 		worldEditExitEditMenuItemDest,
+		worldEditExitEditMenuItemDoor,
 		menuItemCancel,
 	}
 	weh.currentMenu = &menu{
@@ -659,12 +701,18 @@ func (weh *worldEditHandler) handleEditExitState(line []byte, terminalWidth, ter
 		return []byte("Enter new description, followed by a newline <enter>.\n"), weh, nil
 	case worldEditExitEditMenuItemDirection:
 		weh.state = worldEditHandlerStateExitGetDirection
-		return []byte(fmt.Sprintf("Enter one of [%s] followed by a newline <enter>.\n", strings.Join(orderedDirections, ", "))), weh, nil
+		return []byte(fmt.Sprintf("Enter one of [%s] or a custom direction, followed by a newline <enter>.\n", strings.Join(orderedDirections, ", "))), weh, nil
 	case worldEditExitEditMenuItemDest:
 		weh.state = worldEditHandlerStateExitGetDestination
 		prompt := "Enter ID for destination Zone/Location, followed by a newline <enter>\n"
 		prompt += "Example: b6b0fff7-a7fe-4ba2-91e0-9e78e752f841/3730ad94-88fa-4f11-8cbc-bcebdaa0ac9b\n"
 		return []byte(prompt), weh, nil
+	case worldEditExitEditMenuItemDoor:
+		weh.state = worldEditHandlerStateExitGetDoor
+		prompt := "Enter \"none\" to remove the door, or one of [open, closed, locked] optionally\n"
+		prompt += "followed by the ID or keyword of the key which opens its lock, then a newline <enter>.\n"
+		prompt += "Example: locked brasskey\n"
+		return []byte(prompt), weh, nil
 	case menuItemCancel:
 		fallthrough
 	default:
@@ -694,9 +742,9 @@ func (weh *worldEditHandler) handleGetExitDescState(line []byte, terminalWidth,
 }
 
 func (weh *worldEditHandler) handleGetExitDirectionState(line []byte, terminalWidth, terminalHeight int) ([]byte, handler, error) {
-	newDir := strings.TrimSuffix(string(line), "\n")
-	if !core.ValidDirections[newDir] {
-		errBytes := []byte(fmt.Sprintf("Invalid direction, must be one of [%s].\n", strings.Join(orderedDirections, ", ")))
+	newDir := core.ExpandDirection(strings.ToLower(strings.TrimSpace(string(line))))
+	if !isValidExitDirection(newDir) {
+		errBytes := []byte(fmt.Sprintf("Invalid direction, %s\n", directionHelp))
 		menuBytes := weh.gotoEditExitState(weh.exitUnderEdit, terminalWidth, terminalHeight)
 		return append(errBytes, menuBytes...), weh, nil
 	}
@@ -784,15 +832,63 @@ func (weh *worldEditHandler) handleGetExitDestState(line []byte, terminalWidth,
 	return append([]byte("Done.\n"), weh.gotoEditExitState(weh.exitUnderEdit, terminalWidth, terminalHeight)...), weh, nil
 }
 
+func (weh *worldEditHandler) handleGetExitDoorState(line []byte, terminalWidth, terminalHeight int) ([]byte, handler, error) {
+	params := strings.Fields(strings.ToLower(string(line)))
+	if len(params) == 0 || len(params) > 2 {
+		errBytes := []byte("Invalid input, must be of form \"none\" or <state> [<key>]\n")
+		return append(errBytes, weh.gotoEditExitState(weh.exitUnderEdit, terminalWidth, terminalHeight)...), weh, nil
+	}
+
+	var hasDoor, closed, locked bool
+	switch params[0] {
+	case "none":
+	case "open":
+		hasDoor = true
+	case "closed":
+		hasDoor, closed = true, true
+	case "locked":
+		hasDoor, closed, locked = true, true, true
+	default:
+		errBytes := []byte("Invalid door state, must be one of [none, open, closed, locked].\n")
+		return append(errBytes, weh.gotoEditExitState(weh.exitUnderEdit, terminalWidth, terminalHeight)...), weh, nil
+	}
+
+	var keyID uuid.UUID
+	var keyKeyword string
+	if len(params) == 2 {
+		if !hasDoor {
+			errBytes := []byte("An exit without a door can't have a key.\n")
+			return append(errBytes, weh.gotoEditExitState(weh.exitUnderEdit, terminalWidth, terminalHeight)...), weh, nil
+		}
+		// a key may be given by the ID of a particular Object, or by a keyword
+		// any matching Object will do for
+		if id, err := uuid.FromString(params[1]); err == nil {
+			keyID = id
+		} else {
+			keyKeyword = params[1]
+		}
+	}
+	if locked && uuid.Equal(keyID, uuid.Nil) && keyKeyword == "" {
+		errBytes := []byte("A door without a key can't be locked.\n")
+		return append(errBytes, weh.gotoEditExitState(weh.exitUnderEdit, terminalWidth, terminalHeight)...), weh, nil
+	}
+
+	err := weh.exitUnderEdit.SetDoor(hasDoor, closed, locked, keyID, keyKeyword)
+	if err != nil {
+		fmt.Printf("ERROR: Exit.SetDoor(): %s\n", err)
+		return nil, weh, errors.New("Whoops...")
+	}
+	return append([]byte("Done.\n"), weh.gotoEditExitState(weh.exitUnderEdit, terminalWidth, terminalHeight)...), weh, nil
+}
+
 func (weh *worldEditHandler) getRemExitHandler() worldEditCommandHandler {
 	return func(line string, terminalWidth, terminalHeight int) ([]byte, error) {
-		params := strings.Split(line, " ")
-		if len(params) != 1 {
-			return []byte("Usage: remexit <direction\n"), nil
+		direction := core.ExpandDirection(strings.ToLower(strings.TrimSpace(line)))
+		if direction == "" {
+			return []byte("Usage: remexit <direction>\n"), nil
 		}
-		direction := strings.ToLower(params[0])
-		if !core.ValidDirections[direction] {
-			return []byte(fmt.Sprintf("Invalid direction %q, need one of: %s\n", direction, strings.Join(orderedDirections, ", "))), nil
+		if !core.IsValidDirection(direction) {
+			return []byte(fmt.Sprintf("Invalid direction %q, %s\n", direction, directionHelp)), nil
 		}
 
 		var exit *core.Exit
@@ -814,17 +910,18 @@ func (weh *worldEditHandler) getRemExitHandler() worldEditCommandHandler {
 	}
 }
 
-func invertDirection(inDir string) string {
-	switch inDir {
-	case core.ExitDirectionNorth:
-		return core.ExitDirectionSouth
-	case core.ExitDirectionSouth:
-		return core.ExitDirectionNorth
-	case core.ExitDirectionEast:
-		return core.ExitDirectionWest
-	case core.ExitDirectionWest:
-		return core.ExitDirectionEast
-	default:
-		return ""
+// isValidExitDirection reports whether an Exit may be made to lead in the
+// given direction. On top of core.IsValidDirection, a custom direction mustn't
+// start with a game command (or a prefix of one); custom Exits are taken by
+// typing their direction, which would otherwise hide that command from
+// everyone in the Location.
+func isValidExitDirection(direction string) bool {
+	if !core.IsValidDirection(direction) {
+		return false
+	}
+	if core.ValidDirections[direction] {
+		return true
 	}
+	firstTerm := strings.Split(direction, " ")[0]
+	return len(gameCommands.PrefixSearch(firstTerm)) == 0
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/sayotte/gomud2/commands"
 	"github.com/sayotte/gomud2/core"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type gameHandlerCommandHandler func(line string, terminalWidth int) ([]byte, error)
@@ -26,49 +28,79 @@ type gameHandler struct {
 	targetID uuid.UUID
 }
 
+// gameCommands holds the game's commands, for checking custom Exit
+// directions against; see isValidExitDirection.
+var gameCommands = (&gameHandler{}).newCommandTrie()
+
 func (gh *gameHandler) init(terminalWidth, terminalHeight int) []byte {
-	gh.cmdTrie = trie.New()
-	gh.cmdTrie.Add("commands", gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
+	gh.cmdTrie = gh.newCommandTrie()
+	return lookAtLocation(core.ActorList{gh.actor}, terminalWidth, gh.actor.Location())
+}
+
+func (gh *gameHandler) newCommandTrie() *trie.Trie {
+	cmdTrie := trie.New()
+	cmdTrie.Add("bind", gh.getBindHandler())
+	cmdTrie.Add("buy", gh.getBuyHandler())
+	cmdTrie.Add("close", gh.getDoorHandler(core.ExitDoorActionClose))
+	cmdTrie.Add("commands", gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
 		return gh.handleCommandCommands(terminalWidth)
 	}))
-	gh.cmdTrie.Add("drop", gh.getDropHandler())
-	gh.cmdTrie.Add("inventory", gh.getInventoryHandler())
-	gh.cmdTrie.Add("look", gh.getLookHandler())
-	gh.cmdTrie.Add("put", gh.getPutHandler())
-	gh.cmdTrie.Add("take", gh.getTakeHandler())
-	gh.cmdTrie.Add("target", gh.getTargetHandler())
-	gh.cmdTrie.Add("slash", gh.getSlashHandler())
-	gh.cmdTrie.Add("kill", gh.getKillHandler())
-	gh.cmdTrie.Add("wear", gh.getWearHandler())
-	gh.cmdTrie.Add("remove", gh.getRemoveHandler())
-	gh.cmdTrie.Add("say", gh.getSayHandler())
-
-	gh.cmdTrie.Add(core.ExitDirectionNorth, gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
-		return gh.handleCommandMoveGeneric(terminalWidth, core.ExitDirectionNorth)
-	}))
-	gh.cmdTrie.Add("n", gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
-		return gh.handleCommandMoveGeneric(terminalWidth, core.ExitDirectionNorth)
-	}))
-	gh.cmdTrie.Add(core.ExitDirectionSouth, gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
-		return gh.handleCommandMoveGeneric(terminalWidth, core.ExitDirectionSouth)
-	}))
-	gh.cmdTrie.Add("s", gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
-		return gh.handleCommandMoveGeneric(terminalWidth, core.ExitDirectionSouth)
-	}))
-	gh.cmdTrie.Add(core.ExitDirectionEast, gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
-		return gh.handleCommandMoveGeneric(terminalWidth, core.ExitDirectionEast)
-	}))
-	gh.cmdTrie.Add("e", gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
-		return gh.handleCommandMoveGeneric(terminalWidth, core.ExitDirectionEast)
-	}))
-	gh.cmdTrie.Add(core.ExitDirectionWest, gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
-		return gh.handleCommandMoveGeneric(terminalWidth, core.ExitDirectionWest)
-	}))
-	gh.cmdTrie.Add("w", gameHandlerCommandHandler(func(line string, terminalWidth int) ([]byte, error) {
-		return gh.handleCommandMoveGeneric(terminalWidth, core.ExitDirectionWest)
-	}))
+	cmdTrie.Add("dedicate", gh.getDedicateHandler())
+	cmdTrie.Add("craft", gh.getCraftHandler())
+	cmdTrie.Add("crimes", gh.getCrimesHandler())
+	cmdTrie.Add("drink", gh.getConsumeHandler(core.ConsumeMethodDrink))
+	cmdTrie.Add("drop", gh.getDropHandler())
+	cmdTrie.Add("eat", gh.getConsumeHandler(core.ConsumeMethodEat))
+	cmdTrie.Add("fire", gh.getFireHandler())
+	cmdTrie.Add("give", gh.getGiveHandler())
+	cmdTrie.Add("hire", gh.getHireHandler())
+	cmdTrie.Add("inventory", gh.getInventoryHandler())
+	cmdTrie.Add("invoke", gh.getInvokeHandler())
+	cmdTrie.Add("list", gh.getListHandler())
+	// "l" would otherwise be taken as a prefix of "lock"
+	cmdTrie.Add("l", gh.getLookHandler())
+	cmdTrie.Add("look", gh.getLookHandler())
+	cmdTrie.Add("lock", gh.getDoorHandler(core.ExitDoorActionLock))
+	cmdTrie.Add("loot", gh.getLootHandler())
+	cmdTrie.Add("open", gh.getDoorHandler(core.ExitDoorActionOpen))
+	cmdTrie.Add("order", gh.getOrderHandler())
+	cmdTrie.Add("pray", gh.getPrayHandler())
+	cmdTrie.Add("provenance", gh.getProvenanceHandler())
+	cmdTrie.Add("put", gh.getPutHandler())
+	cmdTrie.Add("read", gh.getReadHandler())
+	cmdTrie.Add("take", gh.getTakeHandler())
+	cmdTrie.Add("target", gh.getTargetHandler())
+	cmdTrie.Add("trade", gh.getTradeHandler())
+	cmdTrie.Add("slash", gh.getSlashHandler())
+	cmdTrie.Add("kill", gh.getKillHandler())
+	cmdTrie.Add("disengage", gh.getDisengageHandler())
+	// "f" would otherwise be taken as a prefix of "fire"
+	cmdTrie.Add("f", gh.getFleeHandler())
+	cmdTrie.Add("flee", gh.getFleeHandler())
+	cmdTrie.Add("wear", gh.getWearHandler())
+	cmdTrie.Add("quest", gh.getQuestHandler())
+	cmdTrie.Add("quests", gh.getQuestsHandler())
+	cmdTrie.Add("recipes", gh.getRecipesHandler())
+	cmdTrie.Add("remove", gh.getRemoveHandler())
+	cmdTrie.Add("reputation", gh.getReputationHandler())
+	cmdTrie.Add("sacrifice", gh.getSacrificeHandler())
+	cmdTrie.Add("say", gh.getSayHandler())
+	cmdTrie.Add("score", gh.getSheetHandler())
+	cmdTrie.Add("scribe", gh.getScribeHandler())
+	cmdTrie.Add("sell", gh.getSellHandler())
+	cmdTrie.Add("sheet", gh.getSheetHandler())
+	cmdTrie.Add("unlock", gh.getDoorHandler(core.ExitDoorActionUnlock))
+	cmdTrie.Add("use", gh.getConsumeHandler(core.ConsumeMethodUse))
+	cmdTrie.Add("value", gh.getValueHandler())
+
+	for _, direction := range orderedDirections {
+		cmdTrie.Add(direction, gh.getMoveHandler(direction))
+	}
+	for abbreviation, direction := range core.DirectionAbbreviations {
+		cmdTrie.Add(abbreviation, gh.getMoveHandler(direction))
+	}
 
-	return lookAtLocation(core.ActorList{gh.actor}, terminalWidth, gh.actor.Location())
+	return cmdTrie
 }
 
 func (gh *gameHandler) handleEvent(e core.Event, terminalWidth, terminalHeight int) ([]byte, handler, error) {
@@ -80,6 +112,9 @@ func (gh *gameHandler) handleEvent(e core.Event, terminalWidth, terminalHeight i
 	case core.EventTypeActorRemoveFromZone:
 		// print nothing, this is not useful information for the Telnet client
 		return nil, gh, nil
+	case core.EventTypeActorActionDelay:
+		// print nothing, Telnet clients just queue their actions in the core
+		return nil, gh, nil
 	case core.EventTypeActorDeath:
 		typedE := e.(*core.ActorDeathEvent)
 		out, err := gh.handleEventActorDeath(terminalWidth, typedE)
@@ -104,10 +139,174 @@ func (gh *gameHandler) handleEvent(e core.Event, terminalWidth, terminalHeight i
 		typedE := e.(*core.CombatDodgeEvent)
 		out, err := gh.handleEventCombatDodge(terminalWidth, typedE)
 		return out, gh, err
+	case core.EventTypeCombatEngage:
+		typedE := e.(*core.CombatEngageEvent)
+		out := gh.handleEventCombatEngage(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeCombatDisengage:
+		typedE := e.(*core.CombatDisengageEvent)
+		out := gh.handleEventCombatDisengage(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeCombatFlee:
+		typedE := e.(*core.CombatFleeEvent)
+		out := gh.handleEventCombatFlee(terminalWidth, typedE)
+		return out, gh, nil
 	case core.EventTypeActorSpeak:
 		typedE := e.(*core.ActorSpeakEvent)
 		out := gh.handleEventActorSpeak(terminalWidth, typedE)
 		return out, gh, nil
+	case core.EventTypeActorBecomeGhost:
+		typedE := e.(*core.ActorBecomeGhostEvent)
+		out := gh.handleEventActorBecomeGhost(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorRespawn:
+		typedE := e.(*core.ActorRespawnEvent)
+		out := gh.handleEventActorRespawn(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorSetBindLocation:
+		// print nothing, the bind command already told the user what happened
+		return nil, gh, nil
+	case core.EventTypeSorceryInvoke:
+		typedE := e.(*core.SorceryInvokeEvent)
+		out := gh.handleEventSorceryInvoke(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorLearnReaction:
+		typedE := e.(*core.ActorLearnReactionEvent)
+		out := gh.handleEventActorLearnReaction(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorLearnTechnique:
+		typedE := e.(*core.ActorLearnTechniqueEvent)
+		out := gh.handleEventActorLearnTechnique(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorScribe:
+		typedE := e.(*core.ActorScribeEvent)
+		out := gh.handleEventActorScribe(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorExert:
+		// only our own Actor's observers hear about this
+		return []byte("You strain under the weight of everything you're carrying.\n"), gh, nil
+	case core.EventTypeActorDedicate:
+		typedE := e.(*core.ActorDedicateEvent)
+		out := gh.handleEventActorDedicate(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorSacrifice:
+		typedE := e.(*core.ActorSacrificeEvent)
+		out := gh.handleEventActorSacrifice(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorPray:
+		typedE := e.(*core.ActorPrayEvent)
+		out := gh.handleEventActorPray(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeExitDoor:
+		typedE := e.(*core.ExitDoorEvent)
+		out := gh.handleEventExitDoor(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeObjectContainerAction:
+		typedE := e.(*core.ObjectContainerActionEvent)
+		out := gh.handleEventObjectContainerAction(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeObjectCoins:
+		// print nothing, the trade or move that changed the stack says enough
+		return nil, gh, nil
+	case core.EventTypeShopTrade:
+		typedE := e.(*core.ShopTradeEvent)
+		out := gh.handleEventShopTrade(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeTradeOffer:
+		typedE := e.(*core.TradeOfferEvent)
+		out := gh.handleEventTradeOffer(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeTradeAccept:
+		typedE := e.(*core.TradeAcceptEvent)
+		out := gh.handleEventTradeAccept(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeTradeCancel:
+		typedE := e.(*core.TradeCancelEvent)
+		out := gh.handleEventTradeCancel(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeTradeComplete:
+		typedE := e.(*core.TradeCompleteEvent)
+		out := gh.handleEventTradeComplete(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorHire:
+		typedE := e.(*core.ActorHireEvent)
+		out := gh.handleEventActorHire(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorRelease:
+		typedE := e.(*core.ActorReleaseEvent)
+		out := gh.handleEventActorRelease(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorRelationship:
+		// print nothing, the order that changed it says enough
+		return nil, gh, nil
+	case core.EventTypeActorOrder:
+		typedE := e.(*core.ActorOrderEvent)
+		out := gh.handleEventActorOrder(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorJoinFaction:
+		typedE := e.(*core.ActorJoinFactionEvent)
+		out := gh.handleEventActorFactionMembership(terminalWidth, typedE.ActorID, typedE.ActorName, "join", typedE.Faction)
+		return out, gh, nil
+	case core.EventTypeActorLeaveFaction:
+		typedE := e.(*core.ActorLeaveFactionEvent)
+		out := gh.handleEventActorFactionMembership(terminalWidth, typedE.ActorID, typedE.ActorName, "leave", typedE.Faction)
+		return out, gh, nil
+	case core.EventTypeActorReputation:
+		typedE := e.(*core.ActorReputationEvent)
+		out := gh.handleEventActorReputation(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeCrimeCommit:
+		typedE := e.(*core.CrimeCommitEvent)
+		out := gh.handleEventCrimeCommit(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeCrimeReport:
+		typedE := e.(*core.CrimeReportEvent)
+		out := gh.handleEventCrimeReport(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeCrimeResolve:
+		typedE := e.(*core.CrimeResolveEvent)
+		out := gh.handleEventCrimeResolve(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeObjectOwnership:
+		typedE := e.(*core.ObjectOwnershipEvent)
+		out := gh.handleEventObjectOwnership(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorQuestAccept:
+		typedE := e.(*core.ActorQuestAcceptEvent)
+		out := gh.handleEventActorQuestAccept(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorQuestProgress:
+		typedE := e.(*core.ActorQuestProgressEvent)
+		out := gh.handleEventActorQuestProgress(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorQuestComplete:
+		typedE := e.(*core.ActorQuestCompleteEvent)
+		out := gh.handleEventActorQuestComplete(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorQuestAbandon:
+		typedE := e.(*core.ActorQuestAbandonEvent)
+		out := gh.handleEventActorQuestAbandon(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorConsume:
+		typedE := e.(*core.ActorConsumeEvent)
+		out := gh.handleEventActorConsume(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorEffect:
+		typedE := e.(*core.ActorEffectEvent)
+		out := gh.handleEventActorEffect(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorEffectStart:
+		typedE := e.(*core.ActorEffectStartEvent)
+		out := gh.handleEventActorEffectStart(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorEffectEnd:
+		typedE := e.(*core.ActorEffectEndEvent)
+		out := gh.handleEventActorEffectEnd(terminalWidth, typedE)
+		return out, gh, nil
+	case core.EventTypeActorCraft:
+		typedE := e.(*core.ActorCraftEvent)
+		out := gh.handleEventActorCraft(terminalWidth, typedE)
+		return out, gh, nil
 	default:
 		return []byte(fmt.Sprintf("session: observed event of type %T\n", e)), gh, nil
 	}
@@ -119,6 +318,12 @@ func (gh *gameHandler) handleRxLine(lineB []byte, terminalWidth, terminalHeight
 		return nil, gh, nil
 	}
 
+	// custom exits, e.g. "climb rope", are taken by typing their direction
+	if exit := exitInDirection(gh.actor.Location(), line); exit != nil && !core.ValidDirections[exit.Direction()] {
+		outBytes, err := gh.handleCommandMoveGeneric(terminalWidth, exit.Direction())
+		return outBytes, gh, err
+	}
+
 	firstTerm := strings.ToLower(strings.Split(line, " ")[0])
 	terms := gh.cmdTrie.PrefixSearch(firstTerm)
 	if len(terms) == 0 {
@@ -148,25 +353,28 @@ func (gh *gameHandler) getLookHandler() gameHandlerCommandHandler {
 
 		targetKW := strings.ToLower(params[0])
 
+		// If we're asked to look in a container, list what's inside it.
+		if targetKW == "in" && len(params) > 1 {
+			contKW := strings.ToLower(params[1])
+			contObj := gh.reachableObjectMatch(contKW)
+			if contObj == nil {
+				return []byte(fmt.Sprintf("Look in what, exactly? I can't find a %q.\n", contKW)), nil
+			}
+			if !contObj.IsContainer() {
+				return []byte("That isn't a container.\n"), nil
+			}
+			return []byte(containerContentsClause(contObj)), nil
+		}
+
 		// If we're asked to look in a particular direction, look at the
 		// Location in that direction (if there's even an Exit).
-		var dirLook bool
-		for _, dir := range orderedDirections {
-			if targetKW == dir {
-				dirLook = true
-				break
-			}
+		exit := exitInDirection(gh.actor.Location(), line)
+		if exit == nil && core.ValidDirections[core.ExpandDirection(targetKW)] {
+			return []byte("No exit in that direction!\n"), nil
 		}
-		if dirLook {
-			var exit *core.Exit
-			for _, maybeExit := range gh.actor.Location().OutExits() {
-				if maybeExit.Direction() == targetKW {
-					exit = maybeExit
-					break
-				}
-			}
-			if exit == nil {
-				return []byte("No exit in that direction!\n"), nil
+		if exit != nil {
+			if exit.IsClosed() {
+				return []byte("The door that way is closed.\n"), nil
 			}
 			var targetLoc *core.Location
 			if exit.Destination() != nil {
@@ -196,15 +404,9 @@ func (gh *gameHandler) getLookHandler() gameHandlerCommandHandler {
 		}
 
 		// Otherwise, look at a particular object
-		// Start by looking for a kw match in the inventory
-		var targetObj *core.Object
-		targetObj = keywordObjectMatch(targetKW, gh.actor.Objects())
+		targetObj := gh.reachableObjectMatch(targetKW)
 		if targetObj == nil {
-			// Failing that, look for a kw match on the ground
-			targetObj = keywordObjectMatch(targetKW, gh.actor.Location().Objects())
-			if targetObj == nil {
-				return []byte(fmt.Sprintf("Look at what, exactly? I can't find a %q.\n", targetKW)), nil
-			}
+			return []byte(fmt.Sprintf("Look at what, exactly? I can't find a %q.\n", targetKW)), nil
 		}
 
 		return lookAtObject(terminalWidth, targetObj), nil
@@ -215,6 +417,12 @@ func (gh *gameHandler) handleCommandCommands(terminalWidth int) ([]byte, error)
 	return summarizeCommands(gh.cmdTrie, terminalWidth), nil
 }
 
+func (gh *gameHandler) getMoveHandler(direction string) gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		return gh.handleCommandMoveGeneric(terminalWidth, direction)
+	}
+}
+
 func (gh *gameHandler) handleCommandMoveGeneric(terminalWidth int, direction string) ([]byte, error) {
 	newActor, err := commands.MoveActor(gh.actor, direction, gh.session)
 	if err != nil {
@@ -276,6 +484,28 @@ func (gh *gameHandler) handleEventActorDeath(terminalWidth int, e *core.ActorDea
 	return []byte(fmt.Sprintf("%s died!\n", e.ActorName)), nil
 }
 
+func (gh *gameHandler) handleEventActorBecomeGhost(terminalWidth int, e *core.ActorBecomeGhostEvent) []byte {
+	if uuid.Equal(e.ActorID, gh.actor.ID()) {
+		secs := int(e.RespawnAt.Sub(e.Timestamp()).Seconds())
+		return []byte(wordwrap.WrapString(
+			fmt.Sprintf("Your spirit drifts free of your body. You'll return to life in %d seconds.\n", secs),
+			uint(terminalWidth),
+		))
+	}
+	return []byte(fmt.Sprintf("The ghost of %s rises from its corpse.\n", e.ActorName))
+}
+
+func (gh *gameHandler) handleEventActorRespawn(terminalWidth int, e *core.ActorRespawnEvent) []byte {
+	if uuid.Equal(e.ActorID, gh.actor.ID()) {
+		out := []byte("You return to life!\n")
+		return append(out, lookAtLocation(core.ActorList{gh.actor}, terminalWidth, gh.actor.Location())...)
+	}
+	if uuid.Equal(e.ToLocationID, gh.actor.Location().ID()) {
+		return []byte(fmt.Sprintf("%s appears, alive once again.\n", e.ActorName))
+	}
+	return []byte(fmt.Sprintf("The ghost of %s fades away.\n", e.ActorName))
+}
+
 func (gh *gameHandler) handleEventObjectMove(terminalWidth int, e *core.ObjectMoveEvent) ([]byte, error) {
 	var out string
 
@@ -295,6 +525,7 @@ func (gh *gameHandler) handleEventObjectMove(terminalWidth int, e *core.ObjectMo
 	//| true       | me        |         |            |          |              | Y          | You drop X on the ground.                    |
 	//| true       |           | me      |            |          | Y            |            | You pick up X from the ground.               |
 	//| false      | other     | me      |            |          |              |            | <who> gives you X.                           |
+	//| false      | other     | other2  |            |          |              |            | <who> gives X to <other2>.                   |
 	//| false      | other     |         |            | Y        |              |            | <who> puts X in Y.                           |
 	//| false      |           | other   | Y          |          |              |            | <who> takes X from Y.                        |
 	//| false      | other     |         |            |          |              | Y          | <who> drops X on the ground.                 |
@@ -323,9 +554,13 @@ func (gh *gameHandler) handleEventObjectMove(terminalWidth int, e *core.ObjectMo
 		}
 	} else {
 		switch {
-		case !uuid.Equal(e.FromActorContainerID, uuid.Nil) && !uuid.Equal(e.ToActorContainerID, uuid.Nil):
+		case uuid.Equal(e.ToActorContainerID, gh.actor.ID()):
 			// actor -> me
 			out = fmt.Sprintf("%s gives you %s.\n", who, what)
+		case !uuid.Equal(e.FromActorContainerID, uuid.Nil) && !uuid.Equal(e.ToActorContainerID, uuid.Nil):
+			// actor -> other actor
+			toWhom := resolveActorNameByID(e.ToActorContainerID, "someone", zone)
+			out = fmt.Sprintf("%s gives %s to %s.\n", who, what, toWhom)
 		case !uuid.Equal(e.ToObjectContainerID, uuid.Nil):
 			// actor -> container
 			intoWhat := resolveObjNameByID(e.ToObjectContainerID, zone)
@@ -434,120 +669,793 @@ func (gh *gameHandler) handleEventCombatDodge(terminalWidth int, e *core.CombatD
 	return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
 }
 
-func (gh *gameHandler) handleEventActorSpeak(terminalWidth int, e *core.ActorSpeakEvent) []byte {
-	var preamble string
+func (gh *gameHandler) handleEventCombatEngage(terminalWidth int, e *core.CombatEngageEvent) []byte {
+	var out string
+	switch {
+	case uuid.Equal(e.AttackerID, gh.actor.ID()):
+		out = fmt.Sprintf("You attack %s!\n", e.TargetName)
+	case uuid.Equal(e.TargetID, gh.actor.ID()):
+		out = fmt.Sprintf("%s attacks you!\n", e.AttackerName)
+	default:
+		out = fmt.Sprintf("%s attacks %s!\n", e.AttackerName, e.TargetName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventCombatDisengage(terminalWidth int, e *core.CombatDisengageEvent) []byte {
+	// deaths and escapes are narrated by their own events
+	if e.Reason != core.CombatDisengageReasonDisengage {
+		return nil
+	}
+	var out string
+	switch {
+	case uuid.Equal(e.ActorID, gh.actor.ID()):
+		out = fmt.Sprintf("You stop fighting %s.\n", e.TargetName)
+	case uuid.Equal(e.TargetID, gh.actor.ID()):
+		out = fmt.Sprintf("%s stops fighting you.\n", e.ActorName)
+	default:
+		out = fmt.Sprintf("%s stops fighting %s.\n", e.ActorName, e.TargetName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventCombatFlee(terminalWidth int, e *core.CombatFleeEvent) []byte {
+	var out string
+	switch {
+	case uuid.Equal(e.ActorID, gh.actor.ID()) && e.Success:
+		out = fmt.Sprintf("You flee %s!\n", e.Direction)
+	case uuid.Equal(e.ActorID, gh.actor.ID()):
+		out = "You try to flee, but can't get away!\n"
+	case e.Success:
+		out = fmt.Sprintf("%s flees %s!\n", e.ActorName, e.Direction)
+	default:
+		out = fmt.Sprintf("%s tries to flee, but can't get away!\n", e.ActorName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventSorceryInvoke(terminalWidth int, e *core.SorceryInvokeEvent) []byte {
+	selfInvoked := uuid.Equal(e.ActorID, gh.actor.ID())
+	selfTargeted := uuid.Equal(e.TargetID, gh.actor.ID())
+
+	targetName := e.TargetName
+	switch {
+	case selfTargeted && selfInvoked:
+		targetName = "yourself"
+	case selfTargeted:
+		targetName = "you"
+	case uuid.Equal(e.ActorID, e.TargetID):
+		targetName = "themself"
+	}
+
+	var out string
+	switch {
+	case !e.Success && selfInvoked:
+		out = fmt.Sprintf("You invoke %s, but nothing happens.\n", e.ReactionName)
+	case !e.Success:
+		out = fmt.Sprintf("%s invokes %s, but nothing happens.\n", e.ActorName, e.ReactionName)
+	case e.Effect == core.ReactionEffectHeal && selfInvoked:
+		out = fmt.Sprintf("You invoke %s, mending %s.\n", e.ReactionName, targetName)
+	case e.Effect == core.ReactionEffectHeal:
+		out = fmt.Sprintf("%s invokes %s, mending %s.\n", e.ActorName, e.ReactionName, targetName)
+	case selfInvoked:
+		out = fmt.Sprintf("You invoke %s, wounding %s.\n", e.ReactionName, targetName)
+	default:
+		out = fmt.Sprintf("%s invokes %s, wounding %s.\n", e.ActorName, e.ReactionName, targetName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorLearnReaction(terminalWidth int, e *core.ActorLearnReactionEvent) []byte {
+	var out string
 	if uuid.Equal(e.ActorID, gh.actor.ID()) {
-		preamble = "You say"
+		out = fmt.Sprintf("As you read the scroll, the secret of %s etches itself into your mind.\n", e.ReactionName)
 	} else {
-		preamble = fmt.Sprintf("%s says", e.ActorName)
+		out = fmt.Sprintf("%s studies a scroll intently.\n", e.ActorName)
 	}
-	line := fmt.Sprintf("%s %q.\n", preamble, e.Speech)
-	out := wordwrap.WrapString(line, uint(terminalWidth))
-	return []byte(out)
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
 }
 
-func (gh *gameHandler) getTakeHandler() gameHandlerCommandHandler {
-	return func(line string, terminalWidth int) ([]byte, error) {
-		params := strings.Split(line, " ")
-		if len(params) == 0 {
-			return []byte("Usage: take <object keyword>\n"), nil
-		}
+func (gh *gameHandler) handleEventActorLearnTechnique(terminalWidth int, e *core.ActorLearnTechniqueEvent) []byte {
+	var out string
+	if uuid.Equal(e.ActorID, gh.actor.ID()) {
+		out = fmt.Sprintf("As you study the scroll, you grasp a new %s technique.\n", e.Technique)
+	} else {
+		out = fmt.Sprintf("%s studies a scroll intently.\n", e.ActorName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
 
-		// Decide where we're taking the object from
-		var container core.Container
-		if len(params) == 1 {
-			container = gh.actor.Location() // default to the ground
-		} else {
-			contKeyword := strings.ToLower(params[1])
+func (gh *gameHandler) handleEventActorScribe(terminalWidth int, e *core.ActorScribeEvent) []byte {
+	var out string
+	switch {
+	case uuid.Equal(e.ActorID, gh.actor.ID()) && e.Success:
+		out = fmt.Sprintf("You carefully copy %s onto a blank scroll.\n", e.ScrollName)
+	case uuid.Equal(e.ActorID, gh.actor.ID()):
+		out = fmt.Sprintf("Your hand slips while copying %s, and the blank scroll is ruined.\n", e.ScrollName)
+	default:
+		out = fmt.Sprintf("%s bends over a scroll, quill in hand.\n", e.ActorName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
 
-			// first check inventory
-			contObj := keywordObjectMatch(contKeyword, gh.actor.Objects())
-			if contObj != nil {
-				container = contObj
-				goto foundContainer
-			}
+func (gh *gameHandler) handleEventActorCraft(terminalWidth int, e *core.ActorCraftEvent) []byte {
+	var out string
+	recipe, _ := core.RecipeByName(e.Recipe)
+	switch {
+	case uuid.Equal(e.ActorID, gh.actor.ID()) && e.Success:
+		out = fmt.Sprintf("You set to work, and before long you've made %s.\n", recipe.Output.Name)
+	case uuid.Equal(e.ActorID, gh.actor.ID()):
+		out = "You set to work, but botch the job and waste your materials.\n"
+	case e.Success:
+		out = fmt.Sprintf("%s sets to work, and before long has made %s.\n", e.ActorName, recipe.Output.Name)
+	default:
+		out = fmt.Sprintf("%s sets to work, but botches the job.\n", e.ActorName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
 
-			// if that fails, check containers on the ground
-			contObj = keywordObjectMatch(contKeyword, gh.actor.Location().Objects())
-			if contObj != nil {
-				container = contObj
-				goto foundContainer
-			}
+func (gh *gameHandler) handleEventActorDedicate(terminalWidth int, e *core.ActorDedicateEvent) []byte {
+	var out string
+	if uuid.Equal(e.ActorID, gh.actor.ID()) {
+		out = fmt.Sprintf("You dedicate yourself to %s.\n", e.DeityName)
+	} else {
+		out = fmt.Sprintf("%s kneels and swears devotion to %s.\n", e.ActorName, e.DeityName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
 
-			return []byte(fmt.Sprintf("Take from where? I can't find a %q.\n", contKeyword)), nil
-		}
-	foundContainer:
+func (gh *gameHandler) handleEventActorSacrifice(terminalWidth int, e *core.ActorSacrificeEvent) []byte {
+	var out string
+	if uuid.Equal(e.ActorID, gh.actor.ID()) {
+		out = fmt.Sprintf("You offer up %s to your deity.\n", e.ObjectName)
+	} else {
+		out = fmt.Sprintf("%s offers up %s in sacrifice.\n", e.ActorName, e.ObjectName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
 
-		// Decide which object we're taking
-		targetKeyword := strings.ToLower(params[0])
-		var targetObj *core.Object
-		targetObj = keywordObjectMatch(targetKeyword, container.Objects())
-		if targetObj == nil {
-			return []byte(fmt.Sprintf("Take what again? I can't find a %q.\n", targetKeyword)), nil
-		}
+func (gh *gameHandler) handleEventActorPray(terminalWidth int, e *core.ActorPrayEvent) []byte {
+	selfPrayed := uuid.Equal(e.ActorID, gh.actor.ID())
+	selfTargeted := uuid.Equal(e.TargetID, gh.actor.ID())
 
-		//if len(gh.actor.Objects()) >= gh.actor.Capacity() {
-		//	return []byte("You have no room for that in your inventory!\n"), nil
-		//}
-		handCapacity, handMaxItems := gh.actor.Inventory().CapacityBySubcontainer(core.InventoryContainerHands)
-		handObjs := gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands)
-		if len(handObjs) >= handMaxItems {
-			return []byte("You don't have enough hands to hold that.\n"), nil
-		}
-		var handBurden int
-		for _, handObj := range handObjs {
-			handBurden += handObj.InventorySlots()
-		}
-		if handBurden+targetObj.InventorySlots() > handCapacity {
-			return []byte("You're carrying too much in your hands to carry another thing.\n"), nil
-		}
+	targetName := e.TargetName
+	switch {
+	case selfTargeted && selfPrayed:
+		targetName = "you"
+	case selfTargeted:
+		targetName = "you"
+	case uuid.Equal(e.ActorID, e.TargetID):
+		targetName = e.ActorName
+	}
 
-		err := targetObj.Move(container, gh.actor, gh.actor, core.ContainerDefaultSubcontainer)
-		if err != nil {
-			return []byte("Whoops...\n"), fmt.Errorf("Object.Move(Container, Actor): %s", err)
-		}
+	var preamble string
+	if selfPrayed {
+		preamble = fmt.Sprintf("You pray for %s", e.PrayerName)
+	} else {
+		preamble = fmt.Sprintf("%s prays for %s", e.ActorName, e.PrayerName)
+	}
 
-		return nil, nil
+	var out string
+	switch {
+	case e.Magnitude == 0:
+		out = fmt.Sprintf("%s, but nothing seems to happen.\n", preamble)
+	case e.Effect == core.PrayerEffectHeal:
+		out = fmt.Sprintf("%s, and a warm light washes over %s.\n", preamble, targetName)
+	default:
+		out = fmt.Sprintf("%s, and divine wrath strikes %s!\n", preamble, targetName)
 	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
 }
 
-func (gh *gameHandler) getDropHandler() gameHandlerCommandHandler {
-	return func(line string, terminalWidth int) ([]byte, error) {
-		params := strings.Split(line, " ")
-		if len(params) == 0 {
-			return []byte("Usage: drop <object keyword>\n"), nil
-		}
-
-		targetKeyword := strings.ToLower(params[0])
-		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands))
-		if targetObj == nil {
-			return []byte(fmt.Sprintf("Drop what again? I can't find a %q.\n", targetKeyword)), nil
+func (gh *gameHandler) handleEventExitDoor(terminalWidth int, e *core.ExitDoorEvent) []byte {
+	// the door is narrated from whichever side of it our Actor is on, so
+	// ignore the event for the Exit on the other side
+	var onOurSide bool
+	for _, exit := range gh.actor.Location().OutExits() {
+		if uuid.Equal(exit.ID(), e.ExitID) {
+			onOurSide = true
+			break
 		}
+	}
+	if !onOurSide {
+		return nil
+	}
 
-		err := targetObj.Move(gh.actor, gh.actor.Location(), gh.actor, core.ContainerDefaultSubcontainer)
-		if err != nil {
-			return []byte("Whoops...\n"), fmt.Errorf("Object.Move(Actor, Location): %s", err)
+	var out string
+	actor := gh.actor.Zone().ActorByID(e.ActorID)
+	switch {
+	case uuid.Equal(e.ActorID, gh.actor.ID()):
+		out = fmt.Sprintf("You %s the door to the %s.\n", e.Action, e.Direction)
+	case actor != nil && actor.Location() == gh.actor.Location():
+		out = fmt.Sprintf("%s %ss the door to the %s.\n", e.ActorName, e.Action, e.Direction)
+	default:
+		// the Actor is on the other side of the door
+		switch e.Action {
+		case core.ExitDoorActionOpen:
+			out = fmt.Sprintf("The door to the %s opens.\n", e.Direction)
+		case core.ExitDoorActionClose:
+			out = fmt.Sprintf("The door to the %s closes.\n", e.Direction)
+		default:
+			out = fmt.Sprintf("You hear a click from the door to the %s.\n", e.Direction)
 		}
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
 
-		return nil, nil
+func (gh *gameHandler) handleEventObjectContainerAction(terminalWidth int, e *core.ObjectContainerActionEvent) []byte {
+	var out string
+	switch {
+	case uuid.Equal(e.ActorID, gh.actor.ID()):
+		out = fmt.Sprintf("You %s %s.\n", e.Action, e.ObjectName)
+	default:
+		out = fmt.Sprintf("%s %ss %s.\n", e.ActorName, e.Action, e.ObjectName)
 	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
 }
 
-func (gh *gameHandler) getPutHandler() gameHandlerCommandHandler {
-	return func(line string, terminalWidth int) ([]byte, error) {
-		params := strings.Split(line, " ")
-		if len(params) <= 1 {
-			return []byte("Usage: put <object keyword> <container keyword>\n"), nil
-		}
+// handleEventShopTrade narrates the payment for a trade; the goods changing
+// hands are narrated by the ObjectMoveEvent which follows.
+func (gh *gameHandler) handleEventShopTrade(terminalWidth int, e *core.ShopTradeEvent) []byte {
+	var out string
+	switch {
+	case uuid.Equal(e.CustomerID, gh.actor.ID()) && e.Action == core.ShopTradeActionBuy:
+		out = fmt.Sprintf("You pay %s %d coins for %s.\n", e.ShopkeeperName, e.Price, e.ObjectName)
+	case uuid.Equal(e.CustomerID, gh.actor.ID()):
+		out = fmt.Sprintf("%s pays you %d coins for %s.\n", e.ShopkeeperName, e.Price, e.ObjectName)
+	case e.Action == core.ShopTradeActionBuy:
+		out = fmt.Sprintf("%s buys %s from %s.\n", e.CustomerName, e.ObjectName, e.ShopkeeperName)
+	default:
+		out = fmt.Sprintf("%s sells %s to %s.\n", e.CustomerName, e.ObjectName, e.ShopkeeperName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
 
-		// Decide which object we're putting
-		targetKeyword := strings.ToLower(params[0])
-		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands))
-		if targetObj == nil {
-			return []byte(fmt.Sprintf("Put what, exactly? There's no %q in your inventory.\n", targetKeyword)), nil
+func (gh *gameHandler) handleEventTradeOffer(terminalWidth int, e *core.TradeOfferEvent) []byte {
+	offer := tradeOfferSummary(e.ObjectNames, e.Coins)
+	var out string
+	if uuid.Equal(e.ActorID, gh.actor.ID()) {
+		out = fmt.Sprintf("You offer %s to %s.\n", offer, e.PartnerName)
+	} else {
+		out = fmt.Sprintf("%s offers you %s in trade.\n", e.ActorName, offer)
+		if gh.actor.TradingWith() == nil {
+			out += fmt.Sprintf("(Use \"trade %s\" to join the trade, or \"trade cancel\" to decline.)\n", strings.ToLower(e.ActorName))
 		}
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
 
-		// Decide where we're putting it
+func (gh *gameHandler) handleEventTradeAccept(terminalWidth int, e *core.TradeAcceptEvent) []byte {
+	var out string
+	if uuid.Equal(e.ActorID, gh.actor.ID()) {
+		out = fmt.Sprintf("You accept the trade with %s.\n", e.PartnerName)
+	} else {
+		out = fmt.Sprintf("%s accepts the trade.\n", e.ActorName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventTradeCancel(terminalWidth int, e *core.TradeCancelEvent) []byte {
+	me := uuid.Equal(e.ActorID, gh.actor.ID())
+	var out string
+	switch {
+	case e.Reason != core.TradeCancelReasonCancel && me:
+		out = fmt.Sprintf("The trade with %s is off.\n", e.PartnerName)
+	case e.Reason != core.TradeCancelReasonCancel:
+		out = fmt.Sprintf("The trade with %s is off.\n", e.ActorName)
+	case me:
+		out = fmt.Sprintf("You call off the trade with %s.\n", e.PartnerName)
+	default:
+		out = fmt.Sprintf("%s calls off the trade.\n", e.ActorName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventTradeComplete(terminalWidth int, e *core.TradeCompleteEvent) []byte {
+	partnerName := e.PartnerName
+	if uuid.Equal(e.PartnerID, gh.actor.ID()) {
+		partnerName = e.ActorName
+	}
+	out := fmt.Sprintf("Your trade with %s is complete.\n", partnerName)
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorHire(terminalWidth int, e *core.ActorHireEvent) []byte {
+	var out string
+	if uuid.Equal(e.EmployerID, gh.actor.ID()) {
+		out = fmt.Sprintf(
+			"You pay %s %d coins. They'll serve you for the next %s.\n",
+			e.ActorName,
+			e.Wage,
+			minutesPhrase(e.HiredUntil.Sub(e.Timestamp())),
+		)
+	} else {
+		out = fmt.Sprintf("%s hires %s.\n", e.EmployerName, e.ActorName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorRelease(terminalWidth int, e *core.ActorReleaseEvent) []byte {
+	me := uuid.Equal(e.EmployerID, gh.actor.ID())
+	var out string
+	switch {
+	case e.Reason == core.HirelingReleaseReasonFired && me:
+		out = fmt.Sprintf("You dismiss %s.\n", e.ActorName)
+	case e.Reason == core.HirelingReleaseReasonFired:
+		out = fmt.Sprintf("%s dismisses %s.\n", e.EmployerName, e.ActorName)
+	case me:
+		out = fmt.Sprintf("%s's contract with you has run out.\n", e.ActorName)
+	default:
+		out = fmt.Sprintf("%s's contract with %s has run out.\n", e.ActorName, e.EmployerName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorOrder(terminalWidth int, e *core.ActorOrderEvent) []byte {
+	me := uuid.Equal(e.EmployerID, gh.actor.ID())
+	var what string
+	switch e.Order.Order {
+	case core.HirelingOrderAttack:
+		what = "attack " + e.Order.TargetName
+	case core.HirelingOrderGuard:
+		what = "guard " + e.Order.TargetName
+	case core.HirelingOrderStandDown:
+		what = "stand down"
+	case core.HirelingOrderStay:
+		what = "stay here"
+	case core.HirelingOrderFollow:
+		what = "follow them"
+		if me {
+			what = "follow you"
+		}
+	case core.HirelingOrderReport:
+		what = "report"
+	default:
+		what = e.Order.Order
+	}
+	var out string
+	if me {
+		out = fmt.Sprintf("You order %s to %s.\n", e.ActorName, what)
+	} else {
+		out = fmt.Sprintf("%s orders %s to %s.\n", e.EmployerName, e.ActorName, what)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorFactionMembership(terminalWidth int, actorID uuid.UUID, actorName, verb, faction string) []byte {
+	var out string
+	if uuid.Equal(actorID, gh.actor.ID()) {
+		out = fmt.Sprintf("You %s %s.\n", verb, faction)
+	} else {
+		out = fmt.Sprintf("%s %ss %s.\n", actorName, verb, faction)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorReputation(terminalWidth int, e *core.ActorReputationEvent) []byte {
+	// only the Actor itself hears about its own reputation
+	if !uuid.Equal(e.ActorID, gh.actor.ID()) {
+		return nil
+	}
+	direction := "rises"
+	delta := e.Delta
+	if delta < 0 {
+		direction = "falls"
+		delta = -delta
+	}
+	out := fmt.Sprintf(
+		"Your reputation with %s %s by %d, to %d (%s).\n",
+		e.Faction,
+		direction,
+		delta,
+		e.Reputation,
+		e.Standing,
+	)
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorQuestAccept(terminalWidth int, e *core.ActorQuestAcceptEvent) []byte {
+	if !uuid.Equal(e.ActorID, gh.actor.ID()) {
+		return nil
+	}
+	out := fmt.Sprintf("You accept the quest %q.\n", e.Quest)
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorQuestProgress(terminalWidth int, e *core.ActorQuestProgressEvent) []byte {
+	if !uuid.Equal(e.ActorID, gh.actor.ID()) {
+		return nil
+	}
+	objective := fmt.Sprintf("objective %d", e.Objective+1)
+	if quest, found := core.QuestByName(e.Quest); found && e.Objective < len(quest.Objectives) {
+		objective = quest.Objectives[e.Objective].Description
+	}
+	out := fmt.Sprintf("Quest %q: %s (%d/%d).\n", e.Quest, objective, e.Progress, e.Required)
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorQuestComplete(terminalWidth int, e *core.ActorQuestCompleteEvent) []byte {
+	if !uuid.Equal(e.ActorID, gh.actor.ID()) {
+		out := fmt.Sprintf("%s has completed the quest %q.\n", e.ActorName, e.Quest)
+		return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+	}
+	out := fmt.Sprintf("You have completed the quest %q!", e.Quest)
+	if e.Coins > 0 {
+		out += fmt.Sprintf(" You are rewarded with %d coins.", e.Coins)
+	}
+	return []byte(wordwrap.WrapString(out+"\n", uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorQuestAbandon(terminalWidth int, e *core.ActorQuestAbandonEvent) []byte {
+	if !uuid.Equal(e.ActorID, gh.actor.ID()) {
+		return nil
+	}
+	out := fmt.Sprintf("You abandon the quest %q.\n", e.Quest)
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorConsume(terminalWidth int, e *core.ActorConsumeEvent) []byte {
+	if !uuid.Equal(e.ActorID, gh.actor.ID()) {
+		verb := map[string]string{
+			core.ConsumeMethodEat:   "eats",
+			core.ConsumeMethodDrink: "drinks from",
+			core.ConsumeMethodUse:   "uses",
+		}[e.Method]
+		out := fmt.Sprintf("%s %s %s.\n", e.ActorName, verb, e.ObjectName)
+		return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+	}
+	verb := map[string]string{
+		core.ConsumeMethodEat:   "eat",
+		core.ConsumeMethodDrink: "drink from",
+		core.ConsumeMethodUse:   "use",
+	}[e.Method]
+	out := fmt.Sprintf("You %s %s.", verb, e.ObjectName)
+	if e.UsesLeft == 0 {
+		out += " There's nothing left of it."
+	}
+	return []byte(wordwrap.WrapString(out+"\n", uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorEffect(terminalWidth int, e *core.ActorEffectEvent) []byte {
+	if !uuid.Equal(e.ActorID, gh.actor.ID()) {
+		return nil
+	}
+	var out string
+	switch {
+	case uuid.Equal(e.EffectID, uuid.Nil):
+		out = fmt.Sprintf("You feel restored. (+%d %s)\n", e.Delta, e.Attribute)
+	case e.Delta < 0:
+		out = fmt.Sprintf("Being %s takes its toll. (%d %s)\n", e.Name, e.Delta, e.Attribute)
+	case e.Delta > 0:
+		out = fmt.Sprintf("Being %s does you good. (+%d %s)\n", e.Name, e.Delta, e.Attribute)
+	default:
+		return nil
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorEffectStart(terminalWidth int, e *core.ActorEffectStartEvent) []byte {
+	effect := e.Effect.Effect
+	if !uuid.Equal(e.ActorID, gh.actor.ID()) {
+		out := fmt.Sprintf("%s is now %s.\n", e.ActorName, effect.Name)
+		return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+	}
+	out := fmt.Sprintf("You are now %s", effect.Name)
+	if effect.Source != "" {
+		out += fmt.Sprintf(", from %s", effect.Source)
+	}
+	if effect.Duration >= time.Minute {
+		out += fmt.Sprintf(", for %s", minutesPhrase(effect.Duration))
+	}
+	return []byte(wordwrap.WrapString(out+".\n", uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventActorEffectEnd(terminalWidth int, e *core.ActorEffectEndEvent) []byte {
+	// effects replaced by others, or ended by dying, go without saying
+	if !uuid.Equal(e.ActorID, gh.actor.ID()) || e.Reason != core.EffectEndReasonExpired {
+		return nil
+	}
+	out := fmt.Sprintf("You are no longer %s.\n", e.Name)
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventCrimeCommit(terminalWidth int, e *core.CrimeCommitEvent) []byte {
+	crime := e.Crime
+	var out string
+	switch {
+	case uuid.Equal(crime.OffenderID, gh.actor.ID()):
+		if len(crime.KnownBy) == 0 {
+			out = fmt.Sprintf("That was %s, and a crime here, but nobody saw it.\n", crime.Type)
+		} else {
+			out = fmt.Sprintf("That was %s, and a crime here; you are now wanted by the law.\n", crime.Type)
+		}
+	case uuid.Equal(crime.VictimID, gh.actor.ID()):
+		out = fmt.Sprintf("%s is now wanted for %s against you.\n", crime.OffenderName, crime.Type)
+	case crime.IsKnownBy(gh.actor.ID()):
+		out = fmt.Sprintf("You witness %s commit %s against %s.\n", crime.OffenderName, crime.Type, crime.VictimName)
+	default:
+		return nil
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventCrimeReport(terminalWidth int, e *core.CrimeReportEvent) []byte {
+	var out string
+	switch {
+	case uuid.Equal(e.ReporterID, gh.actor.ID()):
+		out = fmt.Sprintf("You tell %s that %s is wanted for %s.\n", e.ListenerName, e.OffenderName, e.CrimeType)
+	case uuid.Equal(e.ListenerID, gh.actor.ID()):
+		out = fmt.Sprintf("%s tells you that %s is wanted for %s.\n", e.ReporterName, e.OffenderName, e.CrimeType)
+	default:
+		out = fmt.Sprintf("%s speaks quietly with %s.\n", e.ReporterName, e.ListenerName)
+	}
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+func (gh *gameHandler) handleEventCrimeResolve(terminalWidth int, e *core.CrimeResolveEvent) []byte {
+	offender := e.OffenderName
+	if uuid.Equal(e.OffenderID, gh.actor.ID()) {
+		offender = "you"
+	}
+
+	var verb, what string
+	switch e.Resolution {
+	case core.CrimeResolutionJailed:
+		verb, what = "send", fmt.Sprintf("%s to jail", offender)
+	case core.CrimeResolutionFined:
+		verb, what = "fine", fmt.Sprintf("%s %d coins", offender, e.Fine)
+	case core.CrimeResolutionExecuted:
+		verb, what = "put", fmt.Sprintf("%s to death", offender)
+	default:
+		verb, what = "pardon", offender
+	}
+	enforcer := "You"
+	if !uuid.Equal(e.EnforcerID, gh.actor.ID()) {
+		enforcer = e.EnforcerName
+		verb += "s"
+	}
+	out := fmt.Sprintf("%s %s %s for %s.\n", enforcer, verb, what, e.CrimeType)
+	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
+}
+
+// minutesPhrase describes a duration in whole minutes, e.g. "5 minutes".
+func (gh *gameHandler) handleEventObjectOwnership(terminalWidth int, e *core.ObjectOwnershipEvent) []byte {
+	// changes of hands are narrated by the moves that caused them; only the
+	// Actor doing the taking is told what it means
+	if !uuid.Equal(e.Record.ActorID, gh.actor.ID()) {
+		return nil
+	}
+	var out string
+	switch e.Record.Reason {
+	case core.OwnershipReasonStolen:
+		out = fmt.Sprintf("%s belongs to %s; taking it makes it stolen goods.\n", e.ObjectName, e.Record.OwnerName)
+	case core.OwnershipReasonRecovered:
+		out = fmt.Sprintf("You've got %s back, and it's yours again.\n", e.ObjectName)
+	default:
+		return nil
+	}
+	return []byte(wordwrap.WrapString(strings.ToUpper(out[:1])+out[1:], uint(terminalWidth)))
+}
+
+func minutesPhrase(d time.Duration) string {
+	minutes := int((d + time.Minute/2) / time.Minute)
+	if minutes == 1 {
+		return "1 minute"
+	}
+	return fmt.Sprintf("%d minutes", minutes)
+}
+
+// tradeOfferSummary describes one side of a trade, e.g. "a sword, a bag and
+// 10 coins".
+func tradeOfferSummary(objectNames []string, coins int) string {
+	items := append([]string{}, objectNames...)
+	if coins > 0 {
+		items = append(items, fmt.Sprintf("%d coins", coins))
+	}
+	switch len(items) {
+	case 0:
+		return "nothing"
+	case 1:
+		return items[0]
+	default:
+		return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+	}
+}
+
+func (gh *gameHandler) handleEventActorSpeak(terminalWidth int, e *core.ActorSpeakEvent) []byte {
+	var preamble string
+	if uuid.Equal(e.ActorID, gh.actor.ID()) {
+		preamble = "You say"
+	} else {
+		preamble = fmt.Sprintf("%s says", e.ActorName)
+	}
+	line := fmt.Sprintf("%s %q.\n", preamble, e.Speech)
+	out := wordwrap.WrapString(line, uint(terminalWidth))
+	return []byte(out)
+}
+
+func (gh *gameHandler) getTakeHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if len(params) == 0 {
+			return []byte("Usage: take <object keyword>\n"), nil
+		}
+
+		// Decide where we're taking the object from
+		var container core.Container
+		if len(params) == 1 {
+			container = gh.actor.Location() // default to the ground
+		} else {
+			contKeyword := strings.ToLower(params[1])
+
+			// first check inventory
+			contObj := keywordObjectMatch(contKeyword, gh.actor.Objects())
+			if contObj != nil {
+				container = contObj
+				goto foundContainer
+			}
+
+			// if that fails, check containers on the ground
+			contObj = keywordObjectMatch(contKeyword, gh.actor.Location().Objects())
+			if contObj != nil {
+				container = contObj
+				goto foundContainer
+			}
+
+			return []byte(fmt.Sprintf("Take from where? I can't find a %q.\n", contKeyword)), nil
+		}
+	foundContainer:
+
+		// Decide which object we're taking
+		targetKeyword := strings.ToLower(params[0])
+		var targetObj *core.Object
+		targetObj = keywordObjectMatch(targetKeyword, container.Objects())
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("Take what again? I can't find a %q.\n", targetKeyword)), nil
+		}
+
+		//if len(gh.actor.Objects()) >= gh.actor.Capacity() {
+		//	return []byte("You have no room for that in your inventory!\n"), nil
+		//}
+		if !targetObj.IsPortable() {
+			return []byte("You can't carry that.\n"), nil
+		}
+		if msg := gh.handsCannotHold(targetObj); msg != "" {
+			return []byte(msg), nil
+		}
+
+		err := targetObj.Move(container, gh.actor, gh.actor, core.ContainerDefaultSubcontainer)
+		if err == core.ErrObjectLootRightsReserved {
+			return []byte("That isn't yours to take, at least not yet.\n"), nil
+		}
+		if err == core.ErrContainerClosed {
+			return []byte("It's closed.\n"), nil
+		}
+		if err == core.ErrInventoryTooHeavy {
+			return []byte("That's too heavy for you to carry, on top of everything else.\n"), nil
+		}
+		if err == core.ErrActorIsGhost {
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		}
+		if err != nil {
+			return []byte("Whoops...\n"), fmt.Errorf("Object.Move(Container, Actor): %s", err)
+		}
+
+		return nil, nil
+	}
+}
+
+func (gh *gameHandler) getLootHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if len(params) == 0 || params[0] == "" {
+			return []byte("Usage: loot <container keyword>\n"), nil
+		}
+
+		contKeyword := strings.ToLower(params[0])
+		contObj := keywordObjectMatch(contKeyword, gh.actor.Location().Objects())
+		if contObj == nil {
+			return []byte(fmt.Sprintf("Loot what again? I can't find a %q.\n", contKeyword)), nil
+		}
+		if !contObj.CanBeLootedBy(gh.actor) {
+			return []byte("That isn't yours to loot, at least not yet.\n"), nil
+		}
+
+		objs := contObj.Objects()
+		if len(objs) == 0 {
+			return []byte(fmt.Sprintf("There's nothing in %s.\n", contObj.Name())), nil
+		}
+
+		var taken int
+		for _, obj := range objs {
+			if gh.handsCannotHold(obj) != "" {
+				break
+			}
+			err := obj.Move(contObj, gh.actor, gh.actor, core.ContainerDefaultSubcontainer)
+			if err == core.ErrObjectLootRightsReserved {
+				return []byte("That isn't yours to loot, at least not yet.\n"), nil
+			}
+			if err == core.ErrContainerClosed {
+				return []byte("It's closed.\n"), nil
+			}
+			if err == core.ErrActorIsGhost {
+				return []byte(commands.ErrorActorIsGhost + "\n"), nil
+			}
+			if err != nil {
+				return []byte("Whoops...\n"), fmt.Errorf("Object.Move(Container, Actor): %s", err)
+			}
+			taken++
+		}
+		if taken < len(objs) {
+			return []byte("Your hands are full; you leave the rest behind.\n"), nil
+		}
+
+		return nil, nil
+	}
+}
+
+// handsCannotHold returns a message explaining why the Actor can't hold the
+// given Object in its hands, or the empty string if it can.
+func (gh *gameHandler) handsCannotHold(obj *core.Object) string {
+	handCapacity, handMaxItems := gh.actor.Inventory().CapacityBySubcontainer(core.InventoryContainerHands)
+	handObjs := gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands)
+	if len(handObjs) >= handMaxItems {
+		return "You don't have enough hands to hold that.\n"
+	}
+	var handBurden int
+	for _, handObj := range handObjs {
+		handBurden += handObj.InventorySlots()
+	}
+	if handBurden+obj.InventorySlots() > handCapacity {
+		return "You're carrying too much in your hands to carry another thing.\n"
+	}
+	inv := gh.actor.Inventory()
+	if inv.Weight()+obj.Weight() > inv.CarryLimit() {
+		return "That's too heavy for you to carry, on top of everything else.\n"
+	}
+	return ""
+}
+
+func (gh *gameHandler) getDropHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if len(params) == 0 {
+			return []byte("Usage: drop <object keyword>\n"), nil
+		}
+
+		targetKeyword := strings.ToLower(params[0])
+		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands))
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("Drop what again? I can't find a %q.\n", targetKeyword)), nil
+		}
+
+		err := targetObj.Move(gh.actor, gh.actor.Location(), gh.actor, core.ContainerDefaultSubcontainer)
+		if err == core.ErrObjectLockedInTrade {
+			return []byte("That's on offer in a trade; cancel the trade first.\n"), nil
+		}
+		if err != nil {
+			return []byte("Whoops...\n"), fmt.Errorf("Object.Move(Actor, Location): %s", err)
+		}
+
+		return nil, nil
+	}
+}
+
+func (gh *gameHandler) getPutHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if len(params) <= 1 {
+			return []byte("Usage: put <object keyword> <container keyword>\n"), nil
+		}
+
+		// Decide which object we're putting
+		targetKeyword := strings.ToLower(params[0])
+		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands))
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("Put what, exactly? There's no %q in your inventory.\n", targetKeyword)), nil
+		}
+
+		// Decide where we're putting it
 		var container core.Container
 		contKeyword := strings.ToLower(params[1])
 		// first check inventory
@@ -563,96 +1471,1365 @@ func (gh *gameHandler) getPutHandler() gameHandlerCommandHandler {
 			container = contObj
 			goto foundContainer
 		}
-		return []byte(fmt.Sprintf("Put it where, exactly? I can't find a %q container.\n", contKeyword)), nil
-	foundContainer:
+		return []byte(fmt.Sprintf("Put it where, exactly? I can't find a %q container.\n", contKeyword)), nil
+	foundContainer:
+
+		err := targetObj.Move(targetObj.Container(), container, gh.actor, core.ContainerDefaultSubcontainer)
+		switch err {
+		case nil:
+		case core.ErrObjectNotContainer:
+			return []byte("That isn't a container.\n"), nil
+		case core.ErrContainerClosed:
+			return []byte("It's closed.\n"), nil
+		case core.ErrContainerFull:
+			return []byte("That container can't hold any more.\n"), nil
+		case core.ErrContainerInsideItself:
+			return []byte("You can't put something inside itself.\n"), nil
+		case core.ErrObjectLockedInTrade:
+			return []byte("That's on offer in a trade; cancel the trade first.\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops...\n"), fmt.Errorf("Object.Move(Actor, Container): %s", err)
+		}
+
+		return nil, nil
+	}
+}
+
+func (gh *gameHandler) getSheetHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		sheet := commands.CharacterSheet(gh.actor)
+
+		out := fmt.Sprintf("Character sheet for %s\n", sheet.Name)
+		out += fmt.Sprintf("Base attributes (total cap %d):\n", sheet.TotalBaseCap)
+		for _, trait := range sheet.BaseAttributes {
+			out += fmt.Sprintf("  %-20s %4.0f / %-4.0f\n", trait.Name, trait.Value, trait.Cap)
+		}
+		out += "Derived attributes:\n"
+		for _, trait := range sheet.DerivedAttributes {
+			out += fmt.Sprintf("  %-20s %4.0f / %-4.0f\n", trait.Name, trait.Value, trait.Cap)
+		}
+		out += fmt.Sprintf("  %-20s %4.1f / %-4.1f\n", "Carried weight", sheet.CarriedWeight, sheet.CarryLimit)
+		out += "Skills:\n"
+		for _, trait := range sheet.Skills {
+			// skills the Actor can never learn aren't worth mentioning
+			if trait.Value == 0 && trait.Cap == 0 {
+				continue
+			}
+			out += fmt.Sprintf("  %-20s %6.1f / %-6.1f\n", trait.Name, trait.Value, trait.Cap)
+		}
+		if len(sheet.KnownReactions) > 0 {
+			out += fmt.Sprintf("Reactions known: %s\n", strings.Join(sheet.KnownReactions, ", "))
+		}
+
+		if len(sheet.RecentGains) == 0 {
+			out += "You haven't made any progress lately.\n"
+			return []byte(out), nil
+		}
+		out += "Recent progress:\n"
+		for _, gain := range sheet.RecentGains {
+			how := gain.Reason
+			if gain.Detail != "" {
+				how += " " + gain.Detail
+			}
+			out += fmt.Sprintf(
+				"  %s %.1f -> %.1f (%s), %s ago\n",
+				gain.Trait,
+				gain.From,
+				gain.To,
+				how,
+				minutesPhrase(time.Since(gain.At)),
+			)
+		}
+		return []byte(out), nil
+	}
+}
+
+func (gh *gameHandler) getInventoryHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		var objNames []string
+		for _, obj := range gh.actor.Objects() {
+			objNames = append(objNames, fmt.Sprintf("%s (%.1f)%s", obj.Name(), obj.Weight(), closedSuffix(obj)))
+			objNames = append(objNames, containedObjectLines(obj, "  ")...)
+		}
+
+		inv := gh.actor.Inventory()
+		burden := fmt.Sprintf("You have %d coins.\nCarrying %.1f of %.1f", gh.actor.Coins(), inv.Weight(), inv.CarryLimit())
+		switch encumbrance := gh.actor.Encumbrance(); {
+		case encumbrance >= 1:
+			burden += ", and you can't carry another thing."
+		case encumbrance > core.EncumbranceThreshold:
+			burden += ", and the weight is slowing you down."
+		default:
+			burden += "."
+		}
+
+		return []byte(fmt.Sprintf("Inventory contents:\n%s\n%s\n\n", strings.Join(objNames, "\n"), burden)), nil
+	}
+}
+
+func (gh *gameHandler) getReputationHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		info := commands.DescribeReputation(gh.actor)
+		if len(info.Standings) == 0 {
+			return []byte("There are no factions to have a reputation with.\n"), nil
+		}
+
+		out := "Your reputation with each faction:\n"
+		for _, standing := range info.Standings {
+			out += fmt.Sprintf("  %s: %d (%s)\n", standing.Faction, standing.Reputation, standing.Standing)
+		}
+		if len(info.Factions) > 0 {
+			out += fmt.Sprintf("You are a member of %s.\n", strings.Join(info.Factions, ", "))
+		} else {
+			out += "You are not a member of any faction.\n"
+		}
+		return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
+	}
+}
+
+func (gh *gameHandler) getQuestsHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		info := commands.DescribeQuests(gh.actor)
+		if len(info.Quests) == 0 {
+			return []byte("There are no quests to be had.\n"), nil
+		}
+
+		out := "Quests:\n"
+		for _, quest := range info.Quests {
+			status := quest.Status
+			if quest.Status == commands.QuestStatusActive {
+				var done int
+				for _, objective := range quest.Objectives {
+					if objective.Progress >= objective.Required {
+						done++
+					}
+				}
+				status = fmt.Sprintf("%d of %d objectives met", done, len(quest.Objectives))
+			}
+			out += fmt.Sprintf("  %s (%s)\n", quest.Name, status)
+		}
+		out += "Type \"quest <name>\" for details, \"quest accept <name>\" or \"quest abandon <name>\".\n"
+		return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
+	}
+}
+
+func (gh *gameHandler) getQuestHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.SplitN(line, " ", 2)
+		if len(params) < 1 || params[0] == "" {
+			return []byte("Usage: quest [accept|abandon] <name>\n"), nil
+		}
+
+		action := strings.ToLower(params[0])
+		if (action == "accept" || action == "abandon") && len(params) == 2 {
+			name := questNameMatch(params[1])
+			if name == "" {
+				return []byte("There's no such quest.\n"), nil
+			}
+			var err error
+			if action == "accept" {
+				err = gh.actor.AcceptQuest(name)
+			} else {
+				err = gh.actor.AbandonQuest(name)
+			}
+			switch err {
+			case nil:
+				// the events tell the tale
+				return nil, nil
+			case core.ErrQuestAlreadyActive:
+				return []byte("You're already on that quest.\n"), nil
+			case core.ErrQuestAlreadyComplete:
+				return []byte("You've already completed that quest.\n"), nil
+			case core.ErrQuestNotActive:
+				return []byte("You're not on that quest.\n"), nil
+			case core.ErrQuestGiverNotHere:
+				quest, _ := core.QuestByName(name)
+				return []byte(fmt.Sprintf("You'll have to find %s to take on that quest.\n", quest.Giver)), nil
+			default:
+				return nil, err
+			}
+		}
+
+		name := questNameMatch(line)
+		if name == "" {
+			return []byte("There's no such quest.\n"), nil
+		}
+		quest, err := commands.DescribeQuest(gh.actor, name)
+		if err != nil {
+			return nil, err
+		}
+
+		out := fmt.Sprintf("%s (%s)\n%s\n", quest.Name, quest.Status, quest.Description)
+		if quest.Giver != "" {
+			out += fmt.Sprintf("Given by %s.\n", quest.Giver)
+		}
+		out += "Objectives:\n"
+		for _, objective := range quest.Objectives {
+			out += fmt.Sprintf("  %s (%d/%d)\n", objective.Description, objective.Progress, objective.Required)
+		}
+		var rewards []string
+		if quest.Coins > 0 {
+			rewards = append(rewards, fmt.Sprintf("%d coins", quest.Coins))
+		}
+		factionNames := make([]string, 0, len(quest.Reputation))
+		for faction := range quest.Reputation {
+			factionNames = append(factionNames, faction)
+		}
+		sort.Strings(factionNames)
+		for _, faction := range factionNames {
+			rewards = append(rewards, fmt.Sprintf("%+d reputation with %s", quest.Reputation[faction], faction))
+		}
+		if len(rewards) > 0 {
+			out += fmt.Sprintf("Rewards: %s.\n", strings.Join(rewards, ", "))
+		}
+		if quest.Repeatable {
+			out += "This quest can be repeated.\n"
+		}
+		return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
+	}
+}
+
+// questNameMatch finds the quest whose name starts with the given text,
+// ignoring case.
+func questNameMatch(partial string) string {
+	partial = strings.ToLower(strings.TrimSpace(partial))
+	if partial == "" {
+		return ""
+	}
+	for _, quest := range core.Quests() {
+		if strings.HasPrefix(strings.ToLower(quest.Name), partial) {
+			return quest.Name
+		}
+	}
+	return ""
+}
+
+func (gh *gameHandler) getCrimesHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		info := commands.DescribeCrimes(gh.actor)
+
+		var out string
+		if info.Lawful {
+			out = "The law holds sway here.\n"
+		} else {
+			out = "There is no law here.\n"
+		}
+		if info.Wanted {
+			out += "You are wanted by the law.\n"
+		}
+		if len(info.Crimes) == 0 {
+			out += "You know of no crimes here.\n"
+			return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
+		}
+
+		out += "Crimes you know of:\n"
+		for _, crime := range info.Crimes {
+			status := "unpunished"
+			switch crime.Resolution {
+			case core.CrimeResolutionJailed:
+				status = "jailed"
+			case core.CrimeResolutionFined:
+				status = fmt.Sprintf("fined %d coins", crime.Fine)
+			case core.CrimeResolutionExecuted:
+				status = "executed"
+			case core.CrimeResolutionPardoned:
+				status = "pardoned"
+			}
+			out += fmt.Sprintf(
+				"  %s committed %s against %s, %s ago (%s)\n",
+				crime.OffenderName,
+				crime.Type,
+				crime.VictimName,
+				minutesPhrase(time.Since(crime.CommittedAt)),
+				status,
+			)
+		}
+		return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
+	}
+}
+
+func (gh *gameHandler) getTargetHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if len(params) < 1 {
+			return []byte("Usage: target <target keyword>\n"), nil
+		}
+
+		// Decide which actor we're targeting
+		targetName := strings.ToLower(params[0])
+		targetActor := nameActorMatch(targetName, gh.actor.Location().Actors())
+		if targetActor == nil {
+			return []byte(fmt.Sprintf("Target who, exactly? There's no %q here.\n", targetName)), nil
+		}
+
+		gh.targetID = targetActor.ID()
+		return nil, nil
+	}
+}
+
+func (gh *gameHandler) getSlashHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		var targetActor *core.Actor
+		for _, a := range gh.actor.Location().Actors() {
+			if uuid.Equal(a.ID(), gh.targetID) {
+				targetActor = a
+				break
+			}
+		}
+		if targetActor == nil {
+			return []byte("Target doesn't seem to be in this location...\n"), nil
+		}
+
+		err := gh.actor.Slash(targetActor)
+		if err == core.ErrActorNotReady {
+			return []byte(commands.ErrorActorNotReady + "\n"), nil
+		}
+		if err == core.ErrActorIsGhost {
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		}
+		if err != nil {
+			return []byte("Whoops..."), fmt.Errorf("Actor.Slash(): %s", err)
+		}
+
+		return nil, nil
+	}
+}
+
+func (gh *gameHandler) getKillHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] != "" {
+			targetName := strings.ToLower(params[0])
+			targetActor := nameActorMatch(targetName, gh.actor.Location().Actors())
+			if targetActor == nil {
+				return []byte(fmt.Sprintf("Kill who, exactly? There's no %q here.\n", targetName)), nil
+			}
+			gh.targetID = targetActor.ID()
+		}
+
+		var targetActor *core.Actor
+		for _, a := range gh.actor.Location().Actors() {
+			if uuid.Equal(a.ID(), gh.targetID) {
+				targetActor = a
+				break
+			}
+		}
+		if targetActor == nil {
+			return []byte("Target doesn't seem to be in this location...\n"), nil
+		}
+		if targetActor == gh.actor {
+			return []byte("You can't fight yourself.\n"), nil
+		}
+
+		err := gh.actor.Engage(targetActor)
+		if err == core.ErrActorIsGhost {
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		}
+		if err != nil {
+			return []byte("Whoops..."), fmt.Errorf("Actor.Engage(): %s", err)
+		}
+
+		return nil, nil
+	}
+}
+
+func (gh *gameHandler) getBindHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		err := gh.actor.Bind()
+		if err != nil {
+			return []byte("Whoops..."), fmt.Errorf("Actor.Bind(): %s", err)
+		}
+		return []byte("You will return here when you die.\n"), nil
+	}
+}
+
+func (gh *gameHandler) getInvokeHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			known := gh.actor.KnownReactions()
+			if len(known) == 0 {
+				return []byte("Usage: invoke <reaction> [target]\nYou don't know any reactions.\n"), nil
+			}
+			return []byte(fmt.Sprintf("Usage: invoke <reaction> [target]\nYou know: %s\n", strings.Join(known, ", "))), nil
+		}
+		reactionName := strings.ToLower(params[0])
+		reaction, found := core.ReactionByName(reactionName)
+		if !found || !gh.actor.KnowsReaction(reactionName) {
+			return []byte(fmt.Sprintf("You don't know how to invoke %q.\n", reactionName)), nil
+		}
+
+		// with no target named, heal yourself or harm whoever you're targeting
+		var targetActor *core.Actor
+		switch {
+		case len(params) > 1:
+			targetName := strings.ToLower(params[1])
+			targetActor = nameActorMatch(targetName, gh.actor.Location().Actors())
+			if targetActor == nil {
+				return []byte(fmt.Sprintf("Invoke it on who, exactly? There's no %q here.\n", targetName)), nil
+			}
+		case reaction.Effect == core.ReactionEffectHeal:
+			targetActor = gh.actor
+		default:
+			for _, a := range gh.actor.Location().Actors() {
+				if uuid.Equal(a.ID(), gh.targetID) {
+					targetActor = a
+					break
+				}
+			}
+			if targetActor == nil {
+				return []byte("Target doesn't seem to be in this location...\n"), nil
+			}
+		}
+
+		err := gh.actor.Invoke(reactionName, targetActor)
+		switch err {
+		case nil, core.ErrReactionFailed:
+			// the outcome is narrated by the resulting SorceryInvokeEvent
+			return nil, nil
+		case core.ErrActorInsufficientFocus:
+			return []byte("You can't muster the focus for that right now.\n"), nil
+		case core.ErrActorNotReady:
+			return []byte(commands.ErrorActorNotReady + "\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.Invoke(): %s", err)
+		}
+	}
+}
+
+func (gh *gameHandler) getProvenanceHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			return []byte("Usage: provenance <object keyword>\n"), nil
+		}
+
+		// our own things first, then what's lying around, then what others
+		// are carrying
+		targetKeyword := strings.ToLower(params[0])
+		candidates := append(gh.actor.Objects(), gh.actor.Location().Objects()...)
+		for _, other := range gh.actor.Location().Actors() {
+			if other != gh.actor {
+				candidates = append(candidates, other.Objects()...)
+			}
+		}
+		targetObj := keywordObjectMatch(targetKeyword, candidates)
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("There's no %q here.\n", targetKeyword)), nil
+		}
+
+		info := commands.DescribeProvenance(targetObj)
+		var out string
+		switch {
+		case uuid.Equal(info.OwnerID, uuid.Nil):
+			out = fmt.Sprintf("%s doesn't belong to anyone.\n", info.Name)
+		case uuid.Equal(info.OwnerID, gh.actor.ID()):
+			out = fmt.Sprintf("%s belongs to you.\n", info.Name)
+		default:
+			out = fmt.Sprintf("%s belongs to %s.\n", info.Name, info.OwnerName)
+		}
+		if info.Stolen {
+			out += "It's been stolen.\n"
+		}
+		for _, record := range info.History {
+			var what string
+			switch record.Reason {
+			case core.OwnershipReasonClaimed:
+				what = fmt.Sprintf("%s picked it up", record.ActorName)
+			case core.OwnershipReasonStolen:
+				what = fmt.Sprintf("%s stole it from %s", record.ActorName, record.OwnerName)
+			case core.OwnershipReasonRecovered:
+				what = fmt.Sprintf("%s got it back", record.ActorName)
+			case core.OwnershipReasonLooted:
+				what = fmt.Sprintf("%s looted it", record.ActorName)
+			case core.OwnershipReasonGiven:
+				what = fmt.Sprintf("%s gave it to %s", record.ActorName, record.OwnerName)
+			case core.OwnershipReasonTraded:
+				what = fmt.Sprintf("%s traded it to %s", record.ActorName, record.OwnerName)
+			case core.OwnershipReasonPurchased:
+				what = fmt.Sprintf("%s sold it to %s", record.ActorName, record.OwnerName)
+			default:
+				what = fmt.Sprintf("it was %s by %s", record.Reason, record.OwnerName)
+			}
+			out += fmt.Sprintf("  %s ago, %s\n", minutesPhrase(time.Since(record.At)), what)
+		}
+		out = strings.ToUpper(out[:1]) + out[1:]
+		return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
+	}
+}
+
+func (gh *gameHandler) getReadHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			return []byte("Usage: read <object keyword>\n"), nil
+		}
+
+		targetKeyword := strings.ToLower(params[0])
+		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands))
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("Read what again? You're not holding a %q.\n", targetKeyword)), nil
+		}
+
+		err := gh.actor.ReadScroll(targetObj)
+		switch err {
+		case nil:
+			// the outcome is narrated by the resulting ActorLearnReactionEvent
+			// or ActorLearnTechniqueEvent
+			return nil, nil
+		case core.ErrObjectNotScroll:
+			return []byte("There's nothing written there worth reading.\n"), nil
+		case core.ErrReactionUnknown, core.ErrTechniqueUnknown:
+			return []byte("The writing makes no sense to you.\n"), nil
+		case core.ErrReactionAlreadyKnown, core.ErrTechniqueAlreadyKnown:
+			return []byte("You already know everything this scroll could teach you.\n"), nil
+		case core.ErrTechniqueTooAdvanced:
+			return []byte("This scroll builds on techniques you haven't learned yet.\n"), nil
+		case core.ErrTechniqueBeyondCap:
+			return []byte("You can't make sense of any more techniques like this one.\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.ReadScroll(): %s", err)
+		}
+	}
+}
+
+func (gh *gameHandler) getConsumeHandler(method string) gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			return []byte(fmt.Sprintf("Usage: %s <object keyword>\n", method)), nil
+		}
+
+		// prefer whatever's in hand, but anything within easy reach will do
+		targetKeyword := strings.ToLower(params[0])
+		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands))
+		if targetObj == nil {
+			targetObj = keywordObjectMatch(targetKeyword, gh.actor.Inventory().Objects())
+		}
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("You're not carrying a %q.\n", targetKeyword)), nil
+		}
+
+		err := gh.actor.Consume(targetObj, method)
+		switch err {
+		case nil:
+			// the outcome is narrated by the resulting ActorConsumeEvent and
+			// the events for its effects
+			return nil, nil
+		case core.ErrObjectNotHeld:
+			return []byte(fmt.Sprintf("You're not carrying a %q.\n", targetKeyword)), nil
+		case core.ErrObjectNotConsumable:
+			return []byte(fmt.Sprintf("You can't %s that.\n", method)), nil
+		case core.ErrWrongConsumeMethod:
+			return []byte(fmt.Sprintf("You can't %s that; perhaps you could use it?\n", method)), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.Consume(): %s", err)
+		}
+	}
+}
+
+func (gh *gameHandler) getScribeHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			return []byte("Usage: scribe <scroll keyword> [blank scroll keyword]\n"), nil
+		}
+
+		// keywords like "scroll" will match blanks as well as written scrolls,
+		// so look for each among the appropriate kind of scroll only
+		var written, blanks []*core.Object
+		for _, obj := range gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands) {
+			if obj.Attributes().BlankScroll {
+				blanks = append(blanks, obj)
+			} else {
+				written = append(written, obj)
+			}
+		}
 
-		if len(container.Objects()) >= container.Capacity() {
-			return []byte("That container can't hold any more.\n"), nil
+		sourceKeyword := strings.ToLower(params[0])
+		source := keywordObjectMatch(sourceKeyword, written)
+		if source == nil {
+			return []byte(fmt.Sprintf("Copy what again? You're not holding a %q.\n", sourceKeyword)), nil
+		}
+		blankKeyword := "scroll"
+		if len(params) > 1 {
+			blankKeyword = strings.ToLower(params[1])
+		}
+		blank := keywordObjectMatch(blankKeyword, blanks)
+		if blank == nil {
+			return []byte("You'll need to be holding a blank scroll to copy it onto.\n"), nil
+		}
+
+		err := gh.actor.Scribe(source, blank)
+		switch err {
+		case nil, core.ErrScribeFailed:
+			// the outcome is narrated by the resulting ActorScribeEvent
+			return nil, nil
+		case core.ErrObjectNotScroll:
+			return []byte("There's nothing written there worth copying.\n"), nil
+		case core.ErrReactionUnknown:
+			return []byte("The writing makes no sense to you.\n"), nil
+		case core.ErrActorNotReady:
+			return []byte(commands.ErrorActorNotReady + "\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.Scribe(): %s", err)
 		}
+	}
+}
 
-		err := targetObj.Move(targetObj.Container(), container, gh.actor, core.ContainerDefaultSubcontainer)
-		if err != nil {
-			return []byte("Whoops...\n"), fmt.Errorf("Object.Move(Actor, Container): %s", err)
+func (gh *gameHandler) getRecipesHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		info := commands.DescribeRecipes(gh.actor)
+		if len(info.Recipes) == 0 {
+			return []byte("You don't know how to make anything.\n"), nil
 		}
 
-		return nil, nil
+		out := "Recipes:\n"
+		for _, recipe := range info.Recipes {
+			var inputs []string
+			for _, input := range recipe.Inputs {
+				inputs = append(inputs, fmt.Sprintf("%d %s", input.Count, input.Keyword))
+			}
+			out += fmt.Sprintf("  %s (%.0f%% chance): %s from %s", recipe.Name, recipe.Chance*100, recipe.Output, strings.Join(inputs, ", "))
+			if len(recipe.Tools) > 0 {
+				out += fmt.Sprintf(", using %s", strings.Join(recipe.Tools, ", "))
+			}
+			out += "\n"
+		}
+		out += "Type \"craft <recipe>\" to make something.\n"
+		return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
 	}
 }
 
-func (gh *gameHandler) getInventoryHandler() gameHandlerCommandHandler {
+func (gh *gameHandler) getCraftHandler() gameHandlerCommandHandler {
 	return func(line string, terminalWidth int) ([]byte, error) {
-		var objNames []string
-		for _, obj := range gh.actor.Objects() {
-			objNames = append(objNames, obj.Name())
+		if strings.TrimSpace(line) == "" {
+			return []byte("Usage: craft <recipe>\n"), nil
+		}
+		name := recipeNameMatch(line)
+		if name == "" {
+			return []byte("You don't know how to make that. Type \"recipes\" to see what you can make.\n"), nil
+		}
+
+		err := gh.actor.Craft(name)
+		switch err {
+		case nil, core.ErrCraftFailed:
+			// the outcome is narrated by the resulting ActorCraftEvent
+			return nil, nil
+		case core.ErrRecipeMissingInputs:
+			return []byte("You don't have everything you need to make that.\n"), nil
+		case core.ErrRecipeMissingTools:
+			return []byte("You'll need the right tools in your hands to make that.\n"), nil
+		case core.ErrActorNotReady:
+			return []byte(commands.ErrorActorNotReady + "\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.Craft(): %s", err)
 		}
+	}
+}
 
-		return []byte(fmt.Sprintf("Inventory contents:\n%s\n\n", strings.Join(objNames, "\n"))), nil
+func recipeNameMatch(partial string) string {
+	partial = strings.ToLower(strings.TrimSpace(partial))
+	if partial == "" {
+		return ""
 	}
+	for _, recipe := range core.Recipes() {
+		if strings.HasPrefix(strings.ToLower(recipe.Name), partial) {
+			return recipe.Name
+		}
+	}
+	return ""
 }
 
-func (gh *gameHandler) getTargetHandler() gameHandlerCommandHandler {
+func (gh *gameHandler) getDoorHandler(action string) gameHandlerCommandHandler {
 	return func(line string, terminalWidth int) ([]byte, error) {
 		params := strings.Split(line, " ")
-		if len(params) < 1 {
-			return []byte("Usage: target <target keyword>\n"), nil
+		if params[0] == "" {
+			return []byte(fmt.Sprintf("Usage: %s <direction | container keyword>\n", action)), nil
 		}
 
-		// Decide which actor we're targeting
-		targetName := strings.ToLower(params[0])
+		exit := exitInDirection(gh.actor.Location(), line)
+		if exit == nil {
+			// not a door, so maybe a container
+			contObj := gh.reachableObjectMatch(strings.ToLower(params[0]))
+			if contObj != nil {
+				return gh.handleContainerAction(action, contObj)
+			}
+			if core.ValidDirections[core.ExpandDirection(strings.ToLower(params[0]))] {
+				return []byte("No exit in that direction!\n"), nil
+			}
+			return []byte(fmt.Sprintf("%s what, exactly? I can't find a %q.\n", strings.Title(action), params[0])), nil
+		}
+
+		var err error
+		switch action {
+		case core.ExitDoorActionOpen:
+			err = gh.actor.OpenExit(exit)
+		case core.ExitDoorActionClose:
+			err = gh.actor.CloseExit(exit)
+		case core.ExitDoorActionLock:
+			err = gh.actor.LockExit(exit)
+		case core.ExitDoorActionUnlock:
+			err = gh.actor.UnlockExit(exit)
+		}
+		switch err {
+		case nil:
+			// the outcome is narrated by the resulting ExitDoorEvent
+			return nil, nil
+		case core.ErrExitNoDoor:
+			return []byte("There's no door that way.\n"), nil
+		case core.ErrExitNoLock:
+			return []byte("That door has no lock.\n"), nil
+		case core.ErrExitLocked:
+			return []byte("It's locked.\n"), nil
+		case core.ErrExitAlreadyOpen:
+			return []byte("It's already open.\n"), nil
+		case core.ErrExitAlreadyClosed:
+			return []byte("It's already closed.\n"), nil
+		case core.ErrExitAlreadyLocked:
+			return []byte("It's already locked.\n"), nil
+		case core.ErrExitNotLocked:
+			return []byte("It isn't locked.\n"), nil
+		case core.ErrExitNotClosed:
+			return []byte("You'll have to close it first.\n"), nil
+		case core.ErrActorHasNoKey:
+			return []byte("You don't have the key.\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.%sExit(): %s", strings.Title(action), err)
+		}
+	}
+}
+
+func (gh *gameHandler) handleContainerAction(action string, obj *core.Object) ([]byte, error) {
+	var err error
+	switch action {
+	case core.ExitDoorActionOpen:
+		err = gh.actor.OpenContainer(obj)
+	case core.ExitDoorActionClose:
+		err = gh.actor.CloseContainer(obj)
+	case core.ExitDoorActionLock:
+		err = gh.actor.LockContainer(obj)
+	case core.ExitDoorActionUnlock:
+		err = gh.actor.UnlockContainer(obj)
+	}
+	switch err {
+	case nil:
+		// the outcome is narrated by the resulting ObjectContainerActionEvent
+		return nil, nil
+	case core.ErrContainerNotCloseable:
+		return []byte(fmt.Sprintf("You can't %s that.\n", action)), nil
+	case core.ErrContainerNoLock:
+		return []byte("That has no lock.\n"), nil
+	case core.ErrContainerLocked:
+		return []byte("It's locked.\n"), nil
+	case core.ErrContainerAlreadyOpen:
+		return []byte("It's already open.\n"), nil
+	case core.ErrContainerAlreadyClosed:
+		return []byte("It's already closed.\n"), nil
+	case core.ErrContainerAlreadyLocked:
+		return []byte("It's already locked.\n"), nil
+	case core.ErrContainerNotLocked:
+		return []byte("It isn't locked.\n"), nil
+	case core.ErrContainerNotClosed:
+		return []byte("You'll have to close it first.\n"), nil
+	case core.ErrContainerOutOfReach:
+		return []byte("You can't reach it.\n"), nil
+	case core.ErrActorHasNoKey:
+		return []byte("You don't have the key.\n"), nil
+	case core.ErrActorIsGhost:
+		return []byte(commands.ErrorActorIsGhost + "\n"), nil
+	default:
+		return []byte("Whoops..."), fmt.Errorf("Actor.%sContainer(): %s", strings.Title(action), err)
+	}
+}
+
+func (gh *gameHandler) getGiveHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if len(params) < 2 || params[0] == "" {
+			return []byte("Usage: give <object keyword> <actor>\n"), nil
+		}
+
+		targetKeyword := strings.ToLower(params[0])
+		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Objects())
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("Give what, exactly? There's no %q in your inventory.\n", targetKeyword)), nil
+		}
+		targetName := strings.ToLower(strings.Join(params[1:], " "))
 		targetActor := nameActorMatch(targetName, gh.actor.Location().Actors())
 		if targetActor == nil {
-			return []byte(fmt.Sprintf("Target who, exactly? There's no %q here.\n", targetName)), nil
+			return []byte(fmt.Sprintf("Give it to who, exactly? There's no %q here.\n", targetName)), nil
+		}
+		if targetActor == gh.actor {
+			return []byte("You already have it.\n"), nil
+		}
+
+		err := targetObj.Move(gh.actor, targetActor, gh.actor, core.ContainerDefaultSubcontainer)
+		switch err {
+		case nil:
+			// the handover is narrated by the resulting event
+			return nil, nil
+		case core.ErrInventoryNoRoom:
+			return []byte(fmt.Sprintf("%s has no free hand to take it.\n", targetActor.Name())), nil
+		case core.ErrInventoryTooHeavy:
+			return []byte(fmt.Sprintf("That's too heavy for %s to carry.\n", targetActor.Name())), nil
+		case core.ErrObjectLockedInTrade:
+			return []byte("That's on offer in a trade; cancel the trade first.\n"), nil
+		case core.ErrActorIsGhost:
+			if gh.actor.IsGhost() {
+				return []byte(commands.ErrorActorIsGhost + "\n"), nil
+			}
+			return []byte("Your hand passes right through them.\n"), nil
+		default:
+			return []byte("Whoops...\n"), fmt.Errorf("Object.Move(Actor, Actor): %s", err)
 		}
+	}
+}
 
-		gh.targetID = targetActor.ID()
-		return nil, nil
+func (gh *gameHandler) getTradeHandler() gameHandlerCommandHandler {
+	usage := "Usage: trade [<actor> | offer <object keyword> | offer <number> coins | accept | cancel]\n"
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		var err error
+		switch strings.ToLower(params[0]) {
+		case "":
+			return gh.describeTrade(terminalWidth), nil
+		case "accept":
+			err = gh.actor.AcceptTrade()
+		case "cancel":
+			err = gh.actor.CancelTrade()
+		case "offer":
+			if len(params) < 2 || params[1] == "" {
+				return []byte(usage), nil
+			}
+			partner := gh.actor.TradingWith()
+			if partner == nil {
+				return []byte("You're not trading with anyone; use \"trade <actor>\" to start.\n"), nil
+			}
+			objects, coins := gh.actor.TradeOffer()
+			if amount, convErr := strconv.Atoi(params[1]); convErr == nil {
+				coins = amount
+			} else {
+				targetKeyword := strings.ToLower(params[1])
+				targetObj := keywordObjectMatch(targetKeyword, gh.actor.Objects())
+				if targetObj == nil {
+					return []byte(fmt.Sprintf("Offer what, exactly? There's no %q in your inventory.\n", targetKeyword)), nil
+				}
+				objects = append(objects, targetObj)
+			}
+			err = gh.actor.OfferTrade(partner, objects, coins)
+		default:
+			targetName := strings.ToLower(strings.Join(params, " "))
+			partner := nameActorMatch(targetName, gh.actor.Location().Actors())
+			if partner == nil {
+				return []byte(fmt.Sprintf("Trade with who, exactly? There's no %q here.\n", targetName)), nil
+			}
+			objects, coins := gh.actor.TradeOffer()
+			err = gh.actor.OfferTrade(partner, objects, coins)
+		}
+
+		switch err {
+		case nil:
+			// the trade is narrated by the resulting events
+			return nil, nil
+		case core.ErrTradeWithSelf:
+			return []byte("You can't trade with yourself.\n"), nil
+		case core.ErrAlreadyTrading:
+			return []byte("You're already trading with someone else; \"trade cancel\" first.\n"), nil
+		case core.ErrTradePartnerBusy:
+			return []byte("They're already trading with someone else.\n"), nil
+		case core.ErrNotTrading:
+			return []byte("You're not trading with anyone.\n"), nil
+		case core.ErrTradePartnerGone:
+			return []byte("They're not here anymore; \"trade cancel\" to call it off.\n"), nil
+		case core.ErrTradePartnerNotJoined:
+			return []byte("They haven't joined the trade yet.\n"), nil
+		case core.ErrTradeOfferCurrency:
+			return []byte("Offer coins by amount, e.g. \"trade offer 10 coins\".\n"), nil
+		case core.ErrTradeObjectNotHeld:
+			return []byte("Something on offer is no longer there to trade.\n"), nil
+		case core.ErrCannotAfford:
+			return []byte("You don't have that many coins.\n"), nil
+		case core.ErrTradePartnerCannotAfford:
+			return []byte("They don't have the coins they offered.\n"), nil
+		case core.ErrInventoryTooHeavy:
+			return []byte("You couldn't carry all that, on top of everything else.\n"), nil
+		case core.ErrInventoryNoRoom:
+			return []byte("You don't have room for all that.\n"), nil
+		case core.ErrTradePartnerNoRoom:
+			return []byte("They don't have room for all that.\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops...\n"), fmt.Errorf("trade: %s", err)
+		}
 	}
 }
 
-func (gh *gameHandler) getSlashHandler() gameHandlerCommandHandler {
+func (gh *gameHandler) describeTrade(terminalWidth int) []byte {
+	info := commands.DescribeTrade(gh.actor)
+	if uuid.Equal(info.PartnerID, uuid.Nil) {
+		return []byte("You're not trading with anyone.\n")
+	}
+	summary := func(offer commands.TradeOfferInfo) string {
+		var names []string
+		for _, obj := range offer.Objects {
+			names = append(names, obj.Name)
+		}
+		out := tradeOfferSummary(names, offer.Coins)
+		if offer.Accepted {
+			out += " (accepted)"
+		}
+		return out
+	}
+	lines := []string{
+		fmt.Sprintf("You are trading with %s.", info.PartnerName),
+		fmt.Sprintf("You offer %s.", summary(info.Mine)),
+	}
+	if info.Joined {
+		lines = append(lines, fmt.Sprintf("%s offers %s.", info.PartnerName, summary(info.Theirs)))
+	} else {
+		lines = append(lines, fmt.Sprintf("%s hasn't joined the trade yet.", info.PartnerName))
+	}
+	return []byte(wordwrap.WrapString(strings.Join(lines, "\n"), uint(terminalWidth)) + "\n")
+}
+
+func (gh *gameHandler) getHireHandler() gameHandlerCommandHandler {
 	return func(line string, terminalWidth int) ([]byte, error) {
-		var targetActor *core.Actor
-		for _, a := range gh.actor.Location().Actors() {
-			if uuid.Equal(a.ID(), gh.targetID) {
-				targetActor = a
-				break
+		if line == "" {
+			return []byte("Usage: hire <actor>\n"), nil
+		}
+		hireling := nameActorMatch(line, gh.actor.Location().Actors())
+		if hireling == nil {
+			return []byte(fmt.Sprintf("Hire who, exactly? There's no %q here.\n", line)), nil
+		}
+
+		err := gh.actor.Hire(hireling)
+		switch err {
+		case nil:
+			// the payment is narrated by the resulting event
+			return nil, nil
+		case core.ErrNotForHire:
+			return []byte(fmt.Sprintf("%s is not for hire.\n", hireling.Name())), nil
+		case core.ErrHireSelf:
+			return []byte("You already work for yourself.\n"), nil
+		case core.ErrAlreadyHired:
+			return []byte(fmt.Sprintf("%s is already working for someone else.\n", hireling.Name())), nil
+		case core.ErrHirelingNotHere:
+			return []byte(fmt.Sprintf("%s is in no state to be hired.\n", hireling.Name())), nil
+		case core.ErrHirelingNoRoom:
+			return []byte(fmt.Sprintf("%s has no room to carry their wage.\n", hireling.Name())), nil
+		case core.ErrCannotAfford:
+			wage := hireling.HireTerms().Wage
+			return []byte(fmt.Sprintf("You can't afford %s's wage of %d coins.\n", hireling.Name(), wage)), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops...\n"), fmt.Errorf("Actor.Hire(): %s", err)
+		}
+	}
+}
+
+func (gh *gameHandler) getFireHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		if line == "" {
+			return []byte("Usage: fire <hireling>\n"), nil
+		}
+		hireling := nameActorMatch(line, gh.actor.Location().Actors())
+		if hireling == nil {
+			return []byte(fmt.Sprintf("Fire who, exactly? There's no %q here.\n", line)), nil
+		}
+
+		err := gh.actor.Fire(hireling)
+		switch err {
+		case nil:
+			return nil, nil
+		case core.ErrNotEmployer:
+			return []byte(fmt.Sprintf("%s doesn't work for you.\n", hireling.Name())), nil
+		default:
+			return []byte("Whoops...\n"), fmt.Errorf("Actor.Fire(): %s", err)
+		}
+	}
+}
+
+func (gh *gameHandler) getOrderHandler() gameHandlerCommandHandler {
+	usage := "Usage: order <hireling> <attack <actor> | guard <actor> | stand down | stay | follow | report>\n"
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if len(params) < 2 || params[0] == "" {
+			return []byte(usage), nil
+		}
+		hireling := nameActorMatch(params[0], gh.actor.Location().Actors())
+		if hireling == nil {
+			return []byte(fmt.Sprintf("Order who, exactly? There's no %q here.\n", params[0])), nil
+		}
+
+		var order string
+		switch strings.ToLower(params[1]) {
+		case "attack", "kill":
+			order = core.HirelingOrderAttack
+		case "guard", "protect":
+			order = core.HirelingOrderGuard
+		case "stand", "standdown", "stand-down", "stop":
+			order = core.HirelingOrderStandDown
+		case "stay":
+			order = core.HirelingOrderStay
+		case "follow":
+			order = core.HirelingOrderFollow
+		case "report", "status":
+			order = core.HirelingOrderReport
+		default:
+			return []byte(usage), nil
+		}
+
+		var target *core.Actor
+		if order == core.HirelingOrderAttack || order == core.HirelingOrderGuard {
+			targetName := strings.ToLower(strings.Join(params[2:], " "))
+			switch targetName {
+			case "":
+				return []byte(usage), nil
+			case "me", "self":
+				target = gh.actor
+			default:
+				target = nameActorMatch(targetName, gh.actor.Location().Actors())
+			}
+			if target == nil {
+				return []byte(fmt.Sprintf("There's no %q here.\n", targetName)), nil
 			}
 		}
-		if targetActor == nil {
-			return []byte("Target doesn't seem to be in this location...\n"), nil
+
+		err := gh.actor.Order(hireling, order, target)
+		switch err {
+		case nil:
+			// the order is narrated by the resulting event
+			return nil, nil
+		case core.ErrNotEmployer:
+			return []byte(fmt.Sprintf("%s doesn't work for you.\n", hireling.Name())), nil
+		case core.ErrHirelingOrderSelf:
+			return []byte(fmt.Sprintf("%s won't turn on themselves.\n", hireling.Name())), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops...\n"), fmt.Errorf("Actor.Order(): %s", err)
 		}
+	}
+}
 
-		err := gh.actor.Slash(targetActor)
-		if err != nil {
-			return []byte("Whoops..."), fmt.Errorf("Actor.Slash(): %s", err)
+func (gh *gameHandler) getListHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		shopkeeper := commands.FindShopkeeper(gh.actor.Location())
+		if shopkeeper == nil {
+			return []byte("There's no one here to trade with.\n"), nil
+		}
+		info := commands.ListShop(shopkeeper)
+		if len(info.Items) == 0 {
+			return []byte(fmt.Sprintf("%s has nothing for sale.\n", info.Name)), nil
+		}
+		lines := []string{fmt.Sprintf("%s has for sale:", info.Name)}
+		for _, item := range info.Items {
+			lines = append(lines, fmt.Sprintf("  %-40s %5d coins", item.Name, item.Price))
 		}
+		return []byte(strings.Join(lines, "\n") + "\n"), nil
+	}
+}
 
-		return nil, nil
+func (gh *gameHandler) getBuyHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			return []byte("Usage: buy <object keyword>\n"), nil
+		}
+		shopkeeper := commands.FindShopkeeper(gh.actor.Location())
+		if shopkeeper == nil {
+			return []byte("There's no one here to buy from.\n"), nil
+		}
+
+		targetKeyword := strings.ToLower(params[0])
+		var forSale []*core.Object
+		for _, obj := range shopkeeper.Inventory().Objects() {
+			if !obj.IsCurrency() {
+				forSale = append(forSale, obj)
+			}
+		}
+		targetObj := keywordObjectMatch(targetKeyword, forSale)
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("%s has no %q for sale.\n", shopkeeper.Name(), targetKeyword)), nil
+		}
+		if msg := gh.handsCannotHold(targetObj); msg != "" {
+			return []byte(msg), nil
+		}
+
+		err := gh.actor.Buy(shopkeeper, targetObj)
+		switch err {
+		case nil:
+			// the trade is narrated by the resulting events
+			return nil, nil
+		case core.ErrCannotAfford:
+			return []byte("You can't afford that.\n"), nil
+		case core.ErrShopNotForSale:
+			return []byte("That isn't for sale.\n"), nil
+		case core.ErrInventoryTooHeavy:
+			return []byte("That's too heavy for you to carry, on top of everything else.\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops...\n"), fmt.Errorf("Actor.Buy(): %s", err)
+		}
 	}
 }
 
-func (gh *gameHandler) getKillHandler() gameHandlerCommandHandler {
+func (gh *gameHandler) getSellHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			return []byte("Usage: sell <object keyword>\n"), nil
+		}
+		shopkeeper := commands.FindShopkeeper(gh.actor.Location())
+		if shopkeeper == nil {
+			return []byte("There's no one here to sell to.\n"), nil
+		}
+
+		targetKeyword := strings.ToLower(params[0])
+		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Objects())
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("Sell what, exactly? There's no %q in your inventory.\n", targetKeyword)), nil
+		}
+
+		err := gh.actor.Sell(shopkeeper, targetObj)
+		switch err {
+		case nil:
+			// the trade is narrated by the resulting events
+			return nil, nil
+		case core.ErrShopWillNotBuy:
+			return []byte(fmt.Sprintf("%s isn't interested in that.\n", shopkeeper.Name())), nil
+		case core.ErrShopWillNotBuyStolen:
+			return []byte(fmt.Sprintf("%s won't touch stolen goods.\n", shopkeeper.Name())), nil
+		case core.ErrObjectWorthless:
+			return []byte(fmt.Sprintf("%s won't give you anything for that.\n", shopkeeper.Name())), nil
+		case core.ErrShopContainerNotEmpty:
+			return []byte("You'll have to empty it first.\n"), nil
+		case core.ErrShopCannotAfford:
+			return []byte(fmt.Sprintf("%s can't afford to buy that.\n", shopkeeper.Name())), nil
+		case core.ErrShopNoRoom:
+			return []byte(fmt.Sprintf("%s has no room for that.\n", shopkeeper.Name())), nil
+		case core.ErrObjectLockedInTrade:
+			return []byte("That's on offer in a trade; cancel the trade first.\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops...\n"), fmt.Errorf("Actor.Sell(): %s", err)
+		}
+	}
+}
+
+func (gh *gameHandler) getValueHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			return []byte("Usage: value <object keyword>\n"), nil
+		}
+		shopkeeper := commands.FindShopkeeper(gh.actor.Location())
+		if shopkeeper == nil {
+			return []byte("There's no one here to value that.\n"), nil
+		}
+
+		targetKeyword := strings.ToLower(params[0])
+		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Objects())
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("Value what, exactly? There's no %q in your inventory.\n", targetKeyword)), nil
+		}
+
+		info := commands.ValueObject(shopkeeper, targetObj)
+		if info.Stolen {
+			return []byte(fmt.Sprintf("%s won't touch %s; it's stolen.\n", shopkeeper.Name(), targetObj.Name())), nil
+		}
+		if !info.WillBuy {
+			return []byte(fmt.Sprintf("%s isn't interested in %s.\n", shopkeeper.Name(), targetObj.Name())), nil
+		}
+		return []byte(fmt.Sprintf("%s would pay %d coins for %s.\n", shopkeeper.Name(), info.Price, targetObj.Name())), nil
+	}
+}
+
+func (gh *gameHandler) getDedicateHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		pantheon := gh.actor.Zone().World().Pantheon()
+		if pantheon == nil {
+			return []byte("There are no gods here to hear you.\n"), nil
+		}
+
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			out := "Usage: dedicate <deity>\nThe known deities are:\n"
+			for _, d := range pantheon.Deities() {
+				out += fmt.Sprintf("  %s - %s\n", d.Name(), d.Description())
+			}
+			return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
+		}
+
+		deity := pantheon.DeityByName(params[0])
+		if deity == nil {
+			return []byte(fmt.Sprintf("You've never heard of a god called %q.\n", params[0])), nil
+		}
+
+		err := gh.actor.Dedicate(deity)
+		switch err {
+		case nil:
+			// the outcome is narrated by the resulting ActorDedicateEvent
+			return nil, nil
+		case core.ErrActorAlreadyDedicated:
+			return []byte(fmt.Sprintf("You're already a follower of %s.\n", deity.Name())), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.Dedicate(): %s", err)
+		}
+	}
+}
+
+func (gh *gameHandler) getSacrificeHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			return []byte("Usage: sacrifice <object keyword>\n"), nil
+		}
+
+		// prefer something in hand, but anything on the ground will do
+		targetKeyword := strings.ToLower(params[0])
+		targetObj := keywordObjectMatch(targetKeyword, gh.actor.Inventory().ObjectsBySubcontainer(core.InventoryContainerHands))
+		if targetObj == nil {
+			targetObj = keywordObjectMatch(targetKeyword, gh.actor.Location().Objects())
+		}
+		if targetObj == nil {
+			return []byte(fmt.Sprintf("Sacrifice what again? I can't find a %q.\n", targetKeyword)), nil
+		}
+
+		err := gh.actor.Sacrifice(targetObj)
+		switch err {
+		case nil:
+			// the outcome is narrated by the resulting ActorSacrificeEvent
+			return nil, nil
+		case core.ErrActorNotDedicated:
+			return []byte("You aren't a follower of any god.\n"), nil
+		case core.ErrObjectLootRightsReserved:
+			return []byte("That isn't yours to offer up... yet.\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.Sacrifice(): %s", err)
+		}
+	}
+}
+
+func (gh *gameHandler) getPrayHandler() gameHandlerCommandHandler {
 	return func(line string, terminalWidth int) ([]byte, error) {
+		params := strings.Split(line, " ")
+		if params[0] == "" {
+			out := "Usage: pray <prayer> [target]\n"
+			for _, p := range core.Prayers() {
+				if gh.actor.Mysticism() >= p.SkillThreshold {
+					out += fmt.Sprintf("  %s - %s\n", p.Name, p.Description)
+				}
+			}
+			return []byte(wordwrap.WrapString(out, uint(terminalWidth))), nil
+		}
+		prayerName := strings.ToLower(params[0])
+		prayer, found := core.PrayerByName(prayerName)
+		if !found {
+			return []byte(fmt.Sprintf("You don't know any prayer called %q.\n", prayerName)), nil
+		}
+
+		// with no target named, heal yourself or smite whoever you're targeting
 		var targetActor *core.Actor
-		for _, a := range gh.actor.Location().Actors() {
-			if uuid.Equal(a.ID(), gh.targetID) {
-				targetActor = a
-				break
+		switch {
+		case len(params) > 1:
+			targetName := strings.ToLower(params[1])
+			targetActor = nameActorMatch(targetName, gh.actor.Location().Actors())
+			if targetActor == nil {
+				return []byte(fmt.Sprintf("Pray for who, exactly? There's no %q here.\n", targetName)), nil
+			}
+		case prayer.Effect == core.PrayerEffectHeal:
+			targetActor = gh.actor
+		default:
+			for _, a := range gh.actor.Location().Actors() {
+				if uuid.Equal(a.ID(), gh.targetID) {
+					targetActor = a
+					break
+				}
+			}
+			if targetActor == nil {
+				return []byte("Target doesn't seem to be in this location...\n"), nil
 			}
 		}
-		if targetActor == nil {
-			return []byte("Target doesn't seem to be in this location...\n"), nil
+
+		err := gh.actor.Pray(prayerName, targetActor)
+		switch err {
+		case nil:
+			// the outcome is narrated by the resulting ActorPrayEvent
+			return nil, nil
+		case core.ErrActorNotDedicated:
+			return []byte("You aren't a follower of any god.\n"), nil
+		case core.ErrPrayerBeyondSkill:
+			return []byte("Your god doesn't favor you enough to answer that prayer.\n"), nil
+		case core.ErrPrayerUnanswered:
+			return []byte("Your prayer goes unanswered; your god is too weak.\n"), nil
+		case core.ErrActorInsufficientZeal:
+			return []byte("You can't muster the zeal for that right now.\n"), nil
+		case core.ErrActorNotReady:
+			return []byte(commands.ErrorActorNotReady + "\n"), nil
+		case core.ErrActorIsGhost:
+			return []byte(commands.ErrorActorIsGhost + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.Pray(): %s", err)
 		}
+	}
+}
 
-		err := targetActor.Die()
+func (gh *gameHandler) getDisengageHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		err := gh.actor.Disengage()
+		if err == core.ErrActorNotEngaged {
+			return []byte("You're not fighting anyone.\n"), nil
+		}
 		if err != nil {
-			return []byte("Whoops..."), fmt.Errorf("Actor.Die(): %s", err)
+			return []byte("Whoops..."), fmt.Errorf("Actor.Disengage(): %s", err)
 		}
-
 		return nil, nil
 	}
 }
 
+func (gh *gameHandler) getFleeHandler() gameHandlerCommandHandler {
+	return func(line string, terminalWidth int) ([]byte, error) {
+		err := gh.actor.Flee()
+		switch err {
+		case nil, core.ErrActorFleeFailed:
+			// the outcome is narrated by the resulting CombatFleeEvent
+			return nil, nil
+		case core.ErrActorNotEngaged:
+			return []byte("You're not fighting anyone.\n"), nil
+		case core.ErrActorCannotFlee:
+			return []byte("There's nowhere to run!\n"), nil
+		case core.ErrActorNotReady:
+			return []byte(commands.ErrorActorNotReady + "\n"), nil
+		default:
+			return []byte("Whoops..."), fmt.Errorf("Actor.Flee(): %s", err)
+		}
+	}
+}
+
 func (gh *gameHandler) getWearHandler() gameHandlerCommandHandler {
 	return func(line string, terminalWidth int) ([]byte, error) {
 		params := strings.Split(line, " ")
@@ -750,6 +2927,49 @@ var locationExitDisplayOrder = []string{
 	core.ExitDirectionSouth,
 	core.ExitDirectionEast,
 	core.ExitDirectionWest,
+	core.ExitDirectionNortheast,
+	core.ExitDirectionNorthwest,
+	core.ExitDirectionSoutheast,
+	core.ExitDirectionSouthwest,
+	core.ExitDirectionUp,
+	core.ExitDirectionDown,
+}
+
+// exitInDirection returns the Exit leading from the Location in the given
+// direction, which may be an abbreviation of a standard direction.
+func exitInDirection(loc *core.Location, direction string) *core.Exit {
+	direction = core.ExpandDirection(strings.ToLower(strings.TrimSpace(direction)))
+	for _, exit := range loc.OutExits() {
+		if exit.Direction() == direction {
+			return exit
+		}
+	}
+	return nil
+}
+
+// orderExits returns the Exits leading in standard directions in the given
+// order, followed by any leading in custom directions, sorted by direction.
+func orderExits(exits core.ExitList, order []string) core.ExitList {
+	exitMap := make(map[string]*core.Exit)
+	var custom []string
+	for _, exit := range exits {
+		exitMap[exit.Direction()] = exit
+		if !core.ValidDirections[exit.Direction()] {
+			custom = append(custom, exit.Direction())
+		}
+	}
+	sort.Strings(custom)
+
+	var out core.ExitList
+	for _, direction := range order {
+		if exit, found := exitMap[direction]; found {
+			out = append(out, exit)
+		}
+	}
+	for _, direction := range custom {
+		out = append(out, exitMap[direction])
+	}
+	return out
 }
 
 func exitRelativeToLocation(baseLoc, otherLoc *core.Location) *core.Exit {
@@ -797,16 +3017,15 @@ func lookAtLocation(ignoreActors core.ActorList, terminalWidth int, loc *core.Lo
 	exits := loc.OutExits()
 	if len(exits) > 0 {
 		exitClause = "\nObvious exits:\n"
-		exitMap := make(map[string]*core.Exit)
-		for _, exit := range exits {
-			exitMap[exit.Direction()] = exit
-		}
-		for _, direction := range locationExitDisplayOrder {
-			exit, found := exitMap[direction]
-			if !found {
-				continue
+		for _, exit := range orderExits(exits, locationExitDisplayOrder) {
+			var doorState string
+			switch {
+			case exit.IsLocked():
+				doorState = " (locked)"
+			case exit.IsClosed():
+				doorState = " (closed)"
 			}
-			exitClause += fmt.Sprintf("%s\t- %s\n", direction, exit.Description())
+			exitClause += fmt.Sprintf("%s\t- %s%s\n", exit.Direction(), exit.Description(), doorState)
 		}
 	}
 
@@ -829,12 +3048,8 @@ func lookAtObject(terminalWidth int, obj *core.Object) []byte {
 	lookFmt := "%s\n%s\n%s\n"
 
 	var containedObjsClause string
-	if obj.Capacity() > 0 {
-		var objNames []string
-		for _, o := range obj.Objects() {
-			objNames = append(objNames, o.Name())
-		}
-		containedObjsClause = fmt.Sprintf("\nPeering inside, you see:\n%s\n", strings.Join(objNames, "\n"))
+	if obj.IsContainer() {
+		containedObjsClause = "\n" + containerContentsClause(obj)
 	}
 
 	lookOutput := fmt.Sprintf(
@@ -847,6 +3062,40 @@ func lookAtObject(terminalWidth int, obj *core.Object) []byte {
 	return []byte(lookOutput)
 }
 
+// containerContentsClause describes what can be seen inside a container,
+// including inside any open containers within it.
+func containerContentsClause(obj *core.Object) string {
+	switch {
+	case obj.IsClosed():
+		return "It is closed.\n"
+	case len(obj.Objects()) == 0:
+		return "It is empty.\n"
+	default:
+		return fmt.Sprintf("Peering inside, you see:\n%s\n", strings.Join(containedObjectLines(obj, ""), "\n"))
+	}
+}
+
+// containedObjectLines lists the names of the Objects inside a container, and
+// (indented beneath each) the contents of any open containers among them.
+func containedObjectLines(obj *core.Object, indent string) []string {
+	if obj.IsClosed() {
+		return nil
+	}
+	var lines []string
+	for _, o := range obj.Objects() {
+		lines = append(lines, indent+o.Name()+closedSuffix(o))
+		lines = append(lines, containedObjectLines(o, indent+"  ")...)
+	}
+	return lines
+}
+
+func closedSuffix(obj *core.Object) string {
+	if obj.IsClosed() {
+		return " (closed)"
+	}
+	return ""
+}
+
 func lookAtActor(terminalWidth int, actor *core.Actor) []byte {
 	// You see <name>.
 	// They are wearing:
@@ -879,10 +3128,46 @@ func lookAtActor(terminalWidth int, actor *core.Actor) []byte {
 		wornObjectsClause = "They are wearing:\n" + wornObjectsClause
 	}
 
-	out := fmt.Sprintf(lookFmt, actor.Name(), wornObjectsClause)
+	var hireClause string
+	if contract := actor.HirelingContract(); contract != nil {
+		hireClause = fmt.Sprintf("They are in the employ of %s.\n", contract.EmployerName)
+	} else if terms := actor.HireTerms(); terms != nil {
+		hireClause = fmt.Sprintf("They are for hire, at %d coins for %s.\n", terms.Wage, minutesPhrase(terms.Term))
+	}
+
+	var factionClause string
+	if factions := actor.Factions(); len(factions) > 0 {
+		factionClause = fmt.Sprintf("They are a member of %s.\n", strings.Join(factions, ", "))
+	}
+
+	var effectsClause string
+	if effects := statusEffectsSummary(actor.ActiveEffects()); effects != "" {
+		effectsClause = fmt.Sprintf("They are %s.\n", effects)
+	}
+
+	out := fmt.Sprintf(lookFmt, actor.Name(), factionClause+hireClause+effectsClause+wornObjectsClause)
 	return []byte(wordwrap.WrapString(out, uint(terminalWidth)))
 }
 
+// statusEffectsSummary lists the names of the status effects, e.g.
+// "poisoned (x2), fortified".
+func statusEffectsSummary(effects []core.ActiveEffect) string {
+	var names []string
+	counts := make(map[string]int)
+	for _, effect := range effects {
+		if counts[effect.Effect.Name] == 0 {
+			names = append(names, effect.Effect.Name)
+		}
+		counts[effect.Effect.Name]++
+	}
+	for i, name := range names {
+		if counts[name] > 1 {
+			names[i] = fmt.Sprintf("%s (x%d)", name, counts[name])
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
 func summarizeCommands(cmdTrie *trie.Trie, terminalWidth int) []byte {
 	allCmds := cmdTrie.Keys()
 	sort.Strings(allCmds)
@@ -924,6 +3209,16 @@ func keywordObjectMatch(keyword string, candidateObjs []*core.Object) *core.Obje
 	return nil
 }
 
+// reachableObjectMatch finds an Object by keyword in the Actor's inventory or,
+// failing that, on the ground.
+func (gh *gameHandler) reachableObjectMatch(keyword string) *core.Object {
+	obj := keywordObjectMatch(keyword, gh.actor.Objects())
+	if obj == nil {
+		obj = keywordObjectMatch(keyword, gh.actor.Location().Objects())
+	}
+	return obj
+}
+
 func nameActorMatch(name string, candidateActors core.ActorList) *core.Actor {
 	lowerName := strings.ToLower(name)
 	for _, a := range candidateActors {
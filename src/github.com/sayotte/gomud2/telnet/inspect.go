@@ -26,13 +26,22 @@ var orderedDirections = []string{
 	core.ExitDirectionSouth,
 	core.ExitDirectionEast,
 	core.ExitDirectionWest,
+	core.ExitDirectionNortheast,
+	core.ExitDirectionNorthwest,
+	core.ExitDirectionSoutheast,
+	core.ExitDirectionSouthwest,
+	core.ExitDirectionUp,
+	core.ExitDirectionDown,
 }
 
+var directionHelp = fmt.Sprintf("need one of [%s] or a custom direction of lowercase words not starting with a command (e.g. \"climb rope\").", strings.Join(orderedDirections, ", "))
+
 type inspectLocationReport struct {
 	ID               uuid.UUID
 	Zone             string
 	ShortDescription string
 	Description      string
+	Tags             []string
 }
 
 func (ilr *inspectLocationReport) fromLocation(loc *core.Location) {
@@ -40,6 +49,7 @@ func (ilr *inspectLocationReport) fromLocation(loc *core.Location) {
 	ilr.Zone = loc.Zone().Tag()
 	ilr.ShortDescription = loc.ShortDescription()
 	ilr.Description = loc.Description()
+	ilr.Tags = loc.Tags()
 }
 
 func (ilr inspectLocationReport) bytes() []byte {
@@ -69,6 +79,14 @@ func (ioer inspectOutExitsReport) bytes() []byte {
 			Value: directionToExitReportMap[dir],
 		})
 	}
+	// with no standard directions given, orderExits returns only the Exits
+	// in custom directions; those are only listed if present
+	for _, exit := range orderExits(ioer.location.OutExits(), nil) {
+		out = append(out, yaml.MapItem{
+			Key:   exit.Direction(),
+			Value: directionToExitReportMap[exit.Direction()],
+		})
+	}
 
 	outBytes, err := yaml.Marshal(out)
 	if err != nil {
@@ -86,6 +104,8 @@ type inspectExitReport struct {
 	Destination     string
 	OtherZoneID     uuid.UUID
 	OtherLocationID uuid.UUID
+	Door            string
+	Key             string `yaml:",omitempty"`
 }
 
 func (ixr *inspectExitReport) fromExit(exit *core.Exit) {
@@ -101,6 +121,22 @@ func (ixr *inspectExitReport) fromExit(exit *core.Exit) {
 	}
 	ixr.OtherZoneID = exit.OtherZoneID()
 	ixr.OtherLocationID = exit.OtherZoneLocID()
+	switch {
+	case !exit.HasDoor():
+		ixr.Door = "none"
+	case exit.IsLocked():
+		ixr.Door = "locked"
+	case exit.IsClosed():
+		ixr.Door = "closed"
+	default:
+		ixr.Door = "open"
+	}
+	switch {
+	case !uuid.Equal(exit.KeyID(), uuid.Nil):
+		ixr.Key = exit.KeyID().String()
+	case exit.KeyKeyword() != "":
+		ixr.Key = exit.KeyKeyword()
+	}
 }
 
 func (ixr inspectExitReport) bytes() []byte {
@@ -156,6 +156,7 @@ func (lh *lobbyHandler) handleGetCharacterNameState(line []byte) ([]byte, handle
 			SorceryCap:           100.0,
 			MysticismCap:         100.0,
 			InscriptionCap:       100.0,
+			CraftingCap:          100.0,
 		},
 		core.DefaultHumanInventoryConstraints,
 	)
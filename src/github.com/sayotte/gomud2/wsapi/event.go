@@ -3,6 +3,8 @@ package wsapi
 import (
 	"encoding/json"
 	"fmt"
+	"time"
+
 	"github.com/satori/go.uuid"
 
 	"github.com/sayotte/gomud2/core"
@@ -17,6 +19,38 @@ const (
 	EventTypeActorMigrateIn      = "actor-migrate-in"
 	EventTypeActorMigrateOut     = "actor-migrate-out"
 	EventTypeActorSpeak          = "actor-speak"
+	EventTypeActorActionDelay    = "actor-action-delay"
+	EventTypeActorBecomeGhost    = "actor-become-ghost"
+	EventTypeActorRespawn        = "actor-respawn"
+	EventTypeActorSetBindLoc     = "actor-set-bind-location"
+	EventTypeActorLearnReaction  = "actor-learn-reaction"
+	EventTypeActorDedicate       = "actor-dedicate"
+	EventTypeActorSacrifice      = "actor-sacrifice"
+	EventTypeActorPray           = "actor-pray"
+	EventTypeActorLearnTechnique = "actor-learn-technique"
+	EventTypeActorScribe         = "actor-scribe"
+	EventTypeActorExert          = "actor-exert"
+	EventTypeActorHire           = "actor-hire"
+	EventTypeActorRelease        = "actor-release"
+	EventTypeActorRelationship   = "actor-relationship"
+	EventTypeActorOrder          = "actor-order"
+	EventTypeActorJoinFaction    = "actor-join-faction"
+	EventTypeActorLeaveFaction   = "actor-leave-faction"
+	EventTypeActorReputation     = "actor-reputation"
+	EventTypeCrimeCommit         = "crime-commit"
+	EventTypeCrimeReport         = "crime-report"
+	EventTypeCrimeResolve        = "crime-resolve"
+	EventTypeObjectOwnership     = "object-ownership"
+	EventTypeActorQuestAccept    = "actor-quest-accept"
+	EventTypeActorQuestProgress  = "actor-quest-progress"
+	EventTypeActorQuestComplete  = "actor-quest-complete"
+	EventTypeActorQuestAbandon   = "actor-quest-abandon"
+	EventTypeActorConsume        = "actor-consume"
+	EventTypeActorEffect         = "actor-effect"
+	EventTypeActorEffectStart    = "actor-effect-start"
+	EventTypeActorEffectEnd      = "actor-effect-end"
+	EventTypeActorCraft          = "actor-craft"
+	EventTypeExitDoor            = "exit-door"
 	//EventTypeLocationAddToZone
 	//EventTypeLocationRemoveFromZone
 	//EventTypeLocationUpdate
@@ -28,11 +62,22 @@ const (
 	EventTypeObjectMove             = "object-move"
 	EventTypeObjectMoveSubcontainer = "object-move-subcontainers"
 	EventTypeObjectAdminRelocate    = "object-admin-relocate"
+	EventTypeObjectContainerAction  = "object-container-action"
+	EventTypeObjectCoins            = "object-coins"
+	EventTypeShopTrade              = "shop-trade"
+	EventTypeTradeOffer             = "trade-offer"
+	EventTypeTradeAccept            = "trade-accept"
+	EventTypeTradeCancel            = "trade-cancel"
+	EventTypeTradeComplete          = "trade-complete"
 	//EventTypeObjectMigrateIn
 	//EventTypeObjectMigrateOut
 	//EventTypeZoneSetDefaultLocation
 	EventTypeCombatMeleeDamage = "combat-melee-damage"
 	EventTypeCombatDodge       = "combat-dodge"
+	EventTypeCombatEngage      = "combat-engage"
+	EventTypeCombatDisengage   = "combat-disengage"
+	EventTypeCombatFlee        = "combat-flee"
+	EventTypeSorceryInvoke     = "sorcery-invoke"
 )
 
 type Event struct {
@@ -75,6 +120,9 @@ func eventFromDomainEvent(from core.Event) (Event, error) {
 	case core.EventTypeActorSpeak:
 		e.EventType = EventTypeActorSpeak
 		frommer = &ActorSpeakEventBody{}
+	case core.EventTypeActorActionDelay:
+		e.EventType = EventTypeActorActionDelay
+		frommer = &ActorActionDelayEventBody{}
 	case core.EventTypeActorMigrateIn:
 		e.EventType = EventTypeActorMigrateIn
 		frommer = &ActorMigrateInEventBody{}
@@ -102,6 +150,132 @@ func eventFromDomainEvent(from core.Event) (Event, error) {
 	case core.EventTypeCombatDodge:
 		e.EventType = EventTypeCombatDodge
 		frommer = &CombatDodgeEventBody{}
+	case core.EventTypeCombatEngage:
+		e.EventType = EventTypeCombatEngage
+		frommer = &CombatEngageEventBody{}
+	case core.EventTypeCombatDisengage:
+		e.EventType = EventTypeCombatDisengage
+		frommer = &CombatDisengageEventBody{}
+	case core.EventTypeCombatFlee:
+		e.EventType = EventTypeCombatFlee
+		frommer = &CombatFleeEventBody{}
+	case core.EventTypeActorBecomeGhost:
+		e.EventType = EventTypeActorBecomeGhost
+		frommer = &ActorBecomeGhostEventBody{}
+	case core.EventTypeActorRespawn:
+		e.EventType = EventTypeActorRespawn
+		frommer = &ActorRespawnEventBody{}
+	case core.EventTypeActorSetBindLocation:
+		e.EventType = EventTypeActorSetBindLoc
+		frommer = &ActorSetBindLocationEventBody{}
+	case core.EventTypeActorLearnReaction:
+		e.EventType = EventTypeActorLearnReaction
+		frommer = &ActorLearnReactionEventBody{}
+	case core.EventTypeActorDedicate:
+		e.EventType = EventTypeActorDedicate
+		frommer = &ActorDedicateEventBody{}
+	case core.EventTypeActorSacrifice:
+		e.EventType = EventTypeActorSacrifice
+		frommer = &ActorSacrificeEventBody{}
+	case core.EventTypeActorPray:
+		e.EventType = EventTypeActorPray
+		frommer = &ActorPrayEventBody{}
+	case core.EventTypeActorLearnTechnique:
+		e.EventType = EventTypeActorLearnTechnique
+		frommer = &ActorLearnTechniqueEventBody{}
+	case core.EventTypeActorScribe:
+		e.EventType = EventTypeActorScribe
+		frommer = &ActorScribeEventBody{}
+	case core.EventTypeActorExert:
+		e.EventType = EventTypeActorExert
+		frommer = &ActorExertEventBody{}
+	case core.EventTypeExitDoor:
+		e.EventType = EventTypeExitDoor
+		frommer = &ExitDoorEventBody{}
+	case core.EventTypeObjectContainerAction:
+		e.EventType = EventTypeObjectContainerAction
+		frommer = &ObjectContainerActionEventBody{}
+	case core.EventTypeObjectCoins:
+		e.EventType = EventTypeObjectCoins
+		frommer = &ObjectCoinsEventBody{}
+	case core.EventTypeShopTrade:
+		e.EventType = EventTypeShopTrade
+		frommer = &ShopTradeEventBody{}
+	case core.EventTypeTradeOffer:
+		e.EventType = EventTypeTradeOffer
+		frommer = &TradeOfferEventBody{}
+	case core.EventTypeTradeAccept:
+		e.EventType = EventTypeTradeAccept
+		frommer = &TradeAcceptEventBody{}
+	case core.EventTypeTradeCancel:
+		e.EventType = EventTypeTradeCancel
+		frommer = &TradeCancelEventBody{}
+	case core.EventTypeTradeComplete:
+		e.EventType = EventTypeTradeComplete
+		frommer = &TradeCompleteEventBody{}
+	case core.EventTypeActorHire:
+		e.EventType = EventTypeActorHire
+		frommer = &ActorHireEventBody{}
+	case core.EventTypeActorRelease:
+		e.EventType = EventTypeActorRelease
+		frommer = &ActorReleaseEventBody{}
+	case core.EventTypeActorRelationship:
+		e.EventType = EventTypeActorRelationship
+		frommer = &ActorRelationshipEventBody{}
+	case core.EventTypeActorOrder:
+		e.EventType = EventTypeActorOrder
+		frommer = &ActorOrderEventBody{}
+	case core.EventTypeActorJoinFaction:
+		e.EventType = EventTypeActorJoinFaction
+		frommer = &ActorFactionMembershipEventBody{}
+	case core.EventTypeActorLeaveFaction:
+		e.EventType = EventTypeActorLeaveFaction
+		frommer = &ActorFactionMembershipEventBody{}
+	case core.EventTypeActorReputation:
+		e.EventType = EventTypeActorReputation
+		frommer = &ActorReputationEventBody{}
+	case core.EventTypeCrimeCommit:
+		e.EventType = EventTypeCrimeCommit
+		frommer = &CrimeCommitEventBody{}
+	case core.EventTypeCrimeReport:
+		e.EventType = EventTypeCrimeReport
+		frommer = &CrimeReportEventBody{}
+	case core.EventTypeCrimeResolve:
+		e.EventType = EventTypeCrimeResolve
+		frommer = &CrimeResolveEventBody{}
+	case core.EventTypeObjectOwnership:
+		e.EventType = EventTypeObjectOwnership
+		frommer = &ObjectOwnershipEventBody{}
+	case core.EventTypeActorQuestAccept:
+		e.EventType = EventTypeActorQuestAccept
+		frommer = &ActorQuestEventBody{}
+	case core.EventTypeActorQuestProgress:
+		e.EventType = EventTypeActorQuestProgress
+		frommer = &ActorQuestProgressEventBody{}
+	case core.EventTypeActorQuestComplete:
+		e.EventType = EventTypeActorQuestComplete
+		frommer = &ActorQuestCompleteEventBody{}
+	case core.EventTypeActorQuestAbandon:
+		e.EventType = EventTypeActorQuestAbandon
+		frommer = &ActorQuestEventBody{}
+	case core.EventTypeActorConsume:
+		e.EventType = EventTypeActorConsume
+		frommer = &ActorConsumeEventBody{}
+	case core.EventTypeActorEffect:
+		e.EventType = EventTypeActorEffect
+		frommer = &ActorEffectEventBody{}
+	case core.EventTypeActorEffectStart:
+		e.EventType = EventTypeActorEffectStart
+		frommer = &ActorEffectStartEventBody{}
+	case core.EventTypeActorEffectEnd:
+		e.EventType = EventTypeActorEffectEnd
+		frommer = &ActorEffectEndEventBody{}
+	case core.EventTypeActorCraft:
+		e.EventType = EventTypeActorCraft
+		frommer = &ActorCraftEventBody{}
+	case core.EventTypeSorceryInvoke:
+		e.EventType = EventTypeSorceryInvoke
+		frommer = &SorceryInvokeEventBody{}
 	default:
 		return e, fmt.Errorf("unhandled Event type %T", from)
 	}
@@ -161,12 +335,687 @@ func (arfzeb *ActorRemoveFromZoneEventBody) populateFromDomain(e core.Event) {
 }
 
 type ActorDeathEventBody struct {
-	ActorID uuid.UUID `json:"actorID"`
+	ActorID  uuid.UUID `json:"actorID"`
+	KillerID uuid.UUID `json:"killerID"`
 }
 
 func (adeb *ActorDeathEventBody) populateFromDomain(e core.Event) {
 	typedEvent := e.(*core.ActorDeathEvent)
 	adeb.ActorID = typedEvent.ActorID
+	adeb.KillerID = typedEvent.KillerID
+}
+
+type ActorBecomeGhostEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	RespawnAt time.Time `json:"respawnAt"`
+}
+
+func (abgeb *ActorBecomeGhostEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorBecomeGhostEvent)
+	*abgeb = ActorBecomeGhostEventBody{
+		ActorID:   from.ActorID,
+		RespawnAt: from.RespawnAt,
+	}
+}
+
+type ActorRespawnEventBody struct {
+	ActorID      uuid.UUID `json:"actorID"`
+	Name         string    `json:"name"`
+	ToLocationID uuid.UUID `json:"toLocationID"`
+}
+
+func (areb *ActorRespawnEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorRespawnEvent)
+	*areb = ActorRespawnEventBody{
+		ActorID:      from.ActorID,
+		Name:         from.ActorName,
+		ToLocationID: from.ToLocationID,
+	}
+}
+
+type ActorSetBindLocationEventBody struct {
+	ActorID    uuid.UUID `json:"actorID"`
+	LocationID uuid.UUID `json:"locationID"`
+}
+
+func (asbleb *ActorSetBindLocationEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorSetBindLocationEvent)
+	*asbleb = ActorSetBindLocationEventBody{
+		ActorID:    from.ActorID,
+		LocationID: from.LocationID,
+	}
+}
+
+type ActorLearnReactionEventBody struct {
+	ActorID  uuid.UUID `json:"actorID"`
+	Reaction string    `json:"reaction"`
+	ScrollID uuid.UUID `json:"scrollID"`
+}
+
+func (alreb *ActorLearnReactionEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorLearnReactionEvent)
+	*alreb = ActorLearnReactionEventBody{
+		ActorID:  from.ActorID,
+		Reaction: from.ReactionName,
+		ScrollID: from.ScrollID,
+	}
+}
+
+type ActorLearnTechniqueEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	Technique string    `json:"technique"`
+	Level     int       `json:"level"`
+	ScrollID  uuid.UUID `json:"scrollID"`
+}
+
+func (alteb *ActorLearnTechniqueEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorLearnTechniqueEvent)
+	*alteb = ActorLearnTechniqueEventBody{
+		ActorID:   from.ActorID,
+		Technique: from.Technique,
+		Level:     from.Level,
+		ScrollID:  from.ScrollID,
+	}
+}
+
+type ActorScribeEventBody struct {
+	ActorID  uuid.UUID `json:"actorID"`
+	SourceID uuid.UUID `json:"sourceID"`
+	BlankID  uuid.UUID `json:"blankID"`
+	Success  bool      `json:"success"`
+}
+
+func (aseb *ActorScribeEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorScribeEvent)
+	*aseb = ActorScribeEventBody{
+		ActorID:  from.ActorID,
+		SourceID: from.SourceID,
+		BlankID:  from.BlankID,
+		Success:  from.Success,
+	}
+}
+
+type ActorExertEventBody struct {
+	ActorID     uuid.UUID `json:"actorID"`
+	StaminaCost int       `json:"staminaCost"`
+}
+
+func (aeeb *ActorExertEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorExertEvent)
+	*aeeb = ActorExertEventBody{
+		ActorID:     from.ActorID,
+		StaminaCost: from.StaminaCost,
+	}
+}
+
+type ExitDoorEventBody struct {
+	Action    string    `json:"action"`
+	Direction string    `json:"direction"`
+	ExitID    uuid.UUID `json:"exitID"`
+	ActorID   uuid.UUID `json:"actorID"`
+}
+
+func (edeb *ExitDoorEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ExitDoorEvent)
+	*edeb = ExitDoorEventBody{
+		Action:    from.Action,
+		Direction: from.Direction,
+		ExitID:    from.ExitID,
+		ActorID:   from.ActorID,
+	}
+}
+
+type ObjectContainerActionEventBody struct {
+	Action   string    `json:"action"`
+	ObjectID uuid.UUID `json:"objectID"`
+	ActorID  uuid.UUID `json:"actorID"`
+}
+
+func (ocaeb *ObjectContainerActionEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ObjectContainerActionEvent)
+	*ocaeb = ObjectContainerActionEventBody{
+		Action:   from.Action,
+		ObjectID: from.ObjectID,
+		ActorID:  from.ActorID,
+	}
+}
+
+type ObjectCoinsEventBody struct {
+	ObjectID uuid.UUID `json:"objectID"`
+	Coins    int       `json:"coins"`
+}
+
+func (oceb *ObjectCoinsEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ObjectCoinsEvent)
+	*oceb = ObjectCoinsEventBody{
+		ObjectID: from.ObjectID,
+		Coins:    from.Coins,
+	}
+}
+
+type ShopTradeEventBody struct {
+	Action       string    `json:"action"`
+	ShopkeeperID uuid.UUID `json:"shopkeeperID"`
+	CustomerID   uuid.UUID `json:"customerID"`
+	ObjectID     uuid.UUID `json:"objectID"`
+	Price        int       `json:"price"`
+}
+
+func (steb *ShopTradeEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ShopTradeEvent)
+	*steb = ShopTradeEventBody{
+		Action:       from.Action,
+		ShopkeeperID: from.ShopkeeperID,
+		CustomerID:   from.CustomerID,
+		ObjectID:     from.ObjectID,
+		Price:        from.Price,
+	}
+}
+
+type TradeOfferEventBody struct {
+	ActorID   uuid.UUID   `json:"actorID"`
+	PartnerID uuid.UUID   `json:"partnerID"`
+	ObjectIDs []uuid.UUID `json:"objectIDs"`
+	Coins     int         `json:"coins"`
+}
+
+func (toeb *TradeOfferEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.TradeOfferEvent)
+	*toeb = TradeOfferEventBody{
+		ActorID:   from.ActorID,
+		PartnerID: from.PartnerID,
+		ObjectIDs: from.ObjectIDs,
+		Coins:     from.Coins,
+	}
+}
+
+type TradeAcceptEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	PartnerID uuid.UUID `json:"partnerID"`
+}
+
+func (taeb *TradeAcceptEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.TradeAcceptEvent)
+	*taeb = TradeAcceptEventBody{
+		ActorID:   from.ActorID,
+		PartnerID: from.PartnerID,
+	}
+}
+
+type TradeCancelEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	PartnerID uuid.UUID `json:"partnerID"`
+	Reason    string    `json:"reason"`
+}
+
+func (tceb *TradeCancelEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.TradeCancelEvent)
+	*tceb = TradeCancelEventBody{
+		ActorID:   from.ActorID,
+		PartnerID: from.PartnerID,
+		Reason:    from.Reason,
+	}
+}
+
+type TradeCompleteEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	PartnerID uuid.UUID `json:"partnerID"`
+}
+
+func (tceb *TradeCompleteEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.TradeCompleteEvent)
+	*tceb = TradeCompleteEventBody{
+		ActorID:   from.ActorID,
+		PartnerID: from.PartnerID,
+	}
+}
+
+type ActorHireEventBody struct {
+	ActorID      uuid.UUID `json:"actorID"`
+	EmployerID   uuid.UUID `json:"employerID"`
+	ActorName    string    `json:"actorName"`
+	EmployerName string    `json:"employerName"`
+	Wage         int       `json:"wage"`
+	HiredUntil   time.Time `json:"hiredUntil"`
+}
+
+func (aheb *ActorHireEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorHireEvent)
+	*aheb = ActorHireEventBody{
+		ActorID:      from.ActorID,
+		EmployerID:   from.EmployerID,
+		ActorName:    from.ActorName,
+		EmployerName: from.EmployerName,
+		Wage:         from.Wage,
+		HiredUntil:   from.HiredUntil,
+	}
+}
+
+type ActorReleaseEventBody struct {
+	Reason       string    `json:"reason"`
+	ActorID      uuid.UUID `json:"actorID"`
+	EmployerID   uuid.UUID `json:"employerID"`
+	ActorName    string    `json:"actorName"`
+	EmployerName string    `json:"employerName"`
+}
+
+func (areb *ActorReleaseEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorReleaseEvent)
+	*areb = ActorReleaseEventBody{
+		Reason:       from.Reason,
+		ActorID:      from.ActorID,
+		EmployerID:   from.EmployerID,
+		ActorName:    from.ActorName,
+		EmployerName: from.EmployerName,
+	}
+}
+
+type ActorRelationshipEventBody struct {
+	Relationship string    `json:"relationship"`
+	ActorID      uuid.UUID `json:"actorID"`
+	OtherID      uuid.UUID `json:"otherID"`
+	OtherName    string    `json:"otherName"`
+}
+
+func (areb *ActorRelationshipEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorRelationshipEvent)
+	*areb = ActorRelationshipEventBody{
+		Relationship: from.Relationship,
+		ActorID:      from.ActorID,
+		OtherID:      from.OtherID,
+		OtherName:    from.OtherName,
+	}
+}
+
+type ActorOrderEventBody struct {
+	Order        string    `json:"order"`
+	TargetID     uuid.UUID `json:"targetID"`
+	TargetName   string    `json:"targetName"`
+	LocationID   uuid.UUID `json:"locationID"`
+	ActorID      uuid.UUID `json:"actorID"`
+	EmployerID   uuid.UUID `json:"employerID"`
+	ActorName    string    `json:"actorName"`
+	EmployerName string    `json:"employerName"`
+}
+
+func (aoeb *ActorOrderEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorOrderEvent)
+	*aoeb = ActorOrderEventBody{
+		Order:        from.Order.Order,
+		TargetID:     from.Order.TargetID,
+		TargetName:   from.Order.TargetName,
+		LocationID:   from.Order.LocationID,
+		ActorID:      from.ActorID,
+		EmployerID:   from.EmployerID,
+		ActorName:    from.ActorName,
+		EmployerName: from.EmployerName,
+	}
+}
+
+// ActorFactionMembershipEventBody is the body of both actor-join-faction and
+// actor-leave-faction events.
+type ActorFactionMembershipEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	ActorName string    `json:"actorName"`
+	Faction   string    `json:"faction"`
+}
+
+func (afmeb *ActorFactionMembershipEventBody) populateFromDomain(e core.Event) {
+	switch from := e.(type) {
+	case *core.ActorJoinFactionEvent:
+		*afmeb = ActorFactionMembershipEventBody{
+			ActorID:   from.ActorID,
+			ActorName: from.ActorName,
+			Faction:   from.Faction,
+		}
+	case *core.ActorLeaveFactionEvent:
+		*afmeb = ActorFactionMembershipEventBody{
+			ActorID:   from.ActorID,
+			ActorName: from.ActorName,
+			Faction:   from.Faction,
+		}
+	}
+}
+
+type ActorReputationEventBody struct {
+	ActorID    uuid.UUID `json:"actorID"`
+	ActorName  string    `json:"actorName"`
+	Faction    string    `json:"faction"`
+	Reason     string    `json:"reason"`
+	Delta      int       `json:"delta"`
+	Reputation int       `json:"reputation"`
+	Standing   string    `json:"standing"`
+}
+
+func (areb *ActorReputationEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorReputationEvent)
+	*areb = ActorReputationEventBody{
+		ActorID:    from.ActorID,
+		ActorName:  from.ActorName,
+		Faction:    from.Faction,
+		Reason:     from.Reason,
+		Delta:      from.Delta,
+		Reputation: from.Reputation,
+		Standing:   from.Standing,
+	}
+}
+
+type CrimeCommitEventBody struct {
+	CrimeID      uuid.UUID   `json:"crimeID"`
+	CrimeType    string      `json:"crimeType"`
+	Severity     int         `json:"severity"`
+	OffenderID   uuid.UUID   `json:"offenderID"`
+	OffenderName string      `json:"offenderName"`
+	VictimID     uuid.UUID   `json:"victimID"`
+	VictimName   string      `json:"victimName"`
+	LocationID   uuid.UUID   `json:"locationID"`
+	CommittedAt  time.Time   `json:"committedAt"`
+	Witnesses    []uuid.UUID `json:"witnesses"`
+}
+
+func (cceb *CrimeCommitEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.CrimeCommitEvent)
+	*cceb = CrimeCommitEventBody{
+		CrimeID:      from.Crime.ID,
+		CrimeType:    from.Crime.Type,
+		Severity:     from.Crime.Severity,
+		OffenderID:   from.Crime.OffenderID,
+		OffenderName: from.Crime.OffenderName,
+		VictimID:     from.Crime.VictimID,
+		VictimName:   from.Crime.VictimName,
+		LocationID:   from.Crime.LocationID,
+		CommittedAt:  from.Crime.CommittedAt,
+		Witnesses:    from.Crime.KnownBy,
+	}
+}
+
+type CrimeReportEventBody struct {
+	CrimeID      uuid.UUID `json:"crimeID"`
+	ReporterID   uuid.UUID `json:"reporterID"`
+	ListenerID   uuid.UUID `json:"listenerID"`
+	ReporterName string    `json:"reporterName"`
+	ListenerName string    `json:"listenerName"`
+	CrimeType    string    `json:"crimeType"`
+	OffenderName string    `json:"offenderName"`
+}
+
+func (creb *CrimeReportEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.CrimeReportEvent)
+	*creb = CrimeReportEventBody{
+		CrimeID:      from.CrimeID,
+		ReporterID:   from.ReporterID,
+		ListenerID:   from.ListenerID,
+		ReporterName: from.ReporterName,
+		ListenerName: from.ListenerName,
+		CrimeType:    from.CrimeType,
+		OffenderName: from.OffenderName,
+	}
+}
+
+type CrimeResolveEventBody struct {
+	CrimeID      uuid.UUID `json:"crimeID"`
+	OffenderID   uuid.UUID `json:"offenderID"`
+	EnforcerID   uuid.UUID `json:"enforcerID"`
+	OffenderName string    `json:"offenderName"`
+	EnforcerName string    `json:"enforcerName"`
+	CrimeType    string    `json:"crimeType"`
+	Resolution   string    `json:"resolution"`
+	Fine         int       `json:"fine"`
+}
+
+func (creb *CrimeResolveEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.CrimeResolveEvent)
+	*creb = CrimeResolveEventBody{
+		CrimeID:      from.CrimeID,
+		OffenderID:   from.OffenderID,
+		EnforcerID:   from.EnforcerID,
+		OffenderName: from.OffenderName,
+		EnforcerName: from.EnforcerName,
+		CrimeType:    from.CrimeType,
+		Resolution:   from.Resolution,
+		Fine:         from.Fine,
+	}
+}
+
+type ObjectOwnershipEventBody struct {
+	ObjectID   uuid.UUID `json:"objectID"`
+	ObjectName string    `json:"objectName"`
+	Reason     string    `json:"reason"`
+	OwnerID    uuid.UUID `json:"ownerID"`
+	OwnerName  string    `json:"ownerName"`
+	ActorID    uuid.UUID `json:"actorID"`
+	ActorName  string    `json:"actorName"`
+}
+
+func (ooeb *ObjectOwnershipEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ObjectOwnershipEvent)
+	*ooeb = ObjectOwnershipEventBody{
+		ObjectID:   from.ObjectID,
+		ObjectName: from.ObjectName,
+		Reason:     from.Record.Reason,
+		OwnerID:    from.Record.OwnerID,
+		OwnerName:  from.Record.OwnerName,
+		ActorID:    from.Record.ActorID,
+		ActorName:  from.Record.ActorName,
+	}
+}
+
+// ActorQuestEventBody is the body of both actor-quest-accept and
+// actor-quest-abandon events.
+type ActorQuestEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	ActorName string    `json:"actorName"`
+	Quest     string    `json:"quest"`
+}
+
+func (aqeb *ActorQuestEventBody) populateFromDomain(e core.Event) {
+	switch from := e.(type) {
+	case *core.ActorQuestAcceptEvent:
+		*aqeb = ActorQuestEventBody{
+			ActorID:   from.ActorID,
+			ActorName: from.ActorName,
+			Quest:     from.Quest,
+		}
+	case *core.ActorQuestAbandonEvent:
+		*aqeb = ActorQuestEventBody{
+			ActorID:   from.ActorID,
+			ActorName: from.ActorName,
+			Quest:     from.Quest,
+		}
+	}
+}
+
+type ActorQuestProgressEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	Quest     string    `json:"quest"`
+	Objective int       `json:"objective"`
+	Progress  int       `json:"progress"`
+	Required  int       `json:"required"`
+}
+
+func (aqpeb *ActorQuestProgressEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorQuestProgressEvent)
+	*aqpeb = ActorQuestProgressEventBody{
+		ActorID:   from.ActorID,
+		Quest:     from.Quest,
+		Objective: from.Objective,
+		Progress:  from.Progress,
+		Required:  from.Required,
+	}
+}
+
+type ActorQuestCompleteEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	ActorName string    `json:"actorName"`
+	Quest     string    `json:"quest"`
+	Coins     int       `json:"coins"`
+}
+
+func (aqceb *ActorQuestCompleteEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorQuestCompleteEvent)
+	*aqceb = ActorQuestCompleteEventBody{
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		Quest:     from.Quest,
+		Coins:     from.Coins,
+	}
+}
+
+type ActorConsumeEventBody struct {
+	ActorID    uuid.UUID `json:"actorID"`
+	ActorName  string    `json:"actorName"`
+	ObjectID   uuid.UUID `json:"objectID"`
+	ObjectName string    `json:"objectName"`
+	Method     string    `json:"method"`
+	UsesLeft   int       `json:"usesLeft"`
+}
+
+func (aceb *ActorConsumeEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorConsumeEvent)
+	*aceb = ActorConsumeEventBody{
+		ActorID:    from.ActorID,
+		ActorName:  from.ActorName,
+		ObjectID:   from.ObjectID,
+		ObjectName: from.ObjectName,
+		Method:     from.Method,
+		UsesLeft:   from.UsesLeft,
+	}
+}
+
+type ActorEffectEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	ActorName string    `json:"actorName"`
+	EffectID  uuid.UUID `json:"effectID"`
+	Source    string    `json:"source"`
+	Name      string    `json:"name"`
+	Attribute string    `json:"attribute"`
+	Delta     int       `json:"delta"`
+}
+
+func (aeeb *ActorEffectEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorEffectEvent)
+	*aeeb = ActorEffectEventBody{
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		EffectID:  from.EffectID,
+		Source:    from.Source,
+		Name:      from.Name,
+		Attribute: from.Attribute,
+		Delta:     from.Delta,
+	}
+}
+
+type ActorEffectStartEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	ActorName string    `json:"actorName"`
+	EffectID  uuid.UUID `json:"effectID"`
+	Name      string    `json:"name"`
+	Source    string    `json:"source"`
+	// Until is zero for an effect lasting until the Actor dies.
+	Until time.Time `json:"until"`
+}
+
+func (aeseb *ActorEffectStartEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorEffectStartEvent)
+	*aeseb = ActorEffectStartEventBody{
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		EffectID:  from.Effect.ID,
+		Name:      from.Effect.Effect.Name,
+		Source:    from.Effect.Effect.Source,
+		Until:     from.Effect.Until(),
+	}
+}
+
+type ActorEffectEndEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	ActorName string    `json:"actorName"`
+	EffectID  uuid.UUID `json:"effectID"`
+	Name      string    `json:"name"`
+	Source    string    `json:"source"`
+	Reason    string    `json:"reason"`
+}
+
+func (aeeeb *ActorEffectEndEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorEffectEndEvent)
+	*aeeeb = ActorEffectEndEventBody{
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		EffectID:  from.EffectID,
+		Name:      from.Name,
+		Source:    from.Source,
+		Reason:    from.Reason,
+	}
+}
+
+type ActorCraftEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	ActorName string    `json:"actorName"`
+	Recipe    string    `json:"recipe"`
+	Success   bool      `json:"success"`
+}
+
+func (aceb *ActorCraftEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorCraftEvent)
+	*aceb = ActorCraftEventBody{
+		ActorID:   from.ActorID,
+		ActorName: from.ActorName,
+		Recipe:    from.Recipe,
+		Success:   from.Success,
+	}
+}
+
+type ActorDedicateEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	DeityID   uuid.UUID `json:"deityID"`
+	DeityName string    `json:"deityName"`
+}
+
+func (adeb *ActorDedicateEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorDedicateEvent)
+	*adeb = ActorDedicateEventBody{
+		ActorID:   from.ActorID,
+		DeityID:   from.DeityID,
+		DeityName: from.DeityName,
+	}
+}
+
+type ActorSacrificeEventBody struct {
+	ActorID  uuid.UUID `json:"actorID"`
+	ObjectID uuid.UUID `json:"objectID"`
+	DeityID  uuid.UUID `json:"deityID"`
+}
+
+func (aseb *ActorSacrificeEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorSacrificeEvent)
+	*aseb = ActorSacrificeEventBody{
+		ActorID:  from.ActorID,
+		ObjectID: from.ObjectID,
+		DeityID:  from.DeityID,
+	}
+}
+
+type ActorPrayEventBody struct {
+	Prayer    string    `json:"prayer"`
+	Effect    string    `json:"effect"`
+	ActorID   uuid.UUID `json:"actorID"`
+	TargetID  uuid.UUID `json:"targetID"`
+	DeityID   uuid.UUID `json:"deityID"`
+	ZealCost  int       `json:"zealCost"`
+	Magnitude int       `json:"magnitude"`
+}
+
+func (apeb *ActorPrayEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorPrayEvent)
+	*apeb = ActorPrayEventBody{
+		Prayer:    from.PrayerName,
+		Effect:    from.Effect,
+		ActorID:   from.ActorID,
+		TargetID:  from.TargetID,
+		DeityID:   from.DeityID,
+		ZealCost:  from.ZealCost,
+		Magnitude: from.Magnitude,
+	}
 }
 
 type ActorMigrateInEventBody struct {
@@ -218,12 +1067,30 @@ func (aseb *ActorSpeakEventBody) populateFromDomain(e core.Event) {
 	}
 }
 
+type ActorActionDelayEventBody struct {
+	ActorID uuid.UUID `json:"actorID"`
+	DelayMS int64     `json:"delayMS"`
+	ReadyAt time.Time `json:"readyAt"`
+}
+
+func (aadeb *ActorActionDelayEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.ActorActionDelayEvent)
+	*aadeb = ActorActionDelayEventBody{
+		ActorID: from.ActorID,
+		DelayMS: int64(from.Delay / time.Millisecond),
+		ReadyAt: from.ReadyAt,
+	}
+}
+
 type ObjectAddToZoneEventBody struct {
-	ObjectID            uuid.UUID `json:"objectID"`
-	Name                string    `json:"name"`
-	LocationContainerID uuid.UUID `json:"locationContainerID"`
-	ActorContainerID    uuid.UUID `json:"actorContainerID"`
-	ObjectContainerID   uuid.UUID `json:"objectContainerID"`
+	ObjectID            uuid.UUID   `json:"objectID"`
+	Name                string      `json:"name"`
+	LocationContainerID uuid.UUID   `json:"locationContainerID"`
+	ActorContainerID    uuid.UUID   `json:"actorContainerID"`
+	ObjectContainerID   uuid.UUID   `json:"objectContainerID"`
+	DecayAt             time.Time   `json:"decayAt"`
+	LootRightsActorIDs  []uuid.UUID `json:"lootRightsActorIDs"`
+	LootRightsUntil     time.Time   `json:"lootRightsUntil"`
 }
 
 func (oatzeb *ObjectAddToZoneEventBody) populateFromDomain(e core.Event) {
@@ -233,6 +1100,9 @@ func (oatzeb *ObjectAddToZoneEventBody) populateFromDomain(e core.Event) {
 	oatzeb.LocationContainerID = typedEvent.LocationContainerID
 	oatzeb.ActorContainerID = typedEvent.ActorContainerID
 	oatzeb.ObjectContainerID = typedEvent.ObjectContainerID
+	oatzeb.DecayAt = typedEvent.DecayAt
+	oatzeb.LootRightsActorIDs = typedEvent.LootRightsActorIDs
+	oatzeb.LootRightsUntil = typedEvent.LootRightsUntil
 }
 
 type ObjectRemoveFromZoneEventBody struct {
@@ -338,3 +1208,69 @@ func (cdeb *CombatDodgeEventBody) populateFromDomain(e core.Event) {
 		TargetID:   from.TargetID,
 	}
 }
+
+type CombatEngageEventBody struct {
+	AttackerID uuid.UUID `json:"attackerID"`
+	TargetID   uuid.UUID `json:"targetID"`
+}
+
+func (ceeb *CombatEngageEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.CombatEngageEvent)
+	*ceeb = CombatEngageEventBody{
+		AttackerID: from.AttackerID,
+		TargetID:   from.TargetID,
+	}
+}
+
+type CombatDisengageEventBody struct {
+	ActorID  uuid.UUID `json:"actorID"`
+	TargetID uuid.UUID `json:"targetID"`
+	Reason   string    `json:"reason"`
+}
+
+func (cdeb *CombatDisengageEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.CombatDisengageEvent)
+	*cdeb = CombatDisengageEventBody{
+		ActorID:  from.ActorID,
+		TargetID: from.TargetID,
+		Reason:   from.Reason,
+	}
+}
+
+type CombatFleeEventBody struct {
+	ActorID   uuid.UUID `json:"actorID"`
+	Direction string    `json:"direction"`
+	Success   bool      `json:"success"`
+}
+
+func (cfeb *CombatFleeEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.CombatFleeEvent)
+	*cfeb = CombatFleeEventBody{
+		ActorID:   from.ActorID,
+		Direction: from.Direction,
+		Success:   from.Success,
+	}
+}
+
+type SorceryInvokeEventBody struct {
+	Reaction  string    `json:"reaction"`
+	Effect    string    `json:"effect"`
+	ActorID   uuid.UUID `json:"actorID"`
+	TargetID  uuid.UUID `json:"targetID"`
+	Success   bool      `json:"success"`
+	FocusCost int       `json:"focusCost"`
+	Magnitude int       `json:"magnitude"`
+}
+
+func (sieb *SorceryInvokeEventBody) populateFromDomain(e core.Event) {
+	from := e.(*core.SorceryInvokeEvent)
+	*sieb = SorceryInvokeEventBody{
+		Reaction:  from.ReactionName,
+		Effect:    from.Effect,
+		ActorID:   from.ActorID,
+		TargetID:  from.TargetID,
+		Success:   from.Success,
+		FocusCost: from.FocusCost,
+		Magnitude: from.Magnitude,
+	}
+}
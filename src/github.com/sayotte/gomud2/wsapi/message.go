@@ -26,12 +26,98 @@ const (
 	MessageTypeLookAtObjectComplete          = "look-at-object-complete"
 	MessageTypeMeleeCombatCommand            = "combat-melee"
 	MessageTypeMeleeCombatComplete           = "combat-melee-complete"
+	MessageTypeEngageCombatCommand           = "combat-engage"
+	MessageTypeEngageCombatComplete          = "combat-engage-complete"
+	MessageTypeDisengageCombatCommand        = "combat-disengage"
+	MessageTypeDisengageCombatComplete       = "combat-disengage-complete"
+	MessageTypeFleeCombatCommand             = "combat-flee"
+	MessageTypeFleeCombatComplete            = "combat-flee-complete"
+	MessageTypeInvokeSorceryCommand          = "invoke-sorcery"
+	MessageTypeInvokeSorceryComplete         = "invoke-sorcery-complete"
+	MessageTypeReadScrollCommand             = "read-scroll"
+	MessageTypeReadScrollComplete            = "read-scroll-complete"
+	MessageTypeScribeScrollCommand           = "scribe-scroll"
+	MessageTypeScribeScrollComplete          = "scribe-scroll-complete"
+	MessageTypeOpenExitCommand               = "open-exit"
+	MessageTypeCloseExitCommand              = "close-exit"
+	MessageTypeLockExitCommand               = "lock-exit"
+	MessageTypeUnlockExitCommand             = "unlock-exit"
+	MessageTypeExitDoorComplete              = "exit-door-complete"
+	MessageTypeOpenObjectCommand             = "open-object"
+	MessageTypeCloseObjectCommand            = "close-object"
+	MessageTypeLockObjectCommand             = "lock-object"
+	MessageTypeUnlockObjectCommand           = "unlock-object"
+	MessageTypeObjectContainerComplete       = "object-container-complete"
+	MessageTypeListInventoryCommand          = "list-inventory"
+	MessageTypeListInventoryComplete         = "inventory-list"
+	MessageTypeListShopCommand               = "list-shop"
+	MessageTypeListShopComplete              = "shop-list"
+	MessageTypeBuyObjectCommand              = "buy-object"
+	MessageTypeBuyObjectComplete             = "buy-object-complete"
+	MessageTypeSellObjectCommand             = "sell-object"
+	MessageTypeSellObjectComplete            = "sell-object-complete"
+	MessageTypeValueObjectCommand            = "value-object"
+	MessageTypeValueObjectComplete           = "value-object-complete"
+	MessageTypeTradeOfferCommand             = "trade-offer"
+	MessageTypeTradeOfferComplete            = "trade-offer-complete"
+	MessageTypeTradeAcceptCommand            = "trade-accept"
+	MessageTypeTradeAcceptComplete           = "trade-accept-complete"
+	MessageTypeTradeCancelCommand            = "trade-cancel"
+	MessageTypeTradeCancelComplete           = "trade-cancel-complete"
+	MessageTypeDescribeTradeCommand          = "describe-trade"
+	MessageTypeDescribeTradeComplete         = "trade-description"
+	MessageTypeHireActorCommand              = "hire-actor"
+	MessageTypeHireActorComplete             = "hire-actor-complete"
+	MessageTypeFireActorCommand              = "fire-actor"
+	MessageTypeFireActorComplete             = "fire-actor-complete"
+	MessageTypeOrderHirelingCommand          = "order-hireling"
+	MessageTypeOrderHirelingComplete         = "order-hireling-complete"
+	MessageTypeDescribeContractCommand       = "describe-contract"
+	MessageTypeDescribeContractComplete      = "contract-description"
+	MessageTypeSpeakCommand                  = "speak"
+	MessageTypeSpeakComplete                 = "speak-complete"
+	MessageTypeDescribeReputationCommand     = "describe-reputation"
+	MessageTypeDescribeReputationComplete    = "reputation-description"
+	MessageTypeDescribeCrimesCommand         = "describe-crimes"
+	MessageTypeDescribeCrimesComplete        = "crimes-description"
+	MessageTypeReportCrimeCommand            = "report-crime"
+	MessageTypeReportCrimeComplete           = "report-crime-complete"
+	MessageTypePunishCrimeCommand            = "punish-crime"
+	MessageTypePunishCrimeComplete           = "punish-crime-complete"
+	MessageTypeDescribeProvenanceCommand     = "describe-provenance"
+	MessageTypeDescribeProvenanceComplete    = "provenance-description"
+	MessageTypeDescribeQuestsCommand         = "describe-quests"
+	MessageTypeDescribeQuestsComplete        = "quests-description"
+	MessageTypeDescribeQuestCommand          = "describe-quest"
+	MessageTypeDescribeQuestComplete         = "quest-description"
+	MessageTypeAcceptQuestCommand            = "accept-quest"
+	MessageTypeAcceptQuestComplete           = "accept-quest-complete"
+	MessageTypeAbandonQuestCommand           = "abandon-quest"
+	MessageTypeAbandonQuestComplete          = "abandon-quest-complete"
+	MessageTypeEatCommand                    = "eat"
+	MessageTypeEatComplete                   = "eat-complete"
+	MessageTypeDrinkCommand                  = "drink"
+	MessageTypeDrinkComplete                 = "drink-complete"
+	MessageTypeUseCommand                    = "use"
+	MessageTypeUseComplete                   = "use-complete"
+	MessageTypeDescribeRecipesCommand        = "describe-recipes"
+	MessageTypeDescribeRecipesComplete       = "recipes-description"
+	MessageTypeCraftCommand                  = "craft"
+	MessageTypeCraftComplete                 = "craft-complete"
+	MessageTypeDedicateCommand               = "dedicate"
+	MessageTypeDedicateComplete              = "dedicate-complete"
+	MessageTypeSacrificeCommand              = "sacrifice"
+	MessageTypeSacrificeComplete             = "sacrifice-complete"
+	MessageTypePrayCommand                   = "pray"
+	MessageTypePrayComplete                  = "pray-complete"
 	MessageTypeMoveObjectCommand             = "move-object"
 	MessageTypeMoveObjectComplete            = "move-object-complete"
 	MessageTypeMoveObjectSubcontainer        = "move-object-subcontainer"
 	MessageTypeEvent                         = "event"
 	MessageTypeGetCurrentLocationInfoCommand = "get-current-location-info"
 	MessageTypeCurrentLocationInfoComplete   = "current-location-info"
+	MessageTypeGetCharacterSheetCommand      = "get-character-sheet"
+	MessageTypeCharacterSheetComplete        = "character-sheet"
 )
 
 type CompleteListActors struct {
@@ -74,4 +160,146 @@ type CommandMeleeCombat struct {
 	TargetID   uuid.UUID `json:"targetID"`
 }
 
+type CommandEngageCombat struct {
+	TargetID uuid.UUID `json:"targetID"`
+}
+
+type CompleteFleeCombat struct {
+	Escaped bool `json:"escaped"`
+}
+
+type CommandInvokeSorcery struct {
+	Reaction string    `json:"reaction"`
+	TargetID uuid.UUID `json:"targetID"`
+}
+
+type CompleteInvokeSorcery struct {
+	Success bool `json:"success"`
+}
+
+type CommandReadScroll struct {
+	ObjectID uuid.UUID `json:"objectID"`
+}
+
+type CommandScribeScroll struct {
+	SourceID uuid.UUID `json:"sourceID"`
+	BlankID  uuid.UUID `json:"blankID"`
+}
+
+type CompleteScribeScroll struct {
+	Success bool `json:"success"`
+}
+
+// CommandExitDoor is the payload for open-exit, close-exit, lock-exit and
+// unlock-exit messages.
+type CommandExitDoor struct {
+	Direction string `json:"direction"`
+}
+
+// CommandObjectContainer is the payload for open-object, close-object,
+// lock-object and unlock-object messages.
+type CommandObjectContainer struct {
+	ObjectID uuid.UUID `json:"objectID"`
+}
+
+type CommandListShop struct {
+	ShopkeeperID uuid.UUID `json:"shopkeeperID"`
+}
+
+// CommandShopTrade is the payload for buy-object, sell-object and
+// value-object messages.
+type CommandShopTrade struct {
+	ShopkeeperID uuid.UUID `json:"shopkeeperID"`
+	ObjectID     uuid.UUID `json:"objectID"`
+}
+
+// CommandTradeOffer replaces our side of a trade with the given Objects and
+// coins, opening the trade with the partner if need be.
+type CommandTradeOffer struct {
+	PartnerID uuid.UUID   `json:"partnerID"`
+	ObjectIDs []uuid.UUID `json:"objectIDs"`
+	Coins     int         `json:"coins"`
+}
+
+// CommandHireActor hires the Actor, or extends its contract, on its current
+// terms. CommandFireActor and CommandDescribeContract take the same form.
+type CommandHireActor struct {
+	ActorID uuid.UUID `json:"actorID"`
+}
+
+type CommandFireActor struct {
+	ActorID uuid.UUID `json:"actorID"`
+}
+
+type CommandDescribeContract struct {
+	ActorID uuid.UUID `json:"actorID"`
+}
+
+// CommandOrderHireling gives one of the core.HirelingOrder* orders to a
+// hireling we employ. TargetID is only needed for attack and guard orders.
+type CommandOrderHireling struct {
+	HirelingID uuid.UUID `json:"hirelingID"`
+	Order      string    `json:"order"`
+	TargetID   uuid.UUID `json:"targetID"`
+}
+
+type CommandSpeak struct {
+	Speech string `json:"speech"`
+}
+
+type CommandDescribeReputation struct {
+	ActorID uuid.UUID `json:"actorID"`
+}
+
+// CommandReportCrime tells another Actor, who must be here, about a crime we
+// know of.
+type CommandReportCrime struct {
+	CrimeID    uuid.UUID `json:"crimeID"`
+	ListenerID uuid.UUID `json:"listenerID"`
+}
+
+// CommandPunishCrime resolves a crime we know of with one of the
+// core.CrimeResolution* constants. Fine is only needed for fines.
+type CommandPunishCrime struct {
+	CrimeID    uuid.UUID `json:"crimeID"`
+	Resolution string    `json:"resolution"`
+	Fine       int       `json:"fine"`
+}
+
+// CommandDescribeProvenance asks about the ownership of an Object where we
+// are, whether it's lying around, in a container or being carried.
+type CommandDescribeProvenance struct {
+	ObjectID uuid.UUID `json:"objectID"`
+}
+
+// CommandQuest names a quest to describe, accept or abandon.
+type CommandQuest struct {
+	Quest string `json:"quest"`
+}
+
+type CommandConsume struct {
+	ObjectID uuid.UUID `json:"objectID"`
+}
+
+type CommandCraft struct {
+	Recipe string `json:"recipe"`
+}
+
+type CompleteCraft struct {
+	Success bool `json:"success"`
+}
+
+type CommandDedicate struct {
+	DeityID uuid.UUID `json:"deityID"`
+}
+
+type CommandSacrifice struct {
+	ObjectID uuid.UUID `json:"objectID"`
+}
+
+type CommandPray struct {
+	Prayer   string    `json:"prayer"`
+	TargetID uuid.UUID `json:"targetID"`
+}
+
 type CurrentLocationInfo commands.LocationInfo
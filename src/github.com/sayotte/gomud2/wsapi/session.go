@@ -245,8 +245,76 @@ func (s *session) handleMessage(msg Message) {
 		s.handleCommandMoveObject(msg)
 	case MessageTypeGetCurrentLocationInfoCommand:
 		s.handleCommandGetCurrentLocInfo(msg)
+	case MessageTypeGetCharacterSheetCommand:
+		s.sendMessage(MessageTypeCharacterSheetComplete, commands.CharacterSheet(s.actor), msg.MessageID)
 	case MessageTypeMeleeCombatCommand:
 		s.handleCommandMeleeCombat(msg)
+	case MessageTypeEngageCombatCommand:
+		s.handleCommandEngageCombat(msg)
+	case MessageTypeDisengageCombatCommand:
+		s.handleCommandDisengageCombat(msg)
+	case MessageTypeFleeCombatCommand:
+		s.handleCommandFleeCombat(msg)
+	case MessageTypeInvokeSorceryCommand:
+		s.handleCommandInvokeSorcery(msg)
+	case MessageTypeReadScrollCommand:
+		s.handleCommandReadScroll(msg)
+	case MessageTypeScribeScrollCommand:
+		s.handleCommandScribeScroll(msg)
+	case MessageTypeOpenExitCommand, MessageTypeCloseExitCommand, MessageTypeLockExitCommand, MessageTypeUnlockExitCommand:
+		s.handleCommandExitDoor(msg)
+	case MessageTypeOpenObjectCommand, MessageTypeCloseObjectCommand, MessageTypeLockObjectCommand, MessageTypeUnlockObjectCommand:
+		s.handleCommandObjectContainer(msg)
+	case MessageTypeListInventoryCommand:
+		s.handleCommandListInventory(msg)
+	case MessageTypeListShopCommand:
+		s.handleCommandListShop(msg)
+	case MessageTypeBuyObjectCommand, MessageTypeSellObjectCommand, MessageTypeValueObjectCommand:
+		s.handleCommandShopTrade(msg)
+	case MessageTypeTradeOfferCommand:
+		s.handleCommandTradeOffer(msg)
+	case MessageTypeTradeAcceptCommand:
+		s.handleCommandTradeAccept(msg)
+	case MessageTypeTradeCancelCommand:
+		s.handleCommandTradeCancel(msg)
+	case MessageTypeDescribeTradeCommand:
+		s.sendMessage(MessageTypeDescribeTradeComplete, commands.DescribeTrade(s.actor), msg.MessageID)
+	case MessageTypeHireActorCommand:
+		s.handleCommandHireActor(msg)
+	case MessageTypeFireActorCommand:
+		s.handleCommandFireActor(msg)
+	case MessageTypeOrderHirelingCommand:
+		s.handleCommandOrderHireling(msg)
+	case MessageTypeDescribeContractCommand:
+		s.handleCommandDescribeContract(msg)
+	case MessageTypeSpeakCommand:
+		s.handleCommandSpeak(msg)
+	case MessageTypeDescribeReputationCommand:
+		s.handleCommandDescribeReputation(msg)
+	case MessageTypeDescribeCrimesCommand:
+		s.handleCommandDescribeCrimes(msg)
+	case MessageTypeReportCrimeCommand:
+		s.handleCommandReportCrime(msg)
+	case MessageTypePunishCrimeCommand:
+		s.handleCommandPunishCrime(msg)
+	case MessageTypeDescribeProvenanceCommand:
+		s.handleCommandDescribeProvenance(msg)
+	case MessageTypeDescribeQuestsCommand:
+		s.sendMessage(MessageTypeDescribeQuestsComplete, commands.DescribeQuests(s.actor), msg.MessageID)
+	case MessageTypeDescribeQuestCommand, MessageTypeAcceptQuestCommand, MessageTypeAbandonQuestCommand:
+		s.handleCommandQuest(msg)
+	case MessageTypeEatCommand, MessageTypeDrinkCommand, MessageTypeUseCommand:
+		s.handleCommandConsume(msg)
+	case MessageTypeDescribeRecipesCommand:
+		s.sendMessage(MessageTypeDescribeRecipesComplete, commands.DescribeRecipes(s.actor), msg.MessageID)
+	case MessageTypeCraftCommand:
+		s.handleCommandCraft(msg)
+	case MessageTypeDedicateCommand:
+		s.handleCommandDedicate(msg)
+	case MessageTypeSacrificeCommand:
+		s.handleCommandSacrifice(msg)
+	case MessageTypePrayCommand:
+		s.handleCommandPray(msg)
 	default:
 		fmt.Printf("WSAPI ERROR: session received message of type %q\n", msg.Type)
 		s.sendCloseDetachAndStop(true, websocket.CloseProtocolError, fmt.Sprintf("unhandleable API message type %q", msg.Type))
@@ -439,31 +507,11 @@ func (s *session) handleCommandLookAtObject(msg Message) {
 		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
 		return
 	}
-	// Object must be located in one of:
-	// - on the ground in the same Location as our Actor
-	// - in top-level of a container on the ground in the same Location as our Actor
-	// - in our Actor's inventory
-	// - in top-level of a container in our Actor's inventory
-	// Anything else-- e.g. peeking into a container in another Actor's inventory--
-	// should not work.
-	objLoc := obj.Location()
-	objCont := obj.Container()
-
-	containerIsThisActor := objCont == s.actor
-
-	containerIsLocation := objLoc == objCont
-	locationContainerIsActorLocation := objLoc == s.actor.Location()
-
-	containerObj, containerIsObject := objCont.(*core.Object)
-	var containerObjIsOnGround, containerObjIsInInventory bool
-	if containerIsObject {
-		containerObjIsOnGround = containerObj.Container() == s.actor.Location()
-		containerObjIsInInventory = containerObj.Container() == s.actor
-	}
-
-	if !containerIsThisActor &&
-		((containerIsLocation && !locationContainerIsActorLocation) ||
-			(containerIsObject && !containerObjIsOnGround && !containerObjIsInInventory)) {
+	// Object must be on the ground in the same Location as our Actor, or in
+	// our Actor's inventory, or in (possibly nested) open containers in either
+	// of those places. Anything else-- e.g. peeking into a container in another
+	// Actor's inventory, or a closed one-- should not work.
+	if !obj.WithinReachOf(s.actor) {
 		s.sendMessage(MessageTypeProcessingError, "too far away / inside a container", msg.MessageID)
 		return
 	}
@@ -521,6 +569,17 @@ func (s *session) handleCommandMoveObject(msg Message) {
 	}
 
 	err = obj.Move(fromContainer, toContainer, s.actor, cmd.ToSubcontainer)
+	switch err {
+	case core.ErrObjectLootRightsReserved, core.ErrObjectNotPortable, core.ErrInventoryTooHeavy,
+		core.ErrObjectNotContainer, core.ErrContainerClosed, core.ErrContainerFull, core.ErrContainerInsideItself,
+		core.ErrInventoryNoRoom, core.ErrObjectLockedInTrade:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+		return
+	}
+	if err == core.ErrActorIsGhost {
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+		return
+	}
 	if err != nil {
 		fmt.Printf("WSAPI ERROR: %s\n", err)
 		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
@@ -555,6 +614,14 @@ func (s *session) handleCommandMeleeCombat(msg Message) {
 		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
 		return
 	}
+	if err == core.ErrActorNotReady {
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorNotReady, msg.MessageID)
+		return
+	}
+	if err == core.ErrActorIsGhost {
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+		return
+	}
 	if err != nil {
 		fmt.Printf("WSAPI ERROR: %s\n", err)
 		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
@@ -563,6 +630,871 @@ func (s *session) handleCommandMeleeCombat(msg Message) {
 	s.sendMessage(MessageTypeMeleeCombatComplete, nil, msg.MessageID)
 }
 
+func (s *session) handleCommandEngageCombat(msg Message) {
+	var cmd CommandEngageCombat
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	target := s.actor.Zone().ActorByID(cmd.TargetID)
+	if target == nil {
+		errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.TargetID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+	if target.Location() != s.actor.Location() {
+		s.sendMessage(MessageTypeProcessingError, "too far away", msg.MessageID)
+		return
+	}
+	if target == s.actor {
+		s.sendMessage(MessageTypeProcessingError, core.ErrActorEngagedSelf.Error(), msg.MessageID)
+		return
+	}
+
+	err = s.actor.Engage(target)
+	if err == core.ErrActorIsGhost {
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+		return
+	}
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+		return
+	}
+	s.sendMessage(MessageTypeEngageCombatComplete, nil, msg.MessageID)
+}
+
+func (s *session) handleCommandDisengageCombat(msg Message) {
+	err := s.actor.Disengage()
+	if err == core.ErrActorNotEngaged {
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+		return
+	}
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+		return
+	}
+	s.sendMessage(MessageTypeDisengageCombatComplete, nil, msg.MessageID)
+}
+
+func (s *session) handleCommandFleeCombat(msg Message) {
+	err := s.actor.Flee()
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeFleeCombatComplete, CompleteFleeCombat{Escaped: true}, msg.MessageID)
+	case core.ErrActorFleeFailed:
+		s.sendMessage(MessageTypeFleeCombatComplete, CompleteFleeCombat{Escaped: false}, msg.MessageID)
+	case core.ErrActorNotEngaged, core.ErrActorCannotFlee:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorNotReady:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorNotReady, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandInvokeSorcery(msg Message) {
+	var cmd CommandInvokeSorcery
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	target := s.actor.Zone().ActorByID(cmd.TargetID)
+	if target == nil {
+		errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.TargetID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+	if target.Location() != s.actor.Location() {
+		s.sendMessage(MessageTypeProcessingError, "too far away", msg.MessageID)
+		return
+	}
+
+	err = s.actor.Invoke(cmd.Reaction, target)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeInvokeSorceryComplete, CompleteInvokeSorcery{Success: true}, msg.MessageID)
+	case core.ErrReactionFailed:
+		s.sendMessage(MessageTypeInvokeSorceryComplete, CompleteInvokeSorcery{Success: false}, msg.MessageID)
+	case core.ErrReactionUnknown, core.ErrReactionNotKnown, core.ErrActorInsufficientFocus:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorNotReady:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorNotReady, msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandReadScroll(msg Message) {
+	var cmd CommandReadScroll
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	obj := s.actor.Zone().ObjectByID(cmd.ObjectID)
+	if obj == nil || obj.Container() != s.actor {
+		errMsg := fmt.Sprintf("not holding an Object with ID %q", cmd.ObjectID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+
+	err = s.actor.ReadScroll(obj)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeReadScrollComplete, nil, msg.MessageID)
+	case core.ErrObjectNotScroll, core.ErrReactionUnknown, core.ErrReactionAlreadyKnown:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrTechniqueUnknown, core.ErrTechniqueAlreadyKnown, core.ErrTechniqueTooAdvanced, core.ErrTechniqueBeyondCap:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandScribeScroll(msg Message) {
+	var cmd CommandScribeScroll
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	var objs []*core.Object
+	for _, id := range []uuid.UUID{cmd.SourceID, cmd.BlankID} {
+		obj := s.actor.Zone().ObjectByID(id)
+		if obj == nil || obj.Container() != s.actor {
+			errMsg := fmt.Sprintf("not holding an Object with ID %q", id)
+			s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+			return
+		}
+		objs = append(objs, obj)
+	}
+
+	err = s.actor.Scribe(objs[0], objs[1])
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeScribeScrollComplete, CompleteScribeScroll{Success: true}, msg.MessageID)
+	case core.ErrScribeFailed:
+		s.sendMessage(MessageTypeScribeScrollComplete, CompleteScribeScroll{Success: false}, msg.MessageID)
+	case core.ErrObjectNotScroll, core.ErrObjectNotBlankScroll, core.ErrScribeSameObject, core.ErrReactionUnknown:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorNotReady:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorNotReady, msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandExitDoor(msg Message) {
+	var cmd CommandExitDoor
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	var exit *core.Exit
+	for _, maybeExit := range s.actor.Location().OutExits() {
+		if maybeExit.Direction() == cmd.Direction {
+			exit = maybeExit
+			break
+		}
+	}
+	if exit == nil {
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorNoSuchExit, msg.MessageID)
+		return
+	}
+
+	switch msg.Type {
+	case MessageTypeOpenExitCommand:
+		err = s.actor.OpenExit(exit)
+	case MessageTypeCloseExitCommand:
+		err = s.actor.CloseExit(exit)
+	case MessageTypeLockExitCommand:
+		err = s.actor.LockExit(exit)
+	case MessageTypeUnlockExitCommand:
+		err = s.actor.UnlockExit(exit)
+	}
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeExitDoorComplete, nil, msg.MessageID)
+	case core.ErrExitNoDoor, core.ErrExitNoLock, core.ErrExitLocked, core.ErrExitAlreadyOpen,
+		core.ErrExitAlreadyClosed, core.ErrExitAlreadyLocked, core.ErrExitNotLocked, core.ErrExitNotClosed, core.ErrActorHasNoKey:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandObjectContainer(msg Message) {
+	var cmd CommandObjectContainer
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	obj := s.actor.Zone().ObjectByID(cmd.ObjectID)
+	if obj == nil {
+		errMsg := fmt.Sprintf("Object with ID %q does not exist", cmd.ObjectID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+
+	switch msg.Type {
+	case MessageTypeOpenObjectCommand:
+		err = s.actor.OpenContainer(obj)
+	case MessageTypeCloseObjectCommand:
+		err = s.actor.CloseContainer(obj)
+	case MessageTypeLockObjectCommand:
+		err = s.actor.LockContainer(obj)
+	case MessageTypeUnlockObjectCommand:
+		err = s.actor.UnlockContainer(obj)
+	}
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeObjectContainerComplete, nil, msg.MessageID)
+	case core.ErrContainerNotCloseable, core.ErrContainerNoLock, core.ErrContainerLocked, core.ErrContainerAlreadyOpen,
+		core.ErrContainerAlreadyClosed, core.ErrContainerAlreadyLocked, core.ErrContainerNotLocked, core.ErrContainerNotClosed,
+		core.ErrContainerOutOfReach, core.ErrActorHasNoKey:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandListInventory(msg Message) {
+	s.sendMessage(
+		MessageTypeListInventoryComplete,
+		commands.ListInventory(s.actor),
+		msg.MessageID,
+	)
+}
+
+// shopkeeperHere finds the shopkeeper with the given ID in our Actor's
+// Location, sending an error in reply to the message if it isn't there.
+func (s *session) shopkeeperHere(shopkeeperID uuid.UUID, msg Message) *core.Actor {
+	shopkeeper := s.actor.Zone().ActorByID(shopkeeperID)
+	if shopkeeper == nil || shopkeeper.Location() != s.actor.Location() {
+		s.sendMessage(MessageTypeProcessingError, core.ErrShopkeeperNotHere.Error(), msg.MessageID)
+		return nil
+	}
+	if !shopkeeper.IsShopkeeper() {
+		s.sendMessage(MessageTypeProcessingError, core.ErrNotShopkeeper.Error(), msg.MessageID)
+		return nil
+	}
+	return shopkeeper
+}
+
+func (s *session) handleCommandListShop(msg Message) {
+	var cmd CommandListShop
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	shopkeeper := s.shopkeeperHere(cmd.ShopkeeperID, msg)
+	if shopkeeper == nil {
+		return
+	}
+	s.sendMessage(MessageTypeListShopComplete, commands.ListShop(shopkeeper), msg.MessageID)
+}
+
+func (s *session) handleCommandShopTrade(msg Message) {
+	var cmd CommandShopTrade
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	shopkeeper := s.shopkeeperHere(cmd.ShopkeeperID, msg)
+	if shopkeeper == nil {
+		return
+	}
+	obj := s.actor.Zone().ObjectByID(cmd.ObjectID)
+	if obj == nil {
+		errMsg := fmt.Sprintf("Object with ID %q does not exist", cmd.ObjectID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+
+	var completeType string
+	switch msg.Type {
+	case MessageTypeBuyObjectCommand:
+		err = s.actor.Buy(shopkeeper, obj)
+		completeType = MessageTypeBuyObjectComplete
+	case MessageTypeSellObjectCommand:
+		err = s.actor.Sell(shopkeeper, obj)
+		completeType = MessageTypeSellObjectComplete
+	case MessageTypeValueObjectCommand:
+		s.sendMessage(MessageTypeValueObjectComplete, commands.ValueObject(shopkeeper, obj), msg.MessageID)
+		return
+	}
+	switch err {
+	case nil:
+		s.sendMessage(completeType, nil, msg.MessageID)
+	case core.ErrShopkeeperNotHere, core.ErrShopNotForSale, core.ErrShopWillNotBuy, core.ErrShopWillNotBuyStolen, core.ErrShopContainerNotEmpty,
+		core.ErrShopNoRoom, core.ErrShopCannotAfford, core.ErrCannotAfford, core.ErrObjectWorthless, core.ErrInventoryTooHeavy,
+		core.ErrObjectLockedInTrade:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		// the inventory's own errors (e.g. "can't fit another item in/on
+		// hands") aren't sentinels, but they're no reason to drop the session
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	}
+}
+
+func (s *session) handleCommandTradeOffer(msg Message) {
+	var cmd CommandTradeOffer
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	partner := s.actor.Zone().ActorByID(cmd.PartnerID)
+	if partner == nil || partner.Location() != s.actor.Location() {
+		errMsg := fmt.Sprintf("Actor with ID %q is not here", cmd.PartnerID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+	var objects core.ObjectList
+	for _, objID := range cmd.ObjectIDs {
+		obj := s.actor.Zone().ObjectByID(objID)
+		if obj == nil {
+			errMsg := fmt.Sprintf("Object with ID %q does not exist", objID)
+			s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+			return
+		}
+		objects = append(objects, obj)
+	}
+
+	err = s.actor.OfferTrade(partner, objects, cmd.Coins)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeTradeOfferComplete, nil, msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		// everything that can go wrong with an offer is the caller's mistake
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	}
+}
+
+func (s *session) handleCommandTradeAccept(msg Message) {
+	err := s.actor.AcceptTrade()
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeTradeAcceptComplete, nil, msg.MessageID)
+	case core.ErrNotTrading, core.ErrTradePartnerGone, core.ErrTradePartnerNotJoined, core.ErrTradeObjectNotHeld,
+		core.ErrCannotAfford, core.ErrTradePartnerCannotAfford, core.ErrInventoryTooHeavy, core.ErrInventoryNoRoom,
+		core.ErrTradePartnerNoRoom:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandTradeCancel(msg Message) {
+	err := s.actor.CancelTrade()
+	if err == core.ErrNotTrading {
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+		return
+	}
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+		return
+	}
+	s.sendMessage(MessageTypeTradeCancelComplete, nil, msg.MessageID)
+}
+
+func (s *session) handleCommandHireActor(msg Message) {
+	var cmd CommandHireActor
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	hireling := s.actor.Zone().ActorByID(cmd.ActorID)
+	if hireling == nil {
+		errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.ActorID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+
+	err = s.actor.Hire(hireling)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeHireActorComplete, nil, msg.MessageID)
+	case core.ErrNotForHire, core.ErrHireSelf, core.ErrAlreadyHired, core.ErrHirelingNotHere, core.ErrHirelingNoRoom,
+		core.ErrCannotAfford:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandFireActor(msg Message) {
+	var cmd CommandFireActor
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	hireling := s.actor.Zone().ActorByID(cmd.ActorID)
+	if hireling == nil {
+		errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.ActorID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+
+	err = s.actor.Fire(hireling)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeFireActorComplete, nil, msg.MessageID)
+	case core.ErrNotEmployer:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandOrderHireling(msg Message) {
+	var cmd CommandOrderHireling
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	hireling := s.actor.Zone().ActorByID(cmd.HirelingID)
+	if hireling == nil {
+		errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.HirelingID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+	var target *core.Actor
+	if !uuid.Equal(cmd.TargetID, uuid.Nil) {
+		target = s.actor.Zone().ActorByID(cmd.TargetID)
+		if target == nil {
+			errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.TargetID)
+			s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+			return
+		}
+	}
+
+	err = s.actor.Order(hireling, cmd.Order, target)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeOrderHirelingComplete, nil, msg.MessageID)
+	case core.ErrNotEmployer, core.ErrHirelingNotHere, core.ErrHirelingOrderTarget, core.ErrHirelingOrderSelf,
+		core.ErrHirelingOrderUnknown:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandDescribeContract(msg Message) {
+	var cmd CommandDescribeContract
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	actor := s.actor.Zone().ActorByID(cmd.ActorID)
+	if actor == nil {
+		errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.ActorID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+	// anyone can see what an Actor can be hired for, but only the hireling
+	// and its employer can see its contract
+	info := commands.DescribeContract(actor)
+	if actor != s.actor && !uuid.Equal(info.EmployerID, s.actor.ID()) {
+		info = commands.ContractInfo{
+			ActorID: info.ActorID,
+			Name:    info.Name,
+			ForHire: info.ForHire,
+			Wage:    info.Wage,
+			Term:    info.Term,
+			Hired:   info.Hired,
+		}
+	}
+	s.sendMessage(MessageTypeDescribeContractComplete, info, msg.MessageID)
+}
+
+func (s *session) handleCommandSpeak(msg Message) {
+	var cmd CommandSpeak
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	err = s.actor.Speak(cmd.Speech)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+		return
+	}
+	s.sendMessage(MessageTypeSpeakComplete, nil, msg.MessageID)
+}
+
+func (s *session) handleCommandDescribeReputation(msg Message) {
+	var cmd CommandDescribeReputation
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	actor := s.actor.Zone().ActorByID(cmd.ActorID)
+	if actor == nil {
+		errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.ActorID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+	s.sendMessage(MessageTypeDescribeReputationComplete, commands.DescribeReputation(actor), msg.MessageID)
+}
+
+// handleCommandDescribeCrimes only ever describes what our own Actor knows;
+// it's up to the Actor to share that, by reporting crimes to others.
+func (s *session) handleCommandDescribeCrimes(msg Message) {
+	s.sendMessage(MessageTypeDescribeCrimesComplete, commands.DescribeCrimes(s.actor), msg.MessageID)
+}
+
+func (s *session) handleCommandReportCrime(msg Message) {
+	var cmd CommandReportCrime
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	listener := s.actor.Zone().ActorByID(cmd.ListenerID)
+	if listener == nil {
+		errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.ListenerID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+
+	err = s.actor.ReportCrime(cmd.CrimeID, listener)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeReportCrimeComplete, nil, msg.MessageID)
+	case core.ErrCrimeUnknown, core.ErrCrimeNotKnown, core.ErrCrimeReportSelf, core.ErrCrimeListenerNotHere:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandPunishCrime(msg Message) {
+	var cmd CommandPunishCrime
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	err = s.actor.Punish(cmd.CrimeID, cmd.Resolution, cmd.Fine)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypePunishCrimeComplete, nil, msg.MessageID)
+	case core.ErrCrimeUnknown, core.ErrCrimeNotKnown, core.ErrCrimeResolved, core.ErrCrimeResolutionUnknown,
+		core.ErrNotLawEnforcer, core.ErrOffenderNotHere, core.ErrNoJail, core.ErrFineAmount, core.ErrCannotAfford:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandDescribeProvenance(msg Message) {
+	var cmd CommandDescribeProvenance
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	obj := s.actor.Zone().ObjectByID(cmd.ObjectID)
+	if obj == nil || obj.Location() != s.actor.Location() {
+		errMsg := fmt.Sprintf("Object with ID %q is not here", cmd.ObjectID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+	s.sendMessage(MessageTypeDescribeProvenanceComplete, commands.DescribeProvenance(obj), msg.MessageID)
+}
+
+func (s *session) handleCommandQuest(msg Message) {
+	var cmd CommandQuest
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	var completeType string
+	switch msg.Type {
+	case MessageTypeDescribeQuestCommand:
+		info, err := commands.DescribeQuest(s.actor, cmd.Quest)
+		if err != nil {
+			s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+			return
+		}
+		s.sendMessage(MessageTypeDescribeQuestComplete, info, msg.MessageID)
+		return
+	case MessageTypeAcceptQuestCommand:
+		completeType = MessageTypeAcceptQuestComplete
+		err = s.actor.AcceptQuest(cmd.Quest)
+	case MessageTypeAbandonQuestCommand:
+		completeType = MessageTypeAbandonQuestComplete
+		err = s.actor.AbandonQuest(cmd.Quest)
+	}
+	switch err {
+	case nil:
+		s.sendMessage(completeType, nil, msg.MessageID)
+	case core.ErrQuestUnknown, core.ErrQuestAlreadyActive, core.ErrQuestAlreadyComplete, core.ErrQuestNotActive, core.ErrQuestGiverNotHere:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandConsume(msg Message) {
+	var cmd CommandConsume
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	obj := s.actor.Zone().ObjectByID(cmd.ObjectID)
+	if obj == nil {
+		errMsg := fmt.Sprintf("not holding an Object with ID %q", cmd.ObjectID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+
+	var method, completeType string
+	switch msg.Type {
+	case MessageTypeEatCommand:
+		method, completeType = core.ConsumeMethodEat, MessageTypeEatComplete
+	case MessageTypeDrinkCommand:
+		method, completeType = core.ConsumeMethodDrink, MessageTypeDrinkComplete
+	case MessageTypeUseCommand:
+		method, completeType = core.ConsumeMethodUse, MessageTypeUseComplete
+	}
+
+	err = s.actor.Consume(obj, method)
+	switch err {
+	case nil:
+		s.sendMessage(completeType, nil, msg.MessageID)
+	case core.ErrObjectNotHeld, core.ErrObjectNotConsumable, core.ErrWrongConsumeMethod:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandCraft(msg Message) {
+	var cmd CommandCraft
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	err = s.actor.Craft(cmd.Recipe)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeCraftComplete, CompleteCraft{Success: true}, msg.MessageID)
+	case core.ErrCraftFailed:
+		s.sendMessage(MessageTypeCraftComplete, CompleteCraft{Success: false}, msg.MessageID)
+	case core.ErrRecipeUnknown, core.ErrRecipeMissingInputs, core.ErrRecipeMissingTools:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorNotReady:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorNotReady, msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandDedicate(msg Message) {
+	var cmd CommandDedicate
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	pantheon := s.actor.Zone().World().Pantheon()
+	if pantheon == nil {
+		s.sendMessage(MessageTypeProcessingError, core.ErrNoPantheon.Error(), msg.MessageID)
+		return
+	}
+	deity := pantheon.DeityByID(cmd.DeityID)
+	if deity == nil {
+		errMsg := fmt.Sprintf("deity with ID %q does not exist", cmd.DeityID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+
+	err = s.actor.Dedicate(deity)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeDedicateComplete, nil, msg.MessageID)
+	case core.ErrActorAlreadyDedicated:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandSacrifice(msg Message) {
+	var cmd CommandSacrifice
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	obj := s.actor.Zone().ObjectByID(cmd.ObjectID)
+	if obj == nil || (obj.Container() != s.actor && obj.Container() != s.actor.Location()) {
+		errMsg := fmt.Sprintf("no Object with ID %q within reach", cmd.ObjectID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+
+	err = s.actor.Sacrifice(obj)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypeSacrificeComplete, nil, msg.MessageID)
+	case core.ErrActorNotDedicated, core.ErrObjectLootRightsReserved:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
+func (s *session) handleCommandPray(msg Message) {
+	var cmd CommandPray
+	err := json.Unmarshal(msg.Payload, &cmd)
+	if err != nil {
+		fmt.Printf("WSAPI ERROR: json.Unmarshal(): %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.ClosePolicyViolation, "message JSON data cannot be decoded")
+		return
+	}
+
+	target := s.actor.Zone().ActorByID(cmd.TargetID)
+	if target == nil {
+		errMsg := fmt.Sprintf("Actor with ID %q does not exist", cmd.TargetID)
+		s.sendMessage(MessageTypeProcessingError, errMsg, msg.MessageID)
+		return
+	}
+	if target.Location() != s.actor.Location() {
+		s.sendMessage(MessageTypeProcessingError, "too far away", msg.MessageID)
+		return
+	}
+
+	err = s.actor.Pray(cmd.Prayer, target)
+	switch err {
+	case nil:
+		s.sendMessage(MessageTypePrayComplete, nil, msg.MessageID)
+	case core.ErrActorNotDedicated, core.ErrPrayerUnknown, core.ErrPrayerBeyondSkill, core.ErrPrayerUnanswered, core.ErrActorInsufficientZeal:
+		s.sendMessage(MessageTypeProcessingError, err.Error(), msg.MessageID)
+	case core.ErrActorNotReady:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorNotReady, msg.MessageID)
+	case core.ErrActorIsGhost:
+		s.sendMessage(MessageTypeProcessingError, commands.ErrorActorIsGhost, msg.MessageID)
+	default:
+		fmt.Printf("WSAPI ERROR: %s\n", err)
+		s.sendCloseDetachAndStop(true, websocket.CloseInternalServerErr, "")
+	}
+}
+
 func (s *session) handleCommandGetCurrentLocInfo(msg Message) {
 	if s.actor == nil {
 		return